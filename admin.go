@@ -0,0 +1,102 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/alexsasharegan/gophx-xxws/ws"
+)
+
+const (
+	// Default reason sent to clients disconnected without one.
+	defaultKickReason = "disconnected by administrator"
+
+	// The most a close frame can carry.
+	maxKickReason = 123
+)
+
+// clientsHandler serves the client registry:
+//
+//	GET    /ws/clients                   every connected client
+//	GET    /ws/clients/<id>              one client
+//	DELETE /ws/clients/<id>?reason=...   disconnect a client
+//
+// These cover the main hub; add ?room=<name> for a room's clients, whose
+// IDs are counted per room. A close frame holds at most 123 bytes of
+// reason, so longer reasons are refused.
+func clientsHandler(hub *ws.Hub, rooms *ws.Rooms) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hub := hub
+		if name := r.URL.Query().Get("room"); name != "" {
+			if hub = rooms.Hub(name); hub == nil {
+				http.Error(w, ws.ErrNoRoom.Error(), http.StatusNotFound)
+				return
+			}
+		}
+
+		rest := strings.Trim(strings.TrimPrefix(r.URL.Path, "/ws/clients"), "/")
+		if rest == "" {
+			if r.Method != http.MethodGet {
+				http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+				return
+			}
+			writeJSON(w, hub.Clients())
+			return
+		}
+
+		id, err := strconv.ParseUint(rest, 10, 64)
+		if err != nil {
+			http.Error(w, "invalid client id", http.StatusBadRequest)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			info, err := hub.Client(id)
+			if err != nil {
+				clientError(w, err)
+				return
+			}
+			writeJSON(w, info)
+		case http.MethodDelete:
+			reason := r.URL.Query().Get("reason")
+			if reason == "" {
+				reason = defaultKickReason
+			}
+			if len(reason) > maxKickReason {
+				http.Error(w, fmt.Sprintf("reason is longer than %d bytes", maxKickReason), http.StatusBadRequest)
+				return
+			}
+			if err := hub.Disconnect(id, reason); err != nil {
+				clientError(w, err)
+				return
+			}
+			log.Println("[ws] Disconnected client ", id, ": ", reason)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+func clientError(w http.ResponseWriter, err error) {
+	switch err {
+	case ws.ErrNoClient:
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case ws.ErrHubClosed:
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Println("Error serializing json: ", err)
+	}
+}
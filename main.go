@@ -1,6 +1,8 @@
 package main
 
 import (
+	"compress/flate"
+	"context"
 	"encoding/json"
 	"flag"
 	"fmt"
@@ -8,9 +10,13 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"strings"
 	"syscall"
 	"time"
 
+	"github.com/alexsasharegan/gophx-xxws/motion"
+	"github.com/alexsasharegan/gophx-xxws/pipeline"
 	"github.com/alexsasharegan/gophx-xxws/sensor"
 	"github.com/alexsasharegan/gophx-xxws/ws"
 	"github.com/rakyll/statik/fs"
@@ -18,12 +24,113 @@ import (
 	_ "github.com/alexsasharegan/gophx-xxws/statik"
 )
 
+// How often the sensor is sampled, until a client changes it.
+const defaultSampleInterval = time.Second / 120
+
+// Room carrying only motion events, served at /ws/rooms/events.
+const roomEvents = "events"
+
 var (
 	addr = flag.String("addr", "0.0.0.0:3000", "http service address")
+
+	tlsCert       = flag.String("tls-cert", "", "TLS certificate file; serves https/wss when set with -tls-key")
+	tlsKey        = flag.String("tls-key", "", "TLS private key file")
+	tlsSelfSigned = flag.Bool("tls-self-signed", false, "generate a self-signed certificate at -tls-cert/-tls-key (default tls/cert.pem, tls/key.pem) if missing")
+	httpRedirect  = flag.String("http-redirect", "", "also listen for plain http on this address and redirect to https")
+
+	mount = flag.String("mount", "", "sensor mount transform (axes:-y,x,-z | euler:roll,pitch,yaw | matrix:9 values)")
+	tare  = flag.Bool("tare", false, "record the orientation at startup as the reference (hold still)")
+
+	linear = flag.Bool("linear", false, "include gravity-removed linear acceleration in the payload")
+	earth  = flag.String("earth", "", "include earth frame acceleration in the payload (enu | ned)")
+
+	deadReckon = flag.Bool("dead-reckon", false, "estimate velocity and displacement (reset with POST /motion/reset)")
+
+	pipelineConfig = flag.String("pipeline", "", "path to a JSON signal-processing pipeline config")
+
+	shutdownTimeout = flag.Duration("shutdown-timeout", 5*time.Second, "how long to wait for clients and recordings to finish on shutdown")
+
+	recordDir = flag.String("record-dir", "recordings", "directory recordings started over JSON-RPC are written to")
+
+	policy    = flag.String("policy", "drop-oldest", "default slow client policy (drop-newest | drop-oldest | coalesce-to-latest | disconnect)")
+	queueSize = flag.Int("queue-size", ws.DefaultOptions.QueueSize, "outgoing message queue size per client")
+	maxDrops  = flag.Int("max-drops", ws.DefaultOptions.MaxDrops, "dropped messages tolerated before disconnecting (disconnect policy)")
+
+	batch      = flag.String("batch", "none", "default framing of queued messages (none | array); ndjson is negotiated by subprotocol")
+	maxBatch   = flag.Int("max-batch", ws.DefaultOptions.MaxBatch, "most messages written per frame")
+	maxLatency = flag.Duration("max-latency", ws.DefaultOptions.MaxLatency, "how long a batching client waits to fill a frame")
+
+	compress      = flag.Bool("compress", false, "offer permessage-deflate to clients that support it")
+	compressLevel = flag.Int("compress-level", ws.DefaultOptions.CompressionLevel, "permessage-deflate level (1 fastest - 9 smallest, 0 none, -1 default, -2 huffman only)")
+
+	pingEvery = flag.Duration("ping-interval", ws.DefaultOptions.PingInterval, "how often clients are pinged to measure round trip time and detect dead connections (/ws?ping=<seconds> overrides)")
+
+	history = flag.Duration("history", ws.DefaultHistoryDepth, "how much recent data to keep for clients joining late (/ws?history) or resuming (Last-Event-ID); 0 disables")
+
+	origins    = flag.String("origins", "", "comma separated origins allowed to connect to /ws (* for any); default same-origin")
+	tokensFile = flag.String("tokens", "", "file of \"<token> <read|control>\" lines; when set, clients must authenticate")
+
+	upstreams = flag.String("upstream", "", "comma separated <source>=<url> upstream /ws endpoints to relay into this server, e.g. pi-2=ws://pi-2.local:3000/ws")
+
+	sensorID = flag.String("sensor-id", defaultSensorID(), "id of this sensor, published as the topic sensor:<id>")
 )
 
+func defaultSensorID() string {
+	name, err := os.Hostname()
+	if err != nil {
+		return "local"
+	}
+
+	return name
+}
+
 func main() {
 	flag.Parse()
+
+	p, err := buildPipeline()
+	if err != nil {
+		log.Fatalln(err)
+	}
+	log.Println("Pipeline stages: ", p.Stages())
+
+	clientPolicy, err := ws.ParsePolicy(*policy)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	auth := &ws.Auth{}
+	if *origins != "" {
+		auth.Origins = strings.Split(*origins, ",")
+	}
+	if *tokensFile != "" {
+		if auth.Tokens, err = ws.LoadTokens(*tokensFile); err != nil {
+			log.Fatalln(err)
+		}
+	}
+
+	// gorilla only rejects a bad level once a client has upgraded.
+	if *compressLevel < flate.HuffmanOnly || *compressLevel > flate.BestCompression {
+		log.Fatalln(fmt.Sprintf("invalid compress-level %d: must be between %d and %d", *compressLevel, flate.HuffmanOnly, flate.BestCompression))
+	}
+
+	batchMode, err := ws.ParseBatchMode(*batch)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	clientOpts := ws.Options{
+		Auth:       auth,
+		QueueSize:  *queueSize,
+		Policy:     clientPolicy,
+		MaxDrops:   *maxDrops,
+		Batch:      batchMode,
+		MaxBatch:   *maxBatch,
+		MaxLatency: *maxLatency,
+
+		Compression:      *compress,
+		CompressionLevel: *compressLevel,
+
+		PingInterval: *pingEvery,
+	}
+
 	statikFS, err := fs.New()
 	if err != nil {
 		log.Fatalln(err)
@@ -35,10 +142,46 @@ func main() {
 	}
 	defer a.Close()
 
+	m, err := sensor.ParseMount(*mount)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	a.SetMount(m)
+
+	if *tare {
+		if err := a.Tare(); err != nil {
+			log.Fatalln(err)
+		}
+	}
+
 	hub := ws.NewHub()
 	go hub.RunLoop()
+	hub.SetHistory(*history)
+
+	stats := newAppMetrics(hub)
+
+	relays, err := parseUpstreams(*upstreams)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	relay(hub, relays)
+
+	// Rooms carry streams apart from the main hub: "events" has only motion
+	// events, for consumers that don't want the sample stream.
+	rooms := ws.NewRooms(clientOpts)
+	events, err := rooms.Create(roomEvents)
+	if err != nil {
+		log.Fatalln(err)
+	}
 
-	sig := make(chan os.Signal)
+	// One sampling loop feeds every consumer of the sensor.
+	fanout := sensor.NewFanout(timedReader{r: &a, m: stats}, defaultSampleInterval)
+	go fanout.Run(func(err error) {
+		log.Println("Error reading sensor data: ", err)
+	})
+	defer fanout.Close()
+
+	sig := make(chan os.Signal, 1)
 	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
 
 	// h := http.FileServer(assets)
@@ -46,11 +189,50 @@ func main() {
 	// 	fmt.Println("received request: ", r.URL.Path)
 	// 	h.ServeHTTP(w, r)
 	// })
+	ctl := &controller{
+		a:        &a,
+		fanout:   fanout,
+		pipe:     newLivePipeline(p),
+		recorder: newRecorder(*recordDir, fanout),
+	}
+
+	if *deadReckon {
+		dr := motion.NewDeadReckoner(1 << 4)
+		ctl.dr = dr
+		go dr.Run(fanout.Subscribe(1<<4, sensor.DropOldest).C)
+		go motionLoop(hub, dr.Output())
+
+		http.Handle("/motion/reset", auth.Require(ws.RoleControl, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+				return
+			}
+			dr.Reset()
+			w.WriteHeader(http.StatusNoContent)
+		})))
+	}
+
+	http.HandleFunc("/pipeline", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, ctl.pipe.Load().Latencies())
+	})
+
+	http.HandleFunc("/ws/stats", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, hub.Stats())
+	})
+
+	ctl.register(hub)
+
+	clients := auth.Require(ws.RoleControl, clientsHandler(hub, rooms))
+	http.Handle("/ws/clients", clients)
+	http.Handle("/ws/clients/", clients)
+
+	http.Handle("/metrics", stats.reg)
+
 	http.Handle("/", http.FileServer(statikFS))
 	http.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
 		log.Println("[ws] Client connection received.")
 
-		err := ws.ServeWS(hub, w, r)
+		err := ws.ServeWSOptions(hub, w, r, clientOpts)
 		if err != nil {
 			log.Println(
 				fmt.Sprintf("Error upgrading request to ws: %v", err),
@@ -58,9 +240,41 @@ func main() {
 		}
 	})
 
+	http.HandleFunc("/ws/rooms/", func(w http.ResponseWriter, r *http.Request) {
+		log.Println("[ws] Room connection received.")
+
+		if err := rooms.ServeWS(w, r, "/ws/rooms/"); err != nil {
+			log.Println(
+				fmt.Sprintf("Error upgrading request to ws: %v", err),
+			)
+		}
+	})
+
+	http.HandleFunc("/events", func(w http.ResponseWriter, r *http.Request) {
+		log.Println("[sse] Client connection received.")
+
+		if err := ws.ServeSSE(hub, w, r, clientOpts); err != nil {
+			log.Println(
+				fmt.Sprintf("Error streaming events: %v", err),
+			)
+		}
+	})
+
+	certFile, keyFile, err := tlsFiles()
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	srv := &http.Server{Addr: *addr}
 	log.Println("Opening server on port ", *addr)
 	go func() {
-		if err := http.ListenAndServe(*addr, nil); err != nil {
+		var err error
+		if certFile != "" {
+			err = srv.ListenAndServeTLS(certFile, keyFile)
+		} else {
+			err = srv.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
 			log.Fatalln(
 				fmt.Sprintf("Could not bind server to address '%s'", *addr),
 				err,
@@ -68,87 +282,172 @@ func main() {
 		}
 	}()
 
+	var redirect *http.Server
+	if certFile != "" && *httpRedirect != "" {
+		redirect = &http.Server{Addr: *httpRedirect, Handler: redirectToHTTPS(*addr)}
+		log.Println("Redirecting http on ", *httpRedirect)
+		go func() {
+			if err := redirect.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Fatalln(
+					fmt.Sprintf("Could not bind redirect server to address '%s'", *httpRedirect),
+					err,
+				)
+			}
+		}()
+	}
+
 	// Blocking forever loop only broken by interrupt/terminate signal.
-	broadcastLoop(hub, &a, sig)
+	broadcastLoop(hub, events, fanout, ctl.pipe, stats, sig)
+
+	ctx, cancel := context.WithTimeout(context.Background(), *shutdownTimeout)
+	defer cancel()
+	shutdown(ctx, hub, rooms, ctl, fanout, srv, redirect)
 	log.Println("Goodbye 👋")
 }
 
-func broadcastLoop(hub *ws.Hub, a *sensor.Accelerometer, sig <-chan os.Signal) {
-	// Send new data twice per render cycle (60Hz)
-	ticker := time.NewTicker(time.Second / 120)
-	defer func() {
-		ticker.Stop()
-		hub.Close()
-	}()
+// shutdown stops taking requests, sends websocket clients away once their
+// queues are flushed, finishes any recording and stops sampling, giving up
+// on stragglers when ctx is done. The sensor itself is closed by main.
+func shutdown(ctx context.Context, hub *ws.Hub, rooms *ws.Rooms, ctl *controller, fanout *sensor.Fanout, servers ...*http.Server) {
+	// The hub goes first: it ends event streams, which http.Server would
+	// otherwise wait on, and hijacked websocket connections aren't tracked
+	// by http.Server at all.
+	if err := hub.Shutdown(ctx, "server shutting down"); err != nil {
+		log.Println(fmt.Sprintf("Error closing ws connections: %v", err))
+	}
+	if err := rooms.Shutdown(ctx, "server shutting down"); err != nil {
+		log.Println(fmt.Sprintf("Error closing ws rooms: %v", err))
+	}
 
-	for {
-		select {
-		case <-ticker.C:
-			d, err := getData(a)
-			if err != nil {
-				log.Println("Error reading sensor data: ", err)
-				break
-			}
-			b, err := json.Marshal(d)
-			if err != nil {
-				log.Println("Error serializing json: ", err)
-				break
-			}
-			hub.Broadcast(b)
-		case s := <-sig:
-			log.Println("Received shutdown signal: ", s.String())
-			return
+	for _, srv := range servers {
+		if srv == nil {
+			continue
+		}
+		if err := srv.Shutdown(ctx); err != nil {
+			log.Println(fmt.Sprintf("Error shutting down http server: %v", err))
 		}
 	}
+
+	ctl.recorder.Stop()
+	fanout.Close()
 }
 
-type accelerationData struct {
-	X float64 `json:"x"`
-	Y float64 `json:"y"`
-	Z float64 `json:"z"`
+// tlsFiles returns the certificate and key to serve with, generating a
+// self-signed pair if asked to. Empty paths mean plain http.
+func tlsFiles() (string, string, error) {
+	certFile, keyFile := *tlsCert, *tlsKey
 
-	Rotation []float64 `json:"rotation"`
-}
+	if *tlsSelfSigned {
+		if certFile == "" {
+			certFile = filepath.Join("tls", "cert.pem")
+		}
+		if keyFile == "" {
+			keyFile = filepath.Join("tls", "key.pem")
+		}
+		if err := ensureSelfSigned(certFile, keyFile); err != nil {
+			return "", "", err
+		}
+	}
 
-type gyroData struct {
-	X float64 `json:"x"`
-	Y float64 `json:"y"`
-	Z float64 `json:"z"`
-}
+	if (certFile == "") != (keyFile == "") {
+		return "", "", fmt.Errorf("-tls-cert and -tls-key must be given together")
+	}
 
-type sensorData struct {
-	Acceleration *accelerationData `json:"acceleration"`
-	Gyro         *gyroData         `json:"gyro"`
+	return certFile, keyFile, nil
 }
 
-func getData(a *sensor.Accelerometer) (*sensorData, error) {
-	accel, err := a.GetAcceleration()
-	if err != nil {
-		return nil, err
+// buildPipeline loads the configured pipeline, if any, and appends a fusion
+// stage when derived values were requested by flag.
+func buildPipeline() (*pipeline.Pipeline, error) {
+	p := pipeline.New()
+	if *pipelineConfig != "" {
+		var err error
+		if p, err = pipeline.Load(*pipelineConfig); err != nil {
+			return nil, err
+		}
 	}
 
-	gyro, err := a.GetGyro()
-	if err != nil {
+	if err := appendFlagStages(p); err != nil {
 		return nil, err
 	}
 
-	ax, ay, az := accel.GetValues()
-	xr := accel.GetXRotation()
-	yr := accel.GetYRotation()
-	gx, gy, gz := gyro.GetValues()
-
-	return &sensorData{
-		Acceleration: &accelerationData{
-			X:        ax,
-			Y:        ay,
-			Z:        az,
-			Rotation: []float64{xr, yr},
-		},
-		Gyro: &gyroData{
-			X: gx,
-			Y: gy,
-			Z: gz,
-		},
-	}, nil
+	return p, nil
+}
+
+// appendFlagStages adds a fusion stage when derived values were requested
+// by flag.
+func appendFlagStages(p *pipeline.Pipeline) error {
+	if *linear || *earth != "" {
+		s, err := pipeline.NewFusion(0, *linear, *earth)
+		if err != nil {
+			return err
+		}
+		p.Append(s)
+	}
+
+	return nil
+}
 
+func broadcastLoop(hub, events *ws.Hub, fanout *sensor.Fanout, pipe *livePipeline, stats *appMetrics, sig <-chan os.Signal) {
+	// Samples arrive twice per render cycle (60Hz)
+	sub := fanout.Subscribe(1<<4, sensor.DropOldest)
+	defer sub.Close()
+
+	var last time.Time
+	for {
+		select {
+		case s, ok := <-sub.C:
+			if !ok {
+				return
+			}
+			now := time.Now()
+			if !last.IsZero() {
+				stats.observeInterval(now.Sub(last), fanout.Interval())
+			}
+			last = now
+			stats.observeSample(s)
+
+			for _, out := range pipe.Load().Process(pipeline.FromSensor(s)) {
+				for _, d := range getData(out) {
+					b, err := json.Marshal(d.data)
+					if err != nil {
+						log.Println("Error serializing json: ", err)
+						continue
+					}
+					m := ws.Message{
+						Seq:    out.Seq,
+						Topics: []string{d.topic, sensorTopic(*sensorID)},
+						// Events are rare and must never be thinned out.
+						Sampled: d.topic != topicEvents,
+						Data:    b,
+					}
+					hub.Send(m)
+					if d.topic == topicEvents {
+						events.Send(m)
+					}
+				}
+			}
+		case s := <-sig:
+			log.Println("Received shutdown signal: ", s.String())
+			return
+		}
+	}
+}
+
+// motionLoop forwards dead-reckoning estimates as their own message, which
+// the dashboard merges alongside the sensor payload.
+func motionLoop(hub *ws.Hub, estimates <-chan motion.Estimate) {
+	for e := range estimates {
+		b, err := json.Marshal(motionData{Motion: e})
+		if err != nil {
+			log.Println("Error serializing json: ", err)
+			continue
+		}
+		hub.Send(ws.Message{
+			Seq:     e.Seq,
+			Topics:  []string{topicMotion, sensorTopic(*sensorID)},
+			Sampled: true,
+			Data:    b,
+		})
+	}
 }
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/alexsasharegan/gophx-xxws/metrics"
+	"github.com/alexsasharegan/gophx-xxws/sensor"
+	"github.com/alexsasharegan/gophx-xxws/ws"
+)
+
+// appMetrics are the process-wide series served on /metrics.
+type appMetrics struct {
+	reg *metrics.Registry
+
+	readLatency *metrics.Histogram
+	readErrors  *metrics.Counter
+
+	loopJitter *metrics.Histogram
+
+	accel       *metrics.GaugeVec
+	gyro        *metrics.GaugeVec
+	temperature *metrics.Gauge
+	seq         *metrics.Gauge
+}
+
+func newAppMetrics(hub *ws.Hub) *appMetrics {
+	reg := metrics.NewRegistry()
+	m := &appMetrics{
+		reg: reg,
+
+		readLatency: reg.Histogram("xxws_sensor_read_seconds", "Time taken by a combined sensor read over I²C.",
+			metrics.ExponentialBuckets(100e-6, 2, 10)),
+		readErrors: reg.Counter("xxws_sensor_read_errors_total", "Sensor reads that failed."),
+
+		loopJitter: reg.Histogram("xxws_broadcast_jitter_seconds", "Deviation of the broadcast loop's sample interval from nominal.",
+			metrics.ExponentialBuckets(100e-6, 2, 10)),
+
+		accel:       reg.GaugeVec("xxws_sensor_acceleration_g", "Latest acceleration reading.", "axis"),
+		gyro:        reg.GaugeVec("xxws_sensor_gyro_degrees_per_second", "Latest gyroscope reading.", "axis"),
+		temperature: reg.Gauge("xxws_sensor_temperature_celsius", "Latest die temperature."),
+		seq:         reg.Gauge("xxws_sensor_sequence", "Sequence number of the latest sample."),
+	}
+
+	reg.Func("xxws_ws_clients", "Connected websocket clients.", metrics.GaugeType, func() []metrics.Value {
+		return []metrics.Value{{Value: float64(len(hub.Clients()))}}
+	})
+	hubCounter := func(name, help string, f func(ws.Stats) uint64) {
+		reg.Func(name, help, metrics.CounterType, func() []metrics.Value {
+			return []metrics.Value{{Value: float64(f(hub.Stats()))}}
+		})
+	}
+	hubCounter("xxws_ws_messages_sent_total", "Messages written to websocket clients.",
+		func(s ws.Stats) uint64 { return s.Messages })
+	hubCounter("xxws_ws_payload_bytes_total", "Message payload bytes written to websocket clients.",
+		func(s ws.Stats) uint64 { return s.PayloadBytes })
+	hubCounter("xxws_ws_wire_bytes_total", "Bytes written to websocket sockets, after framing and compression.",
+		func(s ws.Stats) uint64 { return s.WireBytes })
+	hubCounter("xxws_ws_dropped_messages_total", "Messages dropped by client backpressure policies.",
+		func(s ws.Stats) uint64 { return s.Dropped })
+
+	reg.Func("xxws_ws_client_dropped_messages_total", "Messages dropped for each connected client.", metrics.CounterType, func() []metrics.Value {
+		clients := hub.Clients()
+		values := make([]metrics.Value, len(clients))
+		for i, c := range clients {
+			values[i] = metrics.Value{Labels: clientLabels(c), Value: float64(c.Dropped)}
+		}
+		return values
+	})
+	reg.Func("xxws_ws_client_queued_messages", "Messages waiting in each connected client's queue.", metrics.GaugeType, func() []metrics.Value {
+		clients := hub.Clients()
+		values := make([]metrics.Value, len(clients))
+		for i, c := range clients {
+			values[i] = metrics.Value{Labels: clientLabels(c), Value: float64(c.Queued)}
+		}
+		return values
+	})
+	reg.Func("xxws_ws_client_rtt_seconds", "Smoothed ping round trip time of each connected client.", metrics.GaugeType, func() []metrics.Value {
+		clients := hub.Clients()
+		values := make([]metrics.Value, len(clients))
+		for i, c := range clients {
+			values[i] = metrics.Value{Labels: clientLabels(c), Value: c.RTT.Seconds()}
+		}
+		return values
+	})
+
+	return m
+}
+
+func clientLabels(c ws.ClientInfo) metrics.Labels {
+	return metrics.Labels{"client": strconv.FormatUint(c.ID, 10), "remote": c.Remote}
+}
+
+// observeSample records the latest readout as gauges.
+func (m *appMetrics) observeSample(s sensor.Sample) {
+	ax, ay, az := s.Acceleration.GetValues()
+	gx, gy, gz := s.Gyro.GetValues()
+
+	m.accel.With("x").Set(ax)
+	m.accel.With("y").Set(ay)
+	m.accel.With("z").Set(az)
+	m.gyro.With("x").Set(gx)
+	m.gyro.With("y").Set(gy)
+	m.gyro.With("z").Set(gz)
+	m.temperature.Set(s.Temperature)
+	m.seq.Set(float64(s.Seq))
+}
+
+// observeInterval records how far the time since the last loop iteration
+// strayed from the sampling interval.
+func (m *appMetrics) observeInterval(elapsed, interval time.Duration) {
+	m.loopJitter.Observe(math.Abs((elapsed - interval).Seconds()))
+}
+
+// timedReader times every read of the wrapped Reader.
+type timedReader struct {
+	r sensor.Reader
+	m *appMetrics
+}
+
+func (t timedReader) Read() (sensor.Sample, error) {
+	start := time.Now()
+	s, err := t.r.Read()
+	t.m.readLatency.Observe(time.Since(start).Seconds())
+	if err != nil {
+		t.m.readErrors.Inc()
+	}
+
+	return s, err
+}
@@ -0,0 +1,359 @@
+// Package metrics is a small, dependency free registry of counters, gauges
+// and histograms served in the Prometheus text exposition format.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Type is the Prometheus metric type written in the TYPE line.
+type Type string
+
+// Supported metric types.
+const (
+	CounterType   Type = "counter"
+	GaugeType     Type = "gauge"
+	HistogramType Type = "histogram"
+)
+
+// Labels are the label names and values of a single series.
+type Labels map[string]string
+
+// Value is one series reported by a Func.
+type Value struct {
+	Labels Labels
+	Value  float64
+}
+
+// collector writes every series of one metric family.
+type collector interface {
+	write(w *bufio.Writer, name string)
+}
+
+type family struct {
+	name, help string
+	typ        Type
+	c          collector
+}
+
+// Registry holds metrics and serves them over HTTP.
+type Registry struct {
+	mu       sync.Mutex
+	families []family
+	names    map[string]bool
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{names: make(map[string]bool)}
+}
+
+func (r *Registry) register(name, help string, typ Type, c collector) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.names[name] {
+		panic(fmt.Sprintf("metrics: %s registered twice", name))
+	}
+	r.names[name] = true
+	r.families = append(r.families, family{name: name, help: help, typ: typ, c: c})
+}
+
+// Counter registers and returns a Counter.
+func (r *Registry) Counter(name, help string) *Counter {
+	c := &Counter{}
+	r.register(name, help, CounterType, c)
+	return c
+}
+
+// Gauge registers and returns a Gauge.
+func (r *Registry) Gauge(name, help string) *Gauge {
+	g := &Gauge{}
+	r.register(name, help, GaugeType, g)
+	return g
+}
+
+// GaugeVec registers a family of gauges told apart by the given labels.
+func (r *Registry) GaugeVec(name, help string, labels ...string) *GaugeVec {
+	v := &GaugeVec{labels: labels, gauges: make(map[string]*Gauge)}
+	r.register(name, help, GaugeType, v)
+	return v
+}
+
+// Histogram registers and returns a Histogram with the given upper bounds,
+// which must be sorted in increasing order.
+func (r *Registry) Histogram(name, help string, buckets []float64) *Histogram {
+	h := &Histogram{
+		buckets: buckets,
+		counts:  make([]uint64, len(buckets)),
+	}
+	r.register(name, help, HistogramType, h)
+	return h
+}
+
+// Func registers a metric whose series are computed by f at scrape time,
+// for values that are already tracked elsewhere.
+func (r *Registry) Func(name, help string, typ Type, f func() []Value) {
+	r.register(name, help, typ, funcCollector(f))
+}
+
+// WriteTo writes every metric in the text exposition format.
+func (r *Registry) WriteTo(w *bufio.Writer) {
+	r.mu.Lock()
+	families := make([]family, len(r.families))
+	copy(families, r.families)
+	r.mu.Unlock()
+
+	for _, f := range families {
+		fmt.Fprintf(w, "# HELP %s %s\n", f.name, escapeHelp(f.help))
+		fmt.Fprintf(w, "# TYPE %s %s\n", f.name, f.typ)
+		f.c.write(w, f.name)
+	}
+}
+
+// ServeHTTP serves the registry for a Prometheus scrape.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+
+	bw := bufio.NewWriter(w)
+	r.WriteTo(bw)
+	if err := bw.Flush(); err != nil {
+		log.Println(fmt.Sprintf("Error writing metrics: %v", err))
+	}
+}
+
+// Counter only goes up.
+type Counter struct {
+	bits uint64
+}
+
+// Inc adds one.
+func (c *Counter) Inc() {
+	c.Add(1)
+}
+
+// Add adds v, which must not be negative.
+func (c *Counter) Add(v float64) {
+	addFloat(&c.bits, v)
+}
+
+// Value returns the current count.
+func (c *Counter) Value() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&c.bits))
+}
+
+func (c *Counter) write(w *bufio.Writer, name string) {
+	writeSample(w, name, "", c.Value())
+}
+
+// Gauge is a value that goes up and down.
+type Gauge struct {
+	bits uint64
+}
+
+// Set replaces the value.
+func (g *Gauge) Set(v float64) {
+	atomic.StoreUint64(&g.bits, math.Float64bits(v))
+}
+
+// Add adds v, which may be negative.
+func (g *Gauge) Add(v float64) {
+	addFloat(&g.bits, v)
+}
+
+// Value returns the current value.
+func (g *Gauge) Value() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&g.bits))
+}
+
+func (g *Gauge) write(w *bufio.Writer, name string) {
+	writeSample(w, name, "", g.Value())
+}
+
+// GaugeVec is a set of gauges sharing a name.
+type GaugeVec struct {
+	labels []string
+
+	mu     sync.Mutex
+	gauges map[string]*Gauge
+}
+
+// With returns the gauge for the label values, given in the order the
+// labels were registered.
+func (v *GaugeVec) With(values ...string) *Gauge {
+	if len(values) != len(v.labels) {
+		panic(fmt.Sprintf("metrics: got %d label values for %d labels", len(values), len(v.labels)))
+	}
+
+	l := make(Labels, len(values))
+	for i, name := range v.labels {
+		l[name] = values[i]
+	}
+	key := formatLabels(l)
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	g, ok := v.gauges[key]
+	if !ok {
+		g = &Gauge{}
+		v.gauges[key] = g
+	}
+
+	return g
+}
+
+func (v *GaugeVec) write(w *bufio.Writer, name string) {
+	v.mu.Lock()
+	keys := make([]string, 0, len(v.gauges))
+	for k := range v.gauges {
+		keys = append(keys, k)
+	}
+	gauges := make(map[string]*Gauge, len(v.gauges))
+	for k, g := range v.gauges {
+		gauges[k] = g
+	}
+	v.mu.Unlock()
+
+	sort.Strings(keys)
+	for _, k := range keys {
+		writeSample(w, name, k, gauges[k].Value())
+	}
+}
+
+// Histogram counts observations into cumulative buckets.
+type Histogram struct {
+	mu      sync.Mutex
+	buckets []float64
+	counts  []uint64
+	count   uint64
+	sum     float64
+}
+
+// Observe records v.
+func (h *Histogram) Observe(v float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	i := sort.SearchFloat64s(h.buckets, v)
+	if i < len(h.counts) {
+		h.counts[i]++
+	}
+	h.count++
+	h.sum += v
+}
+
+func (h *Histogram) write(w *bufio.Writer, name string) {
+	h.mu.Lock()
+	counts := make([]uint64, len(h.counts))
+	copy(counts, h.counts)
+	count, sum := h.count, h.sum
+	h.mu.Unlock()
+
+	var cum uint64
+	for i, le := range h.buckets {
+		cum += counts[i]
+		writeSample(w, name+"_bucket", formatLabels(Labels{"le": formatFloat(le)}), float64(cum))
+	}
+	writeSample(w, name+"_bucket", `{le="+Inf"}`, float64(count))
+	writeSample(w, name+"_sum", "", sum)
+	writeSample(w, name+"_count", "", float64(count))
+}
+
+// ExponentialBuckets returns n upper bounds starting at start, each factor
+// times the previous one.
+func ExponentialBuckets(start, factor float64, n int) []float64 {
+	b := make([]float64, n)
+	for i := range b {
+		b[i] = start
+		start *= factor
+	}
+
+	return b
+}
+
+type funcCollector func() []Value
+
+func (f funcCollector) write(w *bufio.Writer, name string) {
+	values := f()
+	sort.Slice(values, func(i, j int) bool {
+		return formatLabels(values[i].Labels) < formatLabels(values[j].Labels)
+	})
+	for _, v := range values {
+		writeSample(w, name, formatLabels(v.Labels), v.Value)
+	}
+}
+
+func addFloat(bits *uint64, v float64) {
+	for {
+		old := atomic.LoadUint64(bits)
+		next := math.Float64bits(math.Float64frombits(old) + v)
+		if atomic.CompareAndSwapUint64(bits, old, next) {
+			return
+		}
+	}
+}
+
+func writeSample(w *bufio.Writer, name, labels string, v float64) {
+	w.WriteString(name)
+	w.WriteString(labels)
+	w.WriteByte(' ')
+	w.WriteString(formatFloat(v))
+	w.WriteByte('\n')
+}
+
+// formatLabels renders l as {a="1",b="2"} with sorted names, or "" if empty.
+func formatLabels(l Labels) string {
+	if len(l) == 0 {
+		return ""
+	}
+
+	names := make([]string, 0, len(l))
+	for n := range l {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, n := range names {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(n)
+		b.WriteString(`="`)
+		b.WriteString(labelEscaper.Replace(l[n]))
+		b.WriteByte('"')
+	}
+	b.WriteByte('}')
+
+	return b.String()
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+func escapeHelp(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(s)
+}
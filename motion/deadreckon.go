@@ -0,0 +1,177 @@
+package motion
+
+import (
+	"math"
+	"time"
+
+	"github.com/alexsasharegan/gophx-xxws/sensor"
+)
+
+const (
+	// Below these the device is considered still (zero-velocity update).
+	defaultStationaryAccel = 0.02 // g
+	defaultStationaryGyro  = 2.0  // °/s
+	defaultStationaryHold  = 100 * time.Millisecond
+
+	// Velocity high-pass cutoff; bleeds off integrated bias.
+	defaultHighPass = 0.1 // Hz
+)
+
+// Estimate is the dead-reckoning state after a sample, in the ENU frame.
+type Estimate struct {
+	Seq  uint64    `json:"seq"`
+	Time time.Time `json:"time"`
+
+	// Metres per second.
+	Velocity Vector `json:"velocity"`
+	// Metres from the last reset.
+	Displacement Vector `json:"displacement"`
+
+	Stationary bool `json:"stationary"`
+}
+
+// DeadReckoner integrates linear acceleration into velocity and
+// displacement. This is best effort: MEMS bias makes position drift
+// quadratically, so results are only meaningful over short movements
+// that start and end at rest.
+type DeadReckoner struct {
+	// Linear acceleration (g) and rotation rate (°/s) under which the
+	// device counts as still, and for how long before velocity is zeroed.
+	StationaryAccel float64
+	StationaryGyro  float64
+	StationaryHold  time.Duration
+
+	// Cutoff in Hz of the velocity high-pass filter. Zero disables it.
+	HighPass float64
+
+	fusion *Fusion
+
+	last      time.Time
+	stillFor  time.Duration
+	rawVel    Vector
+	vel       Vector
+	pos       Vector
+	prevAccel Vector
+
+	reset chan struct{}
+	out   chan Estimate
+}
+
+// NewDeadReckoner returns a DeadReckoner whose Output is buffered to size.
+func NewDeadReckoner(size int) *DeadReckoner {
+	return &DeadReckoner{
+		StationaryAccel: defaultStationaryAccel,
+		StationaryGyro:  defaultStationaryGyro,
+		StationaryHold:  defaultStationaryHold,
+		HighPass:        defaultHighPass,
+
+		fusion: NewFusion(),
+		reset:  make(chan struct{}, 1),
+		out:    make(chan Estimate, size),
+	}
+}
+
+// Output delivers an Estimate per sample. Estimates are dropped rather
+// than stalling the integrator when the reader falls behind.
+func (d *DeadReckoner) Output() <-chan Estimate {
+	return d.out
+}
+
+// Reset zeroes velocity and displacement before the next sample.
+// It is safe to call from any goroutine.
+func (d *DeadReckoner) Reset() {
+	select {
+	case d.reset <- struct{}{}:
+	default:
+		// A reset is already pending.
+	}
+}
+
+// Run integrates samples from in until it is closed, then closes Output.
+func (d *DeadReckoner) Run(in <-chan sensor.Sample) {
+	defer close(d.out)
+
+	for s := range in {
+		select {
+		case <-d.reset:
+			d.clear()
+		default:
+		}
+
+		select {
+		case d.out <- d.Update(s):
+		default:
+		}
+	}
+}
+
+func (d *DeadReckoner) clear() {
+	d.fusion.Reset()
+	d.last = time.Time{}
+	d.stillFor = 0
+	d.rawVel = Vector{}
+	d.vel = Vector{}
+	d.pos = Vector{}
+	d.prevAccel = Vector{}
+}
+
+// Update folds a single sample into the state. Run calls this for every
+// sample; use it directly only when driving the integrator by hand.
+func (d *DeadReckoner) Update(s sensor.Sample) Estimate {
+	q := d.fusion.Update(s)
+	ax, ay, az := s.Acceleration.GetValues()
+	gx, gy, gz := s.Gyro.GetValues()
+
+	accel := EarthAcceleration(Vector{ax, ay, az}, q, ENU).Scale(StandardGravity)
+	est := Estimate{Seq: s.Seq, Time: s.Time}
+
+	if d.last.IsZero() {
+		d.last = s.Time
+		d.prevAccel = accel
+		est.Stationary = true
+		return est
+	}
+
+	dt := s.Time.Sub(d.last)
+	d.last = s.Time
+	if dt <= 0 || dt > maxStep {
+		d.prevAccel = accel
+		est.Velocity, est.Displacement = d.vel, d.pos
+		return est
+	}
+
+	still := accel.Norm()/StandardGravity < d.StationaryAccel &&
+		Vector{gx, gy, gz}.Norm() < d.StationaryGyro
+	if still {
+		d.stillFor += dt
+	} else {
+		d.stillFor = 0
+	}
+
+	secs := dt.Seconds()
+	if d.stillFor >= d.StationaryHold {
+		// Zero-velocity update: we know we aren't moving, so any
+		// velocity left over is accumulated error.
+		d.rawVel = Vector{}
+		d.vel = Vector{}
+		est.Stationary = true
+	} else {
+		// Trapezoidal integration of acceleration.
+		prevRaw := d.rawVel
+		d.rawVel = d.rawVel.Add(accel.Add(d.prevAccel).Scale(secs / 2))
+
+		if d.HighPass > 0 {
+			rc := 1 / (2 * math.Pi * d.HighPass)
+			alpha := rc / (rc + secs)
+			d.vel = d.vel.Add(d.rawVel.Sub(prevRaw)).Scale(alpha)
+		} else {
+			d.vel = d.rawVel
+		}
+	}
+
+	d.pos = d.pos.Add(d.vel.Scale(secs))
+	d.prevAccel = accel
+
+	est.Velocity, est.Displacement = d.vel, d.pos
+	return est
+}
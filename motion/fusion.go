@@ -0,0 +1,91 @@
+package motion
+
+import (
+	"time"
+
+	"github.com/alexsasharegan/gophx-xxws/sensor"
+)
+
+const (
+	// Default proportional gain pulling the gyro estimate towards gravity.
+	defaultGain = 1.0
+
+	// Ignore sample gaps larger than this; integrating them only adds error.
+	maxStep = 250 * time.Millisecond
+)
+
+// Fusion estimates orientation from accelerometer and gyroscope samples
+// with a complementary (Mahony style, proportional only) filter. The gyro
+// gives smooth short-term rotation while gravity corrects roll and pitch
+// drift. Yaw is gyro-only and will slowly wander.
+type Fusion struct {
+	// Gain weighs the accelerometer correction. Higher trusts gravity more.
+	Gain float64
+
+	q    Quaternion
+	last time.Time
+}
+
+// NewFusion returns a Fusion with the default gain.
+func NewFusion() *Fusion {
+	return &Fusion{Gain: defaultGain}
+}
+
+// Orientation returns the current estimate.
+func (f *Fusion) Orientation() Quaternion {
+	if f.last.IsZero() {
+		return Identity
+	}
+
+	return f.q
+}
+
+// Reset forgets the current estimate; the next sample re-seeds it.
+func (f *Fusion) Reset() {
+	f.last = time.Time{}
+}
+
+// Update folds a sample into the estimate and returns the new orientation.
+func (f *Fusion) Update(s sensor.Sample) Quaternion {
+	ax, ay, az := s.Acceleration.GetValues()
+	gx, gy, gz := s.Gyro.GetValues()
+
+	return f.Step(Vector{ax, ay, az}, Vector{gx, gy, gz}, s.Time)
+}
+
+// Step is Update for callers holding plain vectors: accel in g and
+// gyro in °/s, both in the device frame, taken at time t.
+func (f *Fusion) Step(accel, gyro Vector, t time.Time) Quaternion {
+	if f.last.IsZero() {
+		// Seed from gravity alone so we don't spend seconds converging.
+		f.q = fromTo(accel, up)
+		f.last = t
+		return f.q
+	}
+
+	dt := t.Sub(f.last)
+	f.last = t
+	if dt <= 0 || dt > maxStep {
+		return f.q
+	}
+
+	omega := gyro.Scale(degToRad)
+
+	// Where the estimate thinks "up" is, compared with where the
+	// accelerometer says it is. The cross product is the correcting axis.
+	if n := accel.Norm(); n > 0 {
+		err := accel.Unit().Cross(f.q.Unrotate(up))
+		omega = omega.Add(err.Scale(f.Gain))
+	}
+
+	half := dt.Seconds() / 2
+	dq := f.q.Mul(Quaternion{X: omega[0], Y: omega[1], Z: omega[2]})
+	f.q = Quaternion{
+		W: f.q.W + dq.W*half,
+		X: f.q.X + dq.X*half,
+		Y: f.q.Y + dq.Y*half,
+		Z: f.q.Z + dq.Z*half,
+	}.Normalize()
+
+	return f.q
+}
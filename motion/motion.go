@@ -0,0 +1,172 @@
+// Package motion derives orientation and movement from raw sensor samples.
+// Resources:
+// https://x-io.co.uk/open-source-imu-and-ahrs-algorithms/
+// https://en.wikipedia.org/wiki/Quaternions_and_spatial_rotation
+package motion
+
+import (
+	"math"
+)
+
+const (
+	// StandardGravity converts accelerations in g to m/s².
+	StandardGravity = 9.80665
+
+	// coefficient for converting degrees to radians
+	degToRad = math.Pi / 180
+)
+
+// Frame names the axis convention of an earth-fixed frame.
+// Without a magnetometer, "north" is wherever the device pointed at startup.
+type Frame int
+
+const (
+	// ENU is east, north, up.
+	ENU Frame = iota
+	// NED is north, east, down.
+	NED
+)
+
+// Vector is an x, y, z triple.
+type Vector [3]float64
+
+// Add returns v + w.
+func (v Vector) Add(w Vector) Vector {
+	return Vector{v[0] + w[0], v[1] + w[1], v[2] + w[2]}
+}
+
+// Sub returns v - w.
+func (v Vector) Sub(w Vector) Vector {
+	return Vector{v[0] - w[0], v[1] - w[1], v[2] - w[2]}
+}
+
+// Scale returns v multiplied by k.
+func (v Vector) Scale(k float64) Vector {
+	return Vector{v[0] * k, v[1] * k, v[2] * k}
+}
+
+// Cross returns the cross product v × w.
+func (v Vector) Cross(w Vector) Vector {
+	return Vector{
+		v[1]*w[2] - v[2]*w[1],
+		v[2]*w[0] - v[0]*w[2],
+		v[0]*w[1] - v[1]*w[0],
+	}
+}
+
+// Dot returns the dot product v · w.
+func (v Vector) Dot(w Vector) float64 {
+	return v[0]*w[0] + v[1]*w[1] + v[2]*w[2]
+}
+
+// Norm returns the length of v.
+func (v Vector) Norm() float64 {
+	return math.Sqrt(v.Dot(v))
+}
+
+// Unit returns v scaled to length 1, or the zero vector.
+func (v Vector) Unit() Vector {
+	n := v.Norm()
+	if n == 0 {
+		return v
+	}
+
+	return v.Scale(1 / n)
+}
+
+// Quaternion is a rotation from the device (body) frame into the earth frame.
+type Quaternion struct {
+	W, X, Y, Z float64
+}
+
+// Identity is the orientation of a device lying flat, facing "north".
+var Identity = Quaternion{W: 1}
+
+// Mul returns the Hamilton product q ⊗ r.
+func (q Quaternion) Mul(r Quaternion) Quaternion {
+	return Quaternion{
+		W: q.W*r.W - q.X*r.X - q.Y*r.Y - q.Z*r.Z,
+		X: q.W*r.X + q.X*r.W + q.Y*r.Z - q.Z*r.Y,
+		Y: q.W*r.Y - q.X*r.Z + q.Y*r.W + q.Z*r.X,
+		Z: q.W*r.Z + q.X*r.Y - q.Y*r.X + q.Z*r.W,
+	}
+}
+
+// Conj returns the inverse rotation of a unit quaternion.
+func (q Quaternion) Conj() Quaternion {
+	return Quaternion{W: q.W, X: -q.X, Y: -q.Y, Z: -q.Z}
+}
+
+// Normalize returns q scaled to unit length.
+func (q Quaternion) Normalize() Quaternion {
+	n := math.Sqrt(q.W*q.W + q.X*q.X + q.Y*q.Y + q.Z*q.Z)
+	if n == 0 {
+		return Identity
+	}
+
+	return Quaternion{W: q.W / n, X: q.X / n, Y: q.Y / n, Z: q.Z / n}
+}
+
+// Rotate takes v from the body frame into the earth frame.
+func (q Quaternion) Rotate(v Vector) Vector {
+	p := q.Mul(Quaternion{X: v[0], Y: v[1], Z: v[2]}).Mul(q.Conj())
+	return Vector{p.X, p.Y, p.Z}
+}
+
+// Unrotate takes v from the earth frame into the body frame.
+func (q Quaternion) Unrotate(v Vector) Vector {
+	return q.Conj().Rotate(v)
+}
+
+// Euler returns roll, pitch and yaw in degrees.
+func (q Quaternion) Euler() (roll, pitch, yaw float64) {
+	roll = math.Atan2(2*(q.W*q.X+q.Y*q.Z), 1-2*(q.X*q.X+q.Y*q.Y))
+	sp := 2 * (q.W*q.Y - q.Z*q.X)
+	if sp > 1 {
+		sp = 1
+	} else if sp < -1 {
+		sp = -1
+	}
+	pitch = math.Asin(sp)
+	yaw = math.Atan2(2*(q.W*q.Z+q.X*q.Y), 1-2*(q.Y*q.Y+q.Z*q.Z))
+
+	return roll / degToRad, pitch / degToRad, yaw / degToRad
+}
+
+// fromTo returns the shortest rotation taking the direction of v onto w.
+func fromTo(v, w Vector) Quaternion {
+	v, w = v.Unit(), w.Unit()
+	c := v.Cross(w)
+	d := v.Dot(w)
+	if d < -0.999999 {
+		// Opposite vectors; half turn about any perpendicular axis.
+		axis := Vector{1, 0, 0}.Cross(v)
+		if axis.Norm() < 1e-6 {
+			axis = Vector{0, 1, 0}.Cross(v)
+		}
+		axis = axis.Unit()
+		return Quaternion{X: axis[0], Y: axis[1], Z: axis[2]}
+	}
+
+	return Quaternion{W: 1 + d, X: c[0], Y: c[1], Z: c[2]}.Normalize()
+}
+
+// up is the earth frame z axis, where a resting accelerometer reads +1g.
+var up = Vector{0, 0, 1}
+
+// LinearAcceleration removes gravity from a body frame acceleration (in g),
+// leaving only the acceleration caused by movement, still in the body frame.
+func LinearAcceleration(accel Vector, q Quaternion) Vector {
+	return accel.Sub(q.Unrotate(up))
+}
+
+// EarthAcceleration rotates a body frame acceleration (in g) into the earth
+// frame and removes gravity.
+func EarthAcceleration(accel Vector, q Quaternion, f Frame) Vector {
+	e := q.Rotate(accel).Sub(up)
+	if f == NED {
+		return Vector{e[1], e[0], -e[2]}
+	}
+
+	return e
+}
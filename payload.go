@@ -0,0 +1,146 @@
+package main
+
+import (
+	"github.com/alexsasharegan/gophx-xxws/motion"
+	"github.com/alexsasharegan/gophx-xxws/pipeline"
+	"github.com/alexsasharegan/gophx-xxws/sensor"
+)
+
+// Topics a sample is split into. Clients subscribe to the ones they need
+// (see ws.TopicAll); the dashboard merges whatever arrives.
+const (
+	topicAccel       = "accel"
+	topicGyro        = "gyro"
+	topicOrientation = "orientation"
+	topicTemperature = "temperature"
+	topicEvents      = "events"
+	topicMotion      = "motion"
+)
+
+// sensorTopic is the per-device topic every message is also published on.
+func sensorTopic(id string) string {
+	return "sensor:" + id
+}
+
+// topicData is one topic's share of a sample.
+type topicData struct {
+	topic string
+	data  interface{}
+}
+
+type motionData struct {
+	Motion motion.Estimate `json:"motion"`
+}
+
+type accelerationData struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+
+	Rotation []float64 `json:"rotation"`
+}
+
+type vectorData struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+}
+
+type gyroData struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+}
+
+type orientationData struct {
+	W float64 `json:"w"`
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+
+	Roll  float64 `json:"roll"`
+	Pitch float64 `json:"pitch"`
+	Yaw   float64 `json:"yaw"`
+}
+
+type accelMessage struct {
+	Acceleration *accelerationData `json:"acceleration"`
+
+	// Optional derived values, only present when a stage provides them.
+	Linear *vectorData `json:"linear,omitempty"`
+	Earth  *vectorData `json:"earth,omitempty"`
+}
+
+type gyroMessage struct {
+	Gyro *gyroData `json:"gyro"`
+}
+
+type orientationMessage struct {
+	Orientation *orientationData `json:"orientation"`
+}
+
+type temperatureMessage struct {
+	Temperature float64 `json:"temperature"`
+}
+
+type eventsMessage struct {
+	Events []pipeline.Event `json:"events"`
+}
+
+// getData splits a processed sample into per-topic payloads. Topics
+// without data for this sample (e.g. no events) are left out.
+func getData(s pipeline.Sample) []topicData {
+	ax, ay, az := s.Accel[0], s.Accel[1], s.Accel[2]
+	accel := sensor.NewAcceleration(ax, ay, az)
+	xr := accel.GetXRotation()
+	yr := accel.GetYRotation()
+	gx, gy, gz := s.Gyro[0], s.Gyro[1], s.Gyro[2]
+
+	am := accelMessage{
+		Acceleration: &accelerationData{
+			X:        ax,
+			Y:        ay,
+			Z:        az,
+			Rotation: []float64{xr, yr},
+		},
+	}
+	if v := s.Linear; v != nil {
+		am.Linear = &vectorData{X: v[0], Y: v[1], Z: v[2]}
+	}
+	if v := s.Earth; v != nil {
+		am.Earth = &vectorData{X: v[0], Y: v[1], Z: v[2]}
+	}
+
+	data := []topicData{
+		{topicAccel, am},
+		{topicGyro, gyroMessage{
+			Gyro: &gyroData{
+				X: gx,
+				Y: gy,
+				Z: gz,
+			},
+		}},
+		{topicTemperature, temperatureMessage{Temperature: s.Temperature}},
+	}
+
+	if q := s.Orientation; q != nil {
+		roll, pitch, yaw := q.Euler()
+		data = append(data, topicData{topicOrientation, orientationMessage{
+			Orientation: &orientationData{
+				W:     q.W,
+				X:     q.X,
+				Y:     q.Y,
+				Z:     q.Z,
+				Roll:  roll,
+				Pitch: pitch,
+				Yaw:   yaw,
+			},
+		}})
+	}
+
+	if len(s.Events) > 0 {
+		data = append(data, topicData{topicEvents, eventsMessage{Events: s.Events}})
+	}
+
+	return data
+}
@@ -0,0 +1,126 @@
+package pipeline
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Config describes a pipeline as read from a JSON file:
+//
+//	{
+//	  "stages": [
+//	    {"type": "median", "window": 5},
+//	    {"type": "biquad", "mode": "lowpass", "cutoff": 10, "rate": 120},
+//	    {"type": "fusion", "linear": true, "earth": "enu"},
+//	    {"type": "threshold", "field": "linear", "level": 1.5, "holdoff_ms": 250}
+//	  ]
+//	}
+//
+// Filter stages take an optional "fields" list naming which of accel,
+// gyro, linear and earth they apply to; the default is accel and gyro.
+type Config struct {
+	Stages []StageConfig `json:"stages"`
+}
+
+// StageConfig holds the parameters of every stage type. Each type only
+// reads the fields it needs.
+type StageConfig struct {
+	Type   string   `json:"type"`
+	Fields []string `json:"fields"`
+
+	// moving_average, median
+	Window int `json:"window"`
+	// ema
+	Alpha float64 `json:"alpha"`
+	// biquad
+	Mode   string  `json:"mode"`
+	Cutoff float64 `json:"cutoff"`
+	Q      float64 `json:"q"`
+	// biquad, resample
+	Rate float64 `json:"rate"`
+	// kalman
+	ProcessNoise     float64 `json:"process_noise"`
+	MeasurementNoise float64 `json:"measurement_noise"`
+	// fusion
+	Gain   float64 `json:"gain"`
+	Linear bool    `json:"linear"`
+	Earth  string  `json:"earth"`
+	// threshold, freefall
+	Kind       string  `json:"kind"`
+	Field      string  `json:"field"`
+	Level      float64 `json:"level"`
+	HoldoffMS  int     `json:"holdoff_ms"`
+	DurationMS int     `json:"duration_ms"`
+}
+
+// Default Butterworth quality for biquads that don't specify one.
+const defaultQ = 0.7071
+
+// Load reads a Config from the JSON file at path and builds it.
+func Load(path string) (*Pipeline, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var cfg Config
+	dec := json.NewDecoder(f)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&cfg); err != nil {
+		return nil, fmt.Errorf("parsing pipeline config %s: %v", path, err)
+	}
+
+	return Build(cfg)
+}
+
+// Build assembles the stages described by cfg.
+func Build(cfg Config) (*Pipeline, error) {
+	stages := make([]Stage, len(cfg.Stages))
+	for i, sc := range cfg.Stages {
+		s, err := buildStage(sc)
+		if err != nil {
+			return nil, fmt.Errorf("stage %d: %v", i, err)
+		}
+		stages[i] = s
+	}
+
+	return New(stages...), nil
+}
+
+func buildStage(c StageConfig) (Stage, error) {
+	for _, f := range c.Fields {
+		if !fieldNames[f] {
+			return nil, fmt.Errorf("%s: unknown field %q", c.Type, f)
+		}
+	}
+
+	switch c.Type {
+	case "moving_average":
+		return NewMovingAverage(c.Window, c.Fields...)
+	case "ema":
+		return NewEMA(c.Alpha, c.Fields...)
+	case "median":
+		return NewMedian(c.Window, c.Fields...)
+	case "biquad":
+		q := c.Q
+		if q == 0 {
+			q = defaultQ
+		}
+		return NewBiquad(c.Mode, c.Cutoff, c.Rate, q, c.Fields...)
+	case "kalman":
+		return NewKalman(c.ProcessNoise, c.MeasurementNoise, c.Fields...)
+	case "resample":
+		return NewResample(c.Rate)
+	case "fusion":
+		return NewFusion(c.Gain, c.Linear, c.Earth)
+	case "threshold":
+		return NewThreshold(c.Kind, c.Field, c.Level, time.Duration(c.HoldoffMS)*time.Millisecond)
+	case "freefall":
+		return NewFreefall(c.Level, time.Duration(c.DurationMS)*time.Millisecond)
+	}
+
+	return nil, fmt.Errorf("unknown stage type %q", c.Type)
+}
@@ -0,0 +1,104 @@
+package pipeline
+
+import (
+	"fmt"
+	"time"
+)
+
+// threshold raises an event when a field's magnitude rises above a level.
+type threshold struct {
+	kind    string
+	field   string
+	level   float64
+	holdoff time.Duration
+
+	above bool
+	last  time.Time
+}
+
+// NewThreshold returns a detector raising an event of the given kind each
+// time the magnitude of field crosses above level. Crossings closer than
+// holdoff to the previous event are ignored.
+func NewThreshold(kind, field string, level float64, holdoff time.Duration) (Stage, error) {
+	if !fieldNames[field] {
+		return nil, fmt.Errorf("threshold: unknown field %q", field)
+	}
+	if kind == "" {
+		kind = field + "_threshold"
+	}
+
+	return &threshold{kind: kind, field: field, level: level, holdoff: holdoff}, nil
+}
+
+func (t *threshold) Name() string {
+	return fmt.Sprintf("threshold(%s>%v)", t.field, t.level)
+}
+
+func (t *threshold) Process(s Sample) []Sample {
+	v := s.field(t.field)
+	if v == nil {
+		return []Sample{s}
+	}
+
+	m := v.Norm()
+	if m <= t.level {
+		t.above = false
+		return []Sample{s}
+	}
+
+	if !t.above && s.Time.Sub(t.last) >= t.holdoff {
+		s.Events = append(s.Events[:len(s.Events):len(s.Events)], Event{
+			Kind:  t.kind,
+			Value: m,
+			Time:  s.Time,
+		})
+		t.last = s.Time
+	}
+	t.above = true
+
+	return []Sample{s}
+}
+
+// freefall raises an event when total acceleration stays near zero.
+type freefall struct {
+	level    float64
+	duration time.Duration
+
+	since time.Time
+	fired bool
+}
+
+// NewFreefall returns a detector raising a "freefall" event once the
+// acceleration magnitude has stayed below level (in g) for duration.
+func NewFreefall(level float64, duration time.Duration) (Stage, error) {
+	if level <= 0 {
+		return nil, fmt.Errorf("freefall: level must be positive, got %v", level)
+	}
+
+	return &freefall{level: level, duration: duration}, nil
+}
+
+func (f *freefall) Name() string {
+	return fmt.Sprintf("freefall(<%vg)", f.level)
+}
+
+func (f *freefall) Process(s Sample) []Sample {
+	if s.Accel.Norm() >= f.level {
+		f.since, f.fired = time.Time{}, false
+		return []Sample{s}
+	}
+
+	if f.since.IsZero() {
+		f.since = s.Time
+	}
+	if !f.fired && s.Time.Sub(f.since) >= f.duration {
+		s.Events = append(s.Events[:len(s.Events):len(s.Events)], Event{
+			Kind:  "freefall",
+			Value: s.Time.Sub(f.since).Seconds(),
+			Time:  s.Time,
+		})
+		f.fired = true
+	}
+
+	return []Sample{s}
+}
@@ -0,0 +1,267 @@
+package pipeline
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// scalar is a single-channel filter, one per axis per field.
+type scalar interface {
+	step(x float64) float64
+}
+
+// filterStage applies an independent scalar filter to each axis of the
+// selected fields.
+type filterStage struct {
+	name      string
+	fields    []string
+	newScalar func() scalar
+	state     map[string]*[3]scalar
+}
+
+func newFilterStage(name string, fields []string, newScalar func() scalar) *filterStage {
+	if len(fields) == 0 {
+		fields = []string{"accel", "gyro"}
+	}
+
+	return &filterStage{
+		name:      name,
+		fields:    fields,
+		newScalar: newScalar,
+		state:     make(map[string]*[3]scalar),
+	}
+}
+
+func (f *filterStage) Name() string {
+	return f.name
+}
+
+func (f *filterStage) Process(s Sample) []Sample {
+	for _, name := range f.fields {
+		v := s.field(name)
+		if v == nil {
+			continue
+		}
+
+		st, ok := f.state[name]
+		if !ok {
+			st = &[3]scalar{f.newScalar(), f.newScalar(), f.newScalar()}
+			f.state[name] = st
+		}
+
+		var out [3]float64
+		for i := range out {
+			out[i] = st[i].step(v[i])
+		}
+		s.setField(name, out)
+	}
+
+	return []Sample{s}
+}
+
+// movingAverage is the mean of the last n values.
+type movingAverage struct {
+	buf  []float64
+	next int
+	full bool
+	sum  float64
+}
+
+func (m *movingAverage) step(x float64) float64 {
+	if m.full {
+		m.sum -= m.buf[m.next]
+	}
+	m.buf[m.next] = x
+	m.sum += x
+	m.next = (m.next + 1) % len(m.buf)
+	if m.next == 0 {
+		m.full = true
+	}
+
+	n := len(m.buf)
+	if !m.full {
+		n = m.next
+	}
+
+	return m.sum / float64(n)
+}
+
+// NewMovingAverage returns a stage averaging the last window samples.
+func NewMovingAverage(window int, fields ...string) (Stage, error) {
+	if window < 1 {
+		return nil, fmt.Errorf("moving_average: window must be positive, got %d", window)
+	}
+
+	return newFilterStage(fmt.Sprintf("moving_average(%d)", window), fields, func() scalar {
+		return &movingAverage{buf: make([]float64, window)}
+	}), nil
+}
+
+// ema is an exponential moving average.
+type ema struct {
+	alpha  float64
+	value  float64
+	primed bool
+}
+
+func (e *ema) step(x float64) float64 {
+	if !e.primed {
+		e.value, e.primed = x, true
+		return x
+	}
+	e.value += e.alpha * (x - e.value)
+
+	return e.value
+}
+
+// NewEMA returns an exponential moving average stage. Alpha in (0, 1] is
+// the weight of each new sample.
+func NewEMA(alpha float64, fields ...string) (Stage, error) {
+	if alpha <= 0 || alpha > 1 {
+		return nil, fmt.Errorf("ema: alpha must be in (0, 1], got %v", alpha)
+	}
+
+	return newFilterStage(fmt.Sprintf("ema(%v)", alpha), fields, func() scalar {
+		return &ema{alpha: alpha}
+	}), nil
+}
+
+// median is the median of the last n values. Good at removing spikes.
+type median struct {
+	buf    []float64
+	sorted []float64
+	next   int
+	full   bool
+}
+
+func (m *median) step(x float64) float64 {
+	m.buf[m.next] = x
+	m.next = (m.next + 1) % len(m.buf)
+	if m.next == 0 {
+		m.full = true
+	}
+
+	n := len(m.buf)
+	if !m.full {
+		n = m.next
+	}
+
+	m.sorted = append(m.sorted[:0], m.buf[:n]...)
+	sort.Float64s(m.sorted)
+	if n%2 == 1 {
+		return m.sorted[n/2]
+	}
+
+	return (m.sorted[n/2-1] + m.sorted[n/2]) / 2
+}
+
+// NewMedian returns a stage taking the median of the last window samples.
+func NewMedian(window int, fields ...string) (Stage, error) {
+	if window < 1 {
+		return nil, fmt.Errorf("median: window must be positive, got %d", window)
+	}
+
+	return newFilterStage(fmt.Sprintf("median(%d)", window), fields, func() scalar {
+		return &median{buf: make([]float64, window)}
+	}), nil
+}
+
+// biquad is a second order IIR section in transposed direct form II.
+// Coefficients are from the RBJ audio EQ cookbook:
+// https://www.w3.org/TR/audio-eq-cookbook/
+type biquad struct {
+	b0, b1, b2, a1, a2 float64
+	z1, z2             float64
+	primed             bool
+}
+
+func (b *biquad) step(x float64) float64 {
+	if !b.primed {
+		// Start from steady state at the first input rather than from
+		// zero, which would ring like a step from 0 to x.
+		dc := (b.b0 + b.b1 + b.b2) / (1 + b.a1 + b.a2)
+		y := dc * x
+		b.z2 = b.b2*x - b.a2*y
+		b.z1 = b.b1*x - b.a1*y + b.z2
+		b.primed = true
+	}
+
+	y := b.b0*x + b.z1
+	b.z1 = b.b1*x - b.a1*y + b.z2
+	b.z2 = b.b2*x - b.a2*y
+
+	return y
+}
+
+// NewBiquad returns a low or high pass stage. Mode is "lowpass" or
+// "highpass", cutoff and rate are in Hz and q is the filter's quality
+// (0.7071 for Butterworth).
+func NewBiquad(mode string, cutoff, rate, q float64, fields ...string) (Stage, error) {
+	if rate <= 0 || cutoff <= 0 || cutoff >= rate/2 {
+		return nil, fmt.Errorf("biquad: cutoff must be in (0, %v), got %v", rate/2, cutoff)
+	}
+	if q <= 0 {
+		return nil, fmt.Errorf("biquad: q must be positive, got %v", q)
+	}
+
+	w0 := 2 * math.Pi * cutoff / rate
+	sin, cos := math.Sincos(w0)
+	alpha := sin / (2 * q)
+	a0 := 1 + alpha
+
+	var c biquad
+	switch mode {
+	case "lowpass":
+		c.b0 = (1 - cos) / 2 / a0
+		c.b1 = (1 - cos) / a0
+		c.b2 = c.b0
+	case "highpass":
+		c.b0 = (1 + cos) / 2 / a0
+		c.b1 = -(1 + cos) / a0
+		c.b2 = c.b0
+	default:
+		return nil, fmt.Errorf("biquad: unknown mode %q", mode)
+	}
+	c.a1 = -2 * cos / a0
+	c.a2 = (1 - alpha) / a0
+
+	return newFilterStage(fmt.Sprintf("%s(%vHz)", mode, cutoff), fields, func() scalar {
+		b := c
+		return &b
+	}), nil
+}
+
+// kalman is a one dimensional Kalman filter with a random walk model.
+type kalman struct {
+	q, r   float64
+	x, p   float64
+	primed bool
+}
+
+func (k *kalman) step(z float64) float64 {
+	if !k.primed {
+		k.x, k.p, k.primed = z, k.r, true
+		return z
+	}
+
+	k.p += k.q
+	gain := k.p / (k.p + k.r)
+	k.x += gain * (z - k.x)
+	k.p *= 1 - gain
+
+	return k.x
+}
+
+// NewKalman returns a stage smoothing each axis with a scalar Kalman
+// filter. processNoise is how much the true value is expected to wander
+// per sample, measurementNoise how noisy the sensor is (both variances).
+func NewKalman(processNoise, measurementNoise float64, fields ...string) (Stage, error) {
+	if processNoise <= 0 || measurementNoise <= 0 {
+		return nil, fmt.Errorf("kalman: noise variances must be positive")
+	}
+
+	return newFilterStage("kalman", fields, func() scalar {
+		return &kalman{q: processNoise, r: measurementNoise}
+	}), nil
+}
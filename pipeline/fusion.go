@@ -0,0 +1,55 @@
+package pipeline
+
+import (
+	"fmt"
+
+	"github.com/alexsasharegan/gophx-xxws/motion"
+)
+
+// fusion estimates orientation and fills in the derived fields.
+type fusion struct {
+	f      *motion.Fusion
+	linear bool
+	earth  string
+}
+
+// NewFusion returns a stage setting Orientation on every sample, plus
+// Linear when linear is set and Earth when earth is "enu" or "ned".
+// A gain of zero keeps the default.
+func NewFusion(gain float64, linear bool, earth string) (Stage, error) {
+	if earth != "" && earth != "enu" && earth != "ned" {
+		return nil, fmt.Errorf("fusion: unknown earth frame %q", earth)
+	}
+
+	f := motion.NewFusion()
+	if gain > 0 {
+		f.Gain = gain
+	}
+
+	return &fusion{f: f, linear: linear, earth: earth}, nil
+}
+
+func (f *fusion) Name() string {
+	return "fusion"
+}
+
+func (f *fusion) Process(s Sample) []Sample {
+	q := f.f.Step(s.Accel, s.Gyro, s.Time)
+	s.Orientation = &q
+
+	if f.linear {
+		v := motion.LinearAcceleration(s.Accel, q)
+		s.Linear = &v
+	}
+
+	switch f.earth {
+	case "enu":
+		v := motion.EarthAcceleration(s.Accel, q, motion.ENU)
+		s.Earth = &v
+	case "ned":
+		v := motion.EarthAcceleration(s.Accel, q, motion.NED)
+		s.Earth = &v
+	}
+
+	return []Sample{s}
+}
@@ -0,0 +1,204 @@
+// Package pipeline chains signal-processing stages between the sensor and
+// whatever consumes its samples. Stages are assembled from a config file at
+// startup; see Load.
+package pipeline
+
+import (
+	"sync"
+	"time"
+
+	"github.com/alexsasharegan/gophx-xxws/motion"
+	"github.com/alexsasharegan/gophx-xxws/sensor"
+)
+
+// Sample is the unit of data flowing through a Pipeline.
+type Sample struct {
+	Seq  uint64
+	Time time.Time
+
+	// Acceleration in g and rotation rate in °/s, device frame.
+	Accel motion.Vector
+	Gyro  motion.Vector
+	// Die temperature in °C.
+	Temperature float64
+
+	// Derived values, filled in by stages such as fusion.
+	Orientation *motion.Quaternion
+	Linear      *motion.Vector
+	Earth       *motion.Vector
+
+	// Events raised by detector stages for this sample.
+	Events []Event
+}
+
+// Event is something a detector noticed in the stream.
+type Event struct {
+	Kind  string    `json:"kind"`
+	Value float64   `json:"value"`
+	Time  time.Time `json:"time"`
+}
+
+// FromSensor converts a raw sensor readout into a pipeline Sample.
+func FromSensor(s sensor.Sample) Sample {
+	ax, ay, az := s.Acceleration.GetValues()
+	gx, gy, gz := s.Gyro.GetValues()
+
+	return Sample{
+		Seq:   s.Seq,
+		Time:  s.Time,
+		Accel: motion.Vector{ax, ay, az},
+		Gyro:  motion.Vector{gx, gy, gz},
+
+		Temperature: s.Temperature,
+	}
+}
+
+// fieldNames are the vectors stages can address by name.
+var fieldNames = map[string]bool{
+	"accel":  true,
+	"gyro":   true,
+	"linear": true,
+	"earth":  true,
+}
+
+// field returns the named vector, or nil if it isn't present.
+func (s *Sample) field(name string) *motion.Vector {
+	switch name {
+	case "accel":
+		return &s.Accel
+	case "gyro":
+		return &s.Gyro
+	case "linear":
+		return s.Linear
+	case "earth":
+		return s.Earth
+	}
+
+	return nil
+}
+
+// setField replaces the named vector. Optional fields are reallocated so
+// samples sharing a pointer upstream are left untouched.
+func (s *Sample) setField(name string, v motion.Vector) {
+	switch name {
+	case "accel":
+		s.Accel = v
+	case "gyro":
+		s.Gyro = v
+	case "linear":
+		s.Linear = &v
+	case "earth":
+		s.Earth = &v
+	}
+}
+
+// Stage is a single processing step. Process takes one sample and emits
+// zero (e.g. while a window fills), one or many (e.g. upsampling) samples.
+// Stages are driven from one goroutine and need not be concurrency safe.
+type Stage interface {
+	Name() string
+	Process(s Sample) []Sample
+}
+
+// StageLatency reports the time a stage spends processing.
+type StageLatency struct {
+	Name  string        `json:"name"`
+	Count uint64        `json:"count"`
+	Last  time.Duration `json:"last"`
+	Mean  time.Duration `json:"mean"`
+	Max   time.Duration `json:"max"`
+}
+
+// Pipeline runs samples through an ordered chain of stages.
+type Pipeline struct {
+	stages []Stage
+
+	// Guards stats, which are read from other goroutines.
+	mu    sync.Mutex
+	stats []StageLatency
+	total []time.Duration
+}
+
+// New returns a Pipeline running the given stages in order.
+// A Pipeline with no stages passes samples through unchanged.
+func New(stages ...Stage) *Pipeline {
+	p := &Pipeline{
+		stages: stages,
+		stats:  make([]StageLatency, len(stages)),
+		total:  make([]time.Duration, len(stages)),
+	}
+	for i, s := range stages {
+		p.stats[i].Name = s.Name()
+	}
+
+	return p
+}
+
+// Append adds stages to the end of the chain. It must not be called
+// while samples are being processed.
+func (p *Pipeline) Append(stages ...Stage) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, s := range stages {
+		p.stages = append(p.stages, s)
+		p.stats = append(p.stats, StageLatency{Name: s.Name()})
+		p.total = append(p.total, 0)
+	}
+}
+
+// Stages returns the names of the stages in order.
+func (p *Pipeline) Stages() []string {
+	names := make([]string, len(p.stages))
+	for i, s := range p.stages {
+		names[i] = s.Name()
+	}
+
+	return names
+}
+
+// Process runs s through every stage and returns whatever comes out the end.
+func (p *Pipeline) Process(s Sample) []Sample {
+	batch := []Sample{s}
+
+	for i, st := range p.stages {
+		var next []Sample
+		start := time.Now()
+		for _, b := range batch {
+			next = append(next, st.Process(b)...)
+		}
+		p.record(i, time.Since(start))
+
+		batch = next
+		if len(batch) == 0 {
+			break
+		}
+	}
+
+	return batch
+}
+
+func (p *Pipeline) record(i int, d time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	st := &p.stats[i]
+	st.Count++
+	st.Last = d
+	if d > st.Max {
+		st.Max = d
+	}
+	p.total[i] += d
+	st.Mean = p.total[i] / time.Duration(st.Count)
+}
+
+// Latencies returns a snapshot of per-stage processing times.
+func (p *Pipeline) Latencies() []StageLatency {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	out := make([]StageLatency, len(p.stats))
+	copy(out, p.stats)
+
+	return out
+}
@@ -0,0 +1,93 @@
+package pipeline
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alexsasharegan/gophx-xxws/motion"
+)
+
+// Gaps longer than this restart the output clock instead of being filled.
+const maxResampleGap = time.Second
+
+// resample emits samples on a fixed clock, linearly interpolating between
+// the inputs either side of each tick.
+type resample struct {
+	rate   float64
+	period time.Duration
+	prev   *Sample
+	next   time.Time
+
+	// Events seen on inputs that haven't produced an output yet.
+	pending []Event
+}
+
+// NewResample returns a stage producing samples at rate Hz regardless of
+// the input rate.
+func NewResample(rate float64) (Stage, error) {
+	if rate <= 0 {
+		return nil, fmt.Errorf("resample: rate must be positive, got %v", rate)
+	}
+
+	return &resample{
+		rate:   rate,
+		period: time.Duration(float64(time.Second) / rate),
+	}, nil
+}
+
+func (r *resample) Name() string {
+	return fmt.Sprintf("resample(%vHz)", r.rate)
+}
+
+func (r *resample) Process(s Sample) []Sample {
+	r.pending = append(r.pending, s.Events...)
+
+	if r.prev == nil || s.Time.Sub(r.prev.Time) > maxResampleGap || !s.Time.After(r.prev.Time) {
+		r.prev = &s
+		r.next = s.Time.Add(r.period)
+		s.Events, r.pending = r.pending, nil
+		return []Sample{s}
+	}
+
+	var out []Sample
+	span := s.Time.Sub(r.prev.Time).Seconds()
+	for !r.next.After(s.Time) {
+		t := r.next.Sub(r.prev.Time).Seconds() / span
+		out = append(out, interpolate(*r.prev, s, t, r.next))
+		r.next = r.next.Add(r.period)
+	}
+	r.prev = &s
+
+	if len(out) > 0 {
+		out[0].Events, r.pending = r.pending, nil
+	}
+
+	return out
+}
+
+// interpolate blends a towards b by t in [0, 1]. Orientation is taken
+// from whichever side is nearer; events are attached by the caller.
+func interpolate(a, b Sample, t float64, at time.Time) Sample {
+	out := b
+	if t < 0.5 {
+		out = a
+	}
+	out.Time = at
+	out.Events = nil
+	out.Accel = lerp(a.Accel, b.Accel, t)
+	out.Gyro = lerp(a.Gyro, b.Gyro, t)
+	if a.Linear != nil && b.Linear != nil {
+		v := lerp(*a.Linear, *b.Linear, t)
+		out.Linear = &v
+	}
+	if a.Earth != nil && b.Earth != nil {
+		v := lerp(*a.Earth, *b.Earth, t)
+		out.Earth = &v
+	}
+
+	return out
+}
+
+func lerp(a, b motion.Vector, t float64) motion.Vector {
+	return a.Add(b.Sub(a).Scale(t))
+}
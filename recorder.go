@@ -0,0 +1,153 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/alexsasharegan/gophx-xxws/sensor"
+)
+
+var errRecording = errors.New("a recording is already in progress")
+
+// recordedSample is one line of a recording.
+type recordedSample struct {
+	Seq         uint64     `json:"seq"`
+	Time        time.Time  `json:"time"`
+	Accel       [3]float64 `json:"accel"`
+	Gyro        [3]float64 `json:"gyro"`
+	Temperature float64    `json:"temperature"`
+}
+
+// recordingStatus describes the recording in progress, if any.
+type recordingStatus struct {
+	Active  bool      `json:"active"`
+	Path    string    `json:"path,omitempty"`
+	Started time.Time `json:"started,omitempty"`
+	Samples uint64    `json:"samples"`
+	Dropped uint64    `json:"dropped"`
+}
+
+// recorder writes raw sensor samples to newline delimited JSON files.
+type recorder struct {
+	dir    string
+	fanout *sensor.Fanout
+
+	mu      sync.Mutex
+	status  recordingStatus
+	sub     *sensor.Subscription
+	stopped chan struct{}
+}
+
+func newRecorder(dir string, fanout *sensor.Fanout) *recorder {
+	return &recorder{dir: dir, fanout: fanout}
+}
+
+// Start opens a new file in dir named after the sensor and the time,
+// and records every sample until Stop.
+func (r *recorder) Start() (recordingStatus, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.status.Active {
+		return r.status, errRecording
+	}
+
+	if err := os.MkdirAll(r.dir, 0755); err != nil {
+		return r.status, err
+	}
+
+	now := time.Now()
+	path := filepath.Join(r.dir, fmt.Sprintf("%s-%s.ndjson", *sensorID, now.Format("20060102-150405")))
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return r.status, err
+	}
+
+	r.status = recordingStatus{Active: true, Path: path, Started: now}
+	r.sub = r.fanout.Subscribe(1<<8, sensor.DropNewest)
+	r.stopped = make(chan struct{})
+	go r.write(f, r.sub, r.stopped)
+
+	log.Println("Recording to ", path)
+
+	return r.status, nil
+}
+
+func (r *recorder) write(f *os.File, sub *sensor.Subscription, stopped chan<- struct{}) {
+	defer close(stopped)
+
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for s := range sub.C {
+		ax, ay, az := s.Acceleration.GetValues()
+		gx, gy, gz := s.Gyro.GetValues()
+		err := enc.Encode(recordedSample{
+			Seq:         s.Seq,
+			Time:        s.Time,
+			Accel:       [3]float64{ax, ay, az},
+			Gyro:        [3]float64{gx, gy, gz},
+			Temperature: s.Temperature,
+		})
+		if err != nil {
+			log.Println(fmt.Sprintf("Error writing recording: %v", err))
+			continue
+		}
+
+		r.mu.Lock()
+		r.status.Samples++
+		r.mu.Unlock()
+	}
+
+	if err := w.Flush(); err != nil {
+		log.Println(fmt.Sprintf("Error writing recording: %v", err))
+	}
+	if err := f.Close(); err != nil {
+		log.Println(fmt.Sprintf("Error closing recording: %v", err))
+	}
+}
+
+// Stop ends the recording in progress, waits for it to be flushed to
+// disk and returns its final status.
+func (r *recorder) Stop() recordingStatus {
+	r.mu.Lock()
+	if !r.status.Active {
+		defer r.mu.Unlock()
+		return r.status
+	}
+	sub, stopped := r.sub, r.stopped
+	r.mu.Unlock()
+
+	sub.Close()
+	<-stopped
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.status.Active = false
+	r.status.Dropped = sub.Dropped()
+	r.sub = nil
+
+	log.Println(fmt.Sprintf("Recorded %d samples to %s", r.status.Samples, r.status.Path))
+
+	return r.status
+}
+
+// Status returns the state of the current or last recording.
+func (r *recorder) Status() recordingStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s := r.status
+	if r.sub != nil {
+		s.Dropped = r.sub.Dropped()
+	}
+
+	return s
+}
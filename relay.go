@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/alexsasharegan/gophx-xxws/ws"
+)
+
+// parseUpstreams reads a comma separated list of "<source>=<url>" pairs,
+// e.g. "pi-1=ws://pi-1.local:3000/ws,pi-2=ws://pi-2.local:3000/ws".
+func parseUpstreams(s string) ([]*ws.Relay, error) {
+	var relays []*ws.Relay
+	for _, pair := range strings.Split(s, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+
+		i := strings.Index(pair, "=")
+		if i < 1 || i == len(pair)-1 {
+			return nil, fmt.Errorf("invalid upstream %q: want <source>=<url>", pair)
+		}
+		relays = append(relays, &ws.Relay{
+			Source: pair[:i],
+			URL:    pair[i+1:],
+			Events: []string{topicEvents},
+		})
+	}
+
+	return relays, nil
+}
+
+// relay fans upstream servers into hub until it shuts down.
+func relay(hub *ws.Hub, relays []*ws.Relay) {
+	for _, r := range relays {
+		go func(r *ws.Relay) {
+			if err := r.Run(context.Background(), hub); err != ws.ErrHubClosed {
+				log.Println(fmt.Sprintf("Error relaying %s: %v", r.Source, err))
+			}
+		}(r)
+	}
+}
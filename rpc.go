@@ -0,0 +1,167 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/alexsasharegan/gophx-xxws/motion"
+	"github.com/alexsasharegan/gophx-xxws/pipeline"
+	"github.com/alexsasharegan/gophx-xxws/sensor"
+	"github.com/alexsasharegan/gophx-xxws/ws"
+)
+
+// Bounds on the sample rate clients may ask for, in Hz.
+const (
+	minSampleRate = 1
+	maxSampleRate = 1000
+)
+
+// livePipeline is the pipeline the broadcast loop runs, which clients may
+// replace while it runs.
+type livePipeline struct {
+	v atomic.Value
+}
+
+func newLivePipeline(p *pipeline.Pipeline) *livePipeline {
+	l := &livePipeline{}
+	l.Store(p)
+	return l
+}
+
+func (l *livePipeline) Load() *pipeline.Pipeline {
+	return l.v.Load().(*pipeline.Pipeline)
+}
+
+func (l *livePipeline) Store(p *pipeline.Pipeline) {
+	l.v.Store(p)
+}
+
+// controller exposes sensor and server settings as JSON-RPC methods.
+type controller struct {
+	a        *sensor.Accelerometer
+	fanout   *sensor.Fanout
+	pipe     *livePipeline
+	recorder *recorder
+	// Nil unless dead reckoning is enabled.
+	dr *motion.DeadReckoner
+}
+
+type rateParams struct {
+	Rate float64 `json:"rate"`
+}
+
+type stagesResult struct {
+	Stages []string `json:"stages"`
+}
+
+type calibrationResult struct {
+	GyroBias [3]float64 `json:"gyroBias"`
+}
+
+type mountResult struct {
+	Mount sensor.Mount `json:"mount"`
+}
+
+// register adds the controller's methods to hub. Reading settings needs
+// RoleRead; changing them needs RoleControl.
+func (c *controller) register(hub *ws.Hub) {
+	hub.HandleRPC("sensor.getRange", ws.RoleRead, func(json.RawMessage) (interface{}, error) {
+		return c.a.Range(), nil
+	})
+	hub.HandleRPC("sensor.setRange", ws.RoleControl, c.setRange)
+
+	hub.HandleRPC("sensor.getRate", ws.RoleRead, func(json.RawMessage) (interface{}, error) {
+		return rateParams{Rate: float64(time.Second) / float64(c.fanout.Interval())}, nil
+	})
+	hub.HandleRPC("sensor.setRate", ws.RoleControl, c.setRate)
+
+	hub.HandleRPC("sensor.calibrate", ws.RoleControl, func(json.RawMessage) (interface{}, error) {
+		if err := c.a.CalibrateGyro(); err != nil {
+			return nil, err
+		}
+		return calibrationResult{GyroBias: c.a.GyroBias()}, nil
+	})
+	hub.HandleRPC("sensor.tare", ws.RoleControl, func(json.RawMessage) (interface{}, error) {
+		if err := c.a.Tare(); err != nil {
+			return nil, err
+		}
+		return mountResult{Mount: c.a.Mount()}, nil
+	})
+
+	hub.HandleRPC("pipeline.get", ws.RoleRead, func(json.RawMessage) (interface{}, error) {
+		return stagesResult{Stages: c.pipe.Load().Stages()}, nil
+	})
+	hub.HandleRPC("pipeline.set", ws.RoleControl, c.setPipeline)
+
+	hub.HandleRPC("recording.start", ws.RoleControl, func(json.RawMessage) (interface{}, error) {
+		return c.recorder.Start()
+	})
+	hub.HandleRPC("recording.stop", ws.RoleControl, func(json.RawMessage) (interface{}, error) {
+		return c.recorder.Stop(), nil
+	})
+	hub.HandleRPC("recording.status", ws.RoleRead, func(json.RawMessage) (interface{}, error) {
+		return c.recorder.Status(), nil
+	})
+
+	if c.dr != nil {
+		hub.HandleRPC("motion.reset", ws.RoleControl, func(json.RawMessage) (interface{}, error) {
+			c.dr.Reset()
+			return nil, nil
+		})
+	}
+}
+
+// setRange changes either or both ranges: {"accel": 8, "gyro": 500}.
+// Neither changes unless both are valid. If the device fails, the error's
+// data holds the ranges in effect.
+func (c *controller) setRange(params json.RawMessage) (interface{}, error) {
+	var r sensor.Range
+	if err := ws.DecodeParams(params, &r); err != nil {
+		return nil, err
+	}
+	if err := r.Validate(); err != nil {
+		return nil, ws.InvalidParams(err)
+	}
+	if err := c.a.SetRange(r); err != nil {
+		return nil, &ws.RPCError{Code: ws.RPCServerError, Message: err.Error(), Data: c.a.Range()}
+	}
+
+	return c.a.Range(), nil
+}
+
+// setRate changes the sample rate in Hz: {"rate": 60}.
+func (c *controller) setRate(params json.RawMessage) (interface{}, error) {
+	var p rateParams
+	if err := ws.DecodeParams(params, &p); err != nil {
+		return nil, err
+	}
+	if p.Rate < minSampleRate || p.Rate > maxSampleRate {
+		return nil, ws.InvalidParams(fmt.Errorf("rate must be between %d and %d Hz", minSampleRate, maxSampleRate))
+	}
+
+	c.fanout.SetInterval(time.Duration(float64(time.Second) / p.Rate))
+
+	return p, nil
+}
+
+// setPipeline replaces the processing pipeline, taking the same document
+// as the -pipeline file. Fusion requested by flag is kept.
+func (c *controller) setPipeline(params json.RawMessage) (interface{}, error) {
+	var cfg pipeline.Config
+	if err := ws.DecodeParams(params, &cfg); err != nil {
+		return nil, err
+	}
+
+	p, err := pipeline.Build(cfg)
+	if err != nil {
+		return nil, ws.InvalidParams(err)
+	}
+	if err := appendFlagStages(p); err != nil {
+		return nil, err
+	}
+	c.pipe.Store(p)
+
+	return stagesResult{Stages: p.Stages()}, nil
+}
@@ -0,0 +1,34 @@
+package sensor
+
+// CalibrateGyro measures the gyroscope's zero-rate offset and subtracts it
+// from every later readout. The sensor must be held still while this runs.
+func (a *Accelerometer) CalibrateGyro() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	sum := make([]float64, 3)
+	for i := 0; i < tareSamples; i++ {
+		d, err := a.readGyroRaw()
+		if err != nil {
+			return err
+		}
+		for j := range sum {
+			sum[j] += d[j]
+		}
+	}
+
+	for j := range sum {
+		a.gyroBias[j] = sum[j] / tareSamples
+	}
+
+	return nil
+}
+
+// GyroBias returns the zero-rate offset, in °/s on the sensor's own axes,
+// removed from gyroscope readouts.
+func (a *Accelerometer) GyroBias() [3]float64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.gyroBias
+}
@@ -0,0 +1,255 @@
+package sensor
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DropPolicy decides what a subscription does when its buffer is full.
+type DropPolicy int
+
+const (
+	// DropNewest discards the incoming sample and keeps what is buffered.
+	DropNewest DropPolicy = iota
+	// DropOldest discards the oldest buffered sample to make room.
+	DropOldest
+)
+
+// Reader is anything that can produce a combined sensor readout.
+// *Accelerometer is the canonical implementation.
+type Reader interface {
+	Read() (Sample, error)
+}
+
+// Sample is a single combined readout of acceleration, gyroscope and
+// temperature data.
+type Sample struct {
+	// Monotonic sequence number assigned by the Fanout.
+	Seq uint64
+	// When the readout was taken.
+	Time time.Time
+
+	Acceleration Acceleration
+	Gyro         Gyro
+	// Die temperature in °C.
+	Temperature float64
+}
+
+// Subscription is a single consumer of a Fanout.
+type Subscription struct {
+	// C delivers samples. It is closed when the subscription ends.
+	C <-chan Sample
+
+	c       chan Sample
+	policy  DropPolicy
+	dropped uint64
+	fanout  *Fanout
+}
+
+// Dropped returns the number of samples discarded by the drop policy.
+func (s *Subscription) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+// Close detaches the subscription from its Fanout and closes C.
+// Multiple calls are safe.
+func (s *Subscription) Close() {
+	s.fanout.unsubscribe(s)
+}
+
+func (s *Subscription) deliver(sample Sample) {
+	select {
+	case s.c <- sample:
+		return
+	default:
+	}
+
+	if s.policy == DropOldest {
+		// Make room by discarding the head of the buffer. The consumer may
+		// have drained it in the meantime, which is fine.
+		select {
+		case <-s.c:
+			atomic.AddUint64(&s.dropped, 1)
+		default:
+		}
+
+		select {
+		case s.c <- sample:
+			return
+		default:
+		}
+	}
+
+	atomic.AddUint64(&s.dropped, 1)
+}
+
+// Fanout runs one physical sampling loop and feeds many subscribers,
+// so consumers never touch the bus themselves.
+type Fanout struct {
+	r        Reader
+	interval int64 // time.Duration, accessed atomically
+
+	// Wakes Run when the interval changes.
+	retick chan struct{}
+
+	mu   sync.Mutex
+	subs map[*Subscription]struct{}
+	seq  uint64
+
+	// Whether Run has started, Close has been called and the
+	// subscriptions have been closed. Guarded by mu.
+	running bool
+	stopped bool
+	ended   bool
+
+	done      chan struct{}
+	closeOnce sync.Once
+
+	// Closed when Run returns, if it was started.
+	exited chan struct{}
+}
+
+// NewFanout returns a Fanout sampling r once per interval.
+func NewFanout(r Reader, interval time.Duration) *Fanout {
+	return &Fanout{
+		r:        r,
+		interval: int64(interval),
+		retick:   make(chan struct{}, 1),
+		subs:     make(map[*Subscription]struct{}),
+		done:     make(chan struct{}),
+		exited:   make(chan struct{}),
+	}
+}
+
+// Subscribe registers a consumer with a buffer of the given size. Once the
+// Fanout has stopped, the subscription's C is closed straight away.
+func (f *Fanout) Subscribe(size int, policy DropPolicy) *Subscription {
+	c := make(chan Sample, size)
+	s := &Subscription{
+		C:      c,
+		c:      c,
+		policy: policy,
+		fanout: f,
+	}
+
+	f.mu.Lock()
+	if f.ended {
+		close(c)
+	} else {
+		f.subs[s] = struct{}{}
+	}
+	f.mu.Unlock()
+
+	return s
+}
+
+func (f *Fanout) unsubscribe(s *Subscription) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.subs[s]; ok {
+		delete(f.subs, s)
+		close(s.c)
+	}
+}
+
+// Run samples the Reader until Close is called. Read errors are passed to
+// onError (if non-nil) and the loop carries on with the next tick. Run
+// returns straight away if Close has already been called.
+func (f *Fanout) Run(onError func(error)) {
+	f.mu.Lock()
+	if f.stopped || f.running {
+		f.mu.Unlock()
+		return
+	}
+	f.running = true
+	f.mu.Unlock()
+
+	ticker := time.NewTicker(f.Interval())
+	defer func() {
+		ticker.Stop()
+		f.closeSubs()
+		close(f.exited)
+	}()
+
+	for {
+		select {
+		case <-ticker.C:
+			s, err := f.r.Read()
+			if err != nil {
+				if onError != nil {
+					onError(err)
+				}
+				break
+			}
+			f.publish(s)
+		case <-f.retick:
+			ticker.Stop()
+			ticker = time.NewTicker(f.Interval())
+		case <-f.done:
+			return
+		}
+	}
+}
+
+// Interval returns the time between samples.
+func (f *Fanout) Interval() time.Duration {
+	return time.Duration(atomic.LoadInt64(&f.interval))
+}
+
+// SetInterval changes the time between samples. The sampling loop picks
+// it up straight away.
+func (f *Fanout) SetInterval(d time.Duration) {
+	atomic.StoreInt64(&f.interval, int64(d))
+
+	select {
+	case f.retick <- struct{}{}:
+	default:
+	}
+}
+
+func (f *Fanout) publish(s Sample) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.seq++
+	s.Seq = f.seq
+
+	for sub := range f.subs {
+		sub.deliver(s)
+	}
+}
+
+func (f *Fanout) closeSubs() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.ended = true
+	for sub := range f.subs {
+		delete(f.subs, sub)
+		close(sub.c)
+	}
+}
+
+// Close stops the sampling loop and closes every subscription. If Run is
+// going, Close waits for the read in progress, so the Reader can be closed
+// safely afterwards.
+func (f *Fanout) Close() {
+	f.closeOnce.Do(func() {
+		close(f.done)
+	})
+
+	f.mu.Lock()
+	f.stopped = true
+	running := f.running
+	f.mu.Unlock()
+
+	if running {
+		<-f.exited
+	} else {
+		// Run will never start now, so nothing else ends the
+		// subscriptions.
+		f.closeSubs()
+	}
+}
@@ -0,0 +1,71 @@
+package sensor
+
+import (
+	"testing"
+	"time"
+)
+
+type fakeReader struct{}
+
+func (fakeReader) Read() (Sample, error) {
+	return Sample{Time: time.Now()}, nil
+}
+
+// closedWithin reports whether s.C is closed, draining any buffered samples,
+// before the timeout.
+func closedWithin(s *Subscription, d time.Duration) bool {
+	timeout := time.After(d)
+	for {
+		select {
+		case _, ok := <-s.C:
+			if !ok {
+				return true
+			}
+		case <-timeout:
+			return false
+		}
+	}
+}
+
+func TestFanoutSubscribeAfterRun(t *testing.T) {
+	f := NewFanout(fakeReader{}, time.Millisecond)
+	before := f.Subscribe(1, DropOldest)
+
+	exited := make(chan struct{})
+	go func() {
+		f.Run(nil)
+		close(exited)
+	}()
+	if _, ok := <-before.C; !ok {
+		t.Fatal("subscription closed before any sample")
+	}
+	f.Close()
+	<-exited
+
+	if !closedWithin(before, time.Second) {
+		t.Error("subscription not closed when Run returned")
+	}
+	if !closedWithin(f.Subscribe(1, DropOldest), time.Second) {
+		t.Error("subscription made after Run returned never closes")
+	}
+}
+
+func TestFanoutCloseBeforeRun(t *testing.T) {
+	f := NewFanout(fakeReader{}, time.Millisecond)
+	s := f.Subscribe(1, DropOldest)
+
+	done := make(chan struct{})
+	go func() {
+		f.Run(nil)
+		close(done)
+	}()
+	f.Close()
+	<-done
+
+	if !closedWithin(s, time.Second) {
+		t.Error("subscription not closed by Close")
+	}
+	if !closedWithin(f.Subscribe(1, DropOldest), time.Second) {
+		t.Error("subscription made after Close never closes")
+	}
+}
@@ -0,0 +1,265 @@
+package sensor
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Number of readouts averaged when capturing a reference orientation.
+	tareSamples = 32
+
+	// Allowed deviation from orthonormality for user supplied matrices.
+	mountTolerance = 1e-3
+)
+
+// Mount is a rotation matrix taking vectors from the sensor's native frame
+// into the device frame. Rows are device axes expressed in sensor axes.
+type Mount [3][3]float64
+
+// IdentityMount leaves readings untouched.
+var IdentityMount = Mount{
+	{1, 0, 0},
+	{0, 1, 0},
+	{0, 0, 1},
+}
+
+// MountFromMatrix validates m as a proper rotation and returns it as a Mount.
+func MountFromMatrix(m [3][3]float64) (Mount, error) {
+	mt := Mount(m)
+	p := mt.Mul(mt.Transpose())
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if math.Abs(p[i][j]-IdentityMount[i][j]) > mountTolerance {
+				return IdentityMount, fmt.Errorf("mount matrix is not orthonormal")
+			}
+		}
+	}
+
+	if math.Abs(mt.det()-1) > mountTolerance {
+		return IdentityMount, fmt.Errorf("mount matrix is a reflection, not a rotation")
+	}
+
+	return mt, nil
+}
+
+// MountFromAxes builds a Mount from a signed axis permutation. Each argument
+// names the sensor axis that becomes the device x, y and z axis respectively,
+// e.g. MountFromAxes("-y", "x", "-z").
+func MountFromAxes(x, y, z string) (Mount, error) {
+	var m [3][3]float64
+
+	for row, name := range [3]string{x, y, z} {
+		sign := 1.0
+		name = strings.TrimSpace(strings.ToLower(name))
+		switch {
+		case strings.HasPrefix(name, "-"):
+			sign = -1
+			name = name[1:]
+		case strings.HasPrefix(name, "+"):
+			name = name[1:]
+		}
+
+		switch name {
+		case "x":
+			m[row][0] = sign
+		case "y":
+			m[row][1] = sign
+		case "z":
+			m[row][2] = sign
+		default:
+			return IdentityMount, fmt.Errorf("unknown mount axis %q", name)
+		}
+	}
+
+	return MountFromMatrix(m)
+}
+
+// MountFromEuler builds a Mount from the roll, pitch and yaw (in degrees)
+// by which the sensor is rotated inside the device, i.e. Rz(yaw)·Ry(pitch)·Rx(roll).
+func MountFromEuler(roll, pitch, yaw float64) Mount {
+	sr, cr := math.Sincos(roll * degToRad)
+	sp, cp := math.Sincos(pitch * degToRad)
+	sy, cy := math.Sincos(yaw * degToRad)
+
+	return Mount{
+		{cy * cp, cy*sp*sr - sy*cr, cy*sp*cr + sy*sr},
+		{sy * cp, sy*sp*sr + cy*cr, sy*sp*cr - cy*sr},
+		{-sp, cp * sr, cp * cr},
+	}
+}
+
+// ParseMount reads a Mount from its textual form:
+//
+//	axes:-y,x,-z
+//	euler:180,0,90
+//	matrix:1,0,0,0,1,0,0,0,1
+//
+// An empty string yields IdentityMount.
+func ParseMount(s string) (Mount, error) {
+	if s == "" {
+		return IdentityMount, nil
+	}
+
+	parts := strings.SplitN(s, ":", 2)
+	if len(parts) != 2 {
+		return IdentityMount, fmt.Errorf("mount %q must be of the form kind:values", s)
+	}
+	kind, fields := parts[0], strings.Split(parts[1], ",")
+
+	if kind == "axes" {
+		if len(fields) != 3 {
+			return IdentityMount, fmt.Errorf("mount axes needs 3 values, got %d", len(fields))
+		}
+		return MountFromAxes(fields[0], fields[1], fields[2])
+	}
+
+	values := make([]float64, len(fields))
+	for i, f := range fields {
+		v, err := strconv.ParseFloat(strings.TrimSpace(f), 64)
+		if err != nil {
+			return IdentityMount, fmt.Errorf("mount value %q: %v", f, err)
+		}
+		values[i] = v
+	}
+
+	switch kind {
+	case "euler":
+		if len(values) != 3 {
+			return IdentityMount, fmt.Errorf("mount euler needs 3 values, got %d", len(values))
+		}
+		return MountFromEuler(values[0], values[1], values[2]), nil
+	case "matrix":
+		if len(values) != 9 {
+			return IdentityMount, fmt.Errorf("mount matrix needs 9 values, got %d", len(values))
+		}
+		var m [3][3]float64
+		for i, v := range values {
+			m[i/3][i%3] = v
+		}
+		return MountFromMatrix(m)
+	}
+
+	return IdentityMount, fmt.Errorf("unknown mount kind %q", kind)
+}
+
+// Apply rotates the x, y, z vector v into the device frame.
+func (m Mount) Apply(v []float64) []float64 {
+	out := make([]float64, 3)
+	for i := 0; i < 3; i++ {
+		out[i] = m[i][0]*v[0] + m[i][1]*v[1] + m[i][2]*v[2]
+	}
+
+	return out
+}
+
+// Mul returns the composition m·n, i.e. n is applied first.
+func (m Mount) Mul(n Mount) Mount {
+	var out Mount
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			for k := 0; k < 3; k++ {
+				out[i][j] += m[i][k] * n[k][j]
+			}
+		}
+	}
+
+	return out
+}
+
+// Transpose returns the inverse rotation.
+func (m Mount) Transpose() Mount {
+	var out Mount
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			out[i][j] = m[j][i]
+		}
+	}
+
+	return out
+}
+
+func (m Mount) det() float64 {
+	return m[0][0]*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) -
+		m[0][1]*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) +
+		m[0][2]*(m[1][0]*m[2][1]-m[1][1]*m[2][0])
+}
+
+// alignToZ returns the shortest rotation taking the vector v onto +z.
+func alignToZ(v []float64) Mount {
+	n := math.Sqrt(v[0]*v[0] + v[1]*v[1] + v[2]*v[2])
+	if n == 0 {
+		return IdentityMount
+	}
+	x, y, z := v[0]/n, v[1]/n, v[2]/n
+
+	// Rodrigues' formula for the axis v × z and angle acos(v · z).
+	c := z
+	if c < -1+mountTolerance {
+		// Upside down; any half turn about a horizontal axis will do.
+		return Mount{
+			{1, 0, 0},
+			{0, -1, 0},
+			{0, 0, -1},
+		}
+	}
+	k := 1 / (1 + c)
+	ax, ay := y, -x
+
+	return Mount{
+		{c + ax*ax*k, ax * ay * k, ay},
+		{ay * ax * k, c + ay*ay*k, -ax},
+		{-ay, ax, c},
+	}
+}
+
+// SetMount changes the transform applied to every readout.
+func (a *Accelerometer) SetMount(m Mount) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.mount = &m
+}
+
+// Mount returns the transform currently applied to every readout.
+func (a *Accelerometer) Mount() Mount {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.mountOrIdentity()
+}
+
+// Tare records the current orientation as the reference ("zero here"):
+// gravity as measured right now becomes the device's +z axis. The sensor
+// should be held still while this runs.
+func (a *Accelerometer) Tare() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	sum := make([]float64, 3)
+	for i := 0; i < tareSamples; i++ {
+		d, err := a.readAccel()
+		if err != nil {
+			return err
+		}
+		for j := range sum {
+			sum[j] += d[j]
+		}
+	}
+
+	// readAccel already applied the current mount, so compose on top of it.
+	m := alignToZ(sum).Mul(a.mountOrIdentity())
+	a.mount = &m
+
+	return nil
+}
+
+func (a *Accelerometer) mountOrIdentity() Mount {
+	if a.mount == nil {
+		return IdentityMount
+	}
+
+	return *a.mount
+}
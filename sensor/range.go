@@ -0,0 +1,145 @@
+package sensor
+
+import (
+	"fmt"
+)
+
+const (
+	// Full scale range configuration registers; the range is in bits 3-4.
+	gyroConfig  = 0x1b
+	accelConfig = 0x1c
+	fsSelShift  = 3
+)
+
+var (
+	// Accelerometer full scale ranges in g, indexed by AFS_SEL, and the
+	// matching LSB/g.
+	accelRanges = [4]int{2, 4, 8, 16}
+	accelScales = [4]float64{scale2g, scale4g, scale8g, scale16g}
+
+	// Gyroscope full scale ranges in °/s, indexed by FS_SEL, and the
+	// matching LSB/°/s.
+	gyroRanges = [4]int{250, 500, 1000, 2000}
+	gyroScales = [4]float64{lsbSensitivity, 65.5, 32.8, 16.4}
+)
+
+// Range is the full scale range of both sensors.
+type Range struct {
+	// ±g
+	Accel int `json:"accel"`
+	// ±°/s
+	Gyro int `json:"gyro"`
+}
+
+// SetAccelRange selects the accelerometer's full scale range: 2, 4, 8 or 16 g.
+// Wider ranges trade resolution for headroom.
+func (a *Accelerometer) SetAccelRange(g int) error {
+	sel, err := rangeSel(accelRanges, g, "g")
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.mmr.WriteUint8(accelConfig, uint8(sel)<<fsSelShift); err != nil {
+		return err
+	}
+	a.accelSel = sel
+
+	return nil
+}
+
+// SetGyroRange selects the gyroscope's full scale range: 250, 500, 1000
+// or 2000 °/s.
+func (a *Accelerometer) SetGyroRange(dps int) error {
+	sel, err := rangeSel(gyroRanges, dps, "°/s")
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := a.mmr.WriteUint8(gyroConfig, uint8(sel)<<fsSelShift); err != nil {
+		return err
+	}
+	a.gyroSel = sel
+
+	return nil
+}
+
+// Validate checks that both ranges are supported, treating zero as
+// unchanged.
+func (r Range) Validate() error {
+	if r.Accel != 0 {
+		if _, err := rangeSel(accelRanges, r.Accel, "g"); err != nil {
+			return err
+		}
+	}
+	if r.Gyro != 0 {
+		if _, err := rangeSel(gyroRanges, r.Gyro, "°/s"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetRange selects both full scale ranges at once; a zero field keeps the
+// current range. Nothing changes unless both are supported, and if the
+// device fails the accelerometer is put back as it was.
+func (a *Accelerometer) SetRange(r Range) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	accelSel, gyroSel := a.accelSel, a.gyroSel
+	if r.Accel != 0 {
+		accelSel, _ = rangeSel(accelRanges, r.Accel, "g")
+	}
+	if r.Gyro != 0 {
+		gyroSel, _ = rangeSel(gyroRanges, r.Gyro, "°/s")
+	}
+
+	if r.Accel != 0 {
+		if err := a.mmr.WriteUint8(accelConfig, uint8(accelSel)<<fsSelShift); err != nil {
+			return err
+		}
+	}
+	if r.Gyro != 0 {
+		if err := a.mmr.WriteUint8(gyroConfig, uint8(gyroSel)<<fsSelShift); err != nil {
+			if r.Accel != 0 {
+				if a.mmr.WriteUint8(accelConfig, uint8(a.accelSel)<<fsSelShift) != nil {
+					// Stuck at the new range; at least scale by it.
+					a.accelSel = accelSel
+				}
+			}
+			return err
+		}
+	}
+	a.accelSel, a.gyroSel = accelSel, gyroSel
+
+	return nil
+}
+
+// Range returns the full scale ranges currently selected.
+func (a *Accelerometer) Range() Range {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return Range{Accel: accelRanges[a.accelSel], Gyro: gyroRanges[a.gyroSel]}
+}
+
+func rangeSel(ranges [4]int, v int, unit string) (int, error) {
+	for i, r := range ranges {
+		if r == v {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unsupported range ±%d %s, want one of %v", v, unit, ranges)
+}
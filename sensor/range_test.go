@@ -0,0 +1,23 @@
+package sensor
+
+import "testing"
+
+func TestRangeValidate(t *testing.T) {
+	tests := []struct {
+		r  Range
+		ok bool
+	}{
+		{Range{}, true},
+		{Range{Accel: 8}, true},
+		{Range{Gyro: 500}, true},
+		{Range{Accel: 16, Gyro: 2000}, true},
+		{Range{Accel: 8, Gyro: 300}, false},
+		{Range{Accel: 3, Gyro: 500}, false},
+		{Range{Accel: -2}, false},
+	}
+	for _, tt := range tests {
+		if err := tt.r.Validate(); (err == nil) != tt.ok {
+			t.Errorf("%+v: got %v, want ok=%t", tt.r, err, tt.ok)
+		}
+	}
+}
@@ -8,6 +8,8 @@ package sensor
 import (
 	"encoding/binary"
 	"math"
+	"sync"
+	"time"
 
 	"periph.io/x/periph/conn/i2c"
 	"periph.io/x/periph/conn/i2c/i2creg"
@@ -38,6 +40,11 @@ const (
 	// MPU-60X0 power registers
 	pwrMgmt1 = 0x6b
 	pwrMgmt2 = 0x6c
+
+	// Temperature register and conversion: °C = raw/340 + 36.53
+	tempReg         = 0x41
+	tempSensitivity = 340
+	tempOffset      = 36.53
 )
 
 var (
@@ -46,14 +53,31 @@ var (
 )
 
 // Accelerometer represents a sensor connection.
+// It is safe for concurrent use; bus access is serialized internally so
+// multi-register reads never interleave.
 type Accelerometer struct {
+	// Guards every transaction on the bus.
+	mu sync.Mutex
+
 	bus  i2c.BusCloser
 	conn *i2c.Dev
 	mmr  *mmr.Dev8
+
+	// Transform into the device frame. Nil means the identity.
+	mount *Mount
+
+	// Full scale range selectors; see SetAccelRange and SetGyroRange.
+	accelSel, gyroSel int
+
+	// Zero-rate gyroscope offset in sensor axes; see CalibrateGyro.
+	gyroBias [3]float64
 }
 
 // Open initializes the sensor and connects.
 func (a *Accelerometer) Open() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
 	// Ensure the periph lib has been initialized. Mutliple calls are safe.
 	if _, err := host.Init(); err != nil {
 		return err
@@ -87,6 +111,9 @@ func (a *Accelerometer) wake() error {
 
 // Close closes the i2c bus.
 func (a *Accelerometer) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
 	if err := a.bus.Close(); err != nil {
 		return err
 	}
@@ -103,13 +130,36 @@ func (a *Accelerometer) readAccel() ([]float64, error) {
 			return nil, err
 		}
 
-		data[i] = float64From2C(v) / scale2g
+		data[i] = float64From2C(v) / accelScales[a.accelSel]
 	}
 
-	return data, nil
+	return a.mountOrIdentity().Apply(data), nil
+}
+
+func (a *Accelerometer) readTemperature() (float64, error) {
+	v, err := a.mmr.ReadUint16(tempReg)
+	if err != nil {
+		return 0, err
+	}
+
+	return float64From2C(v)/tempSensitivity + tempOffset, nil
 }
 
 func (a *Accelerometer) readGyro() ([]float64, error) {
+	data, err := a.readGyroRaw()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range data {
+		data[i] -= a.gyroBias[i]
+	}
+
+	return a.mountOrIdentity().Apply(data), nil
+}
+
+// readGyroRaw reads the gyroscope in sensor axes, without calibration.
+func (a *Accelerometer) readGyroRaw() ([]float64, error) {
 	data := make([]float64, len(gyroRegs))
 
 	for i, reg := range gyroRegs {
@@ -118,7 +168,7 @@ func (a *Accelerometer) readGyro() ([]float64, error) {
 			return nil, err
 		}
 
-		data[i] = float64From2C(v) / lsbSensitivity
+		data[i] = float64From2C(v) / gyroScales[a.gyroSel]
 	}
 
 	return data, nil
@@ -127,6 +177,9 @@ func (a *Accelerometer) readGyro() ([]float64, error) {
 // GetGyro reads the current gyroscope data from the sensor,
 // and then returns a struct holding the parsed values.
 func (a *Accelerometer) GetGyro() (Gyro, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
 	var gyro Gyro
 	d, err := a.readGyro()
 	if err != nil {
@@ -141,6 +194,9 @@ func (a *Accelerometer) GetGyro() (Gyro, error) {
 // GetAcceleration reads the current acceleration data from the sensor,
 // and then returns a struct holding the parsed values.
 func (a *Accelerometer) GetAcceleration() (Acceleration, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
 	var acc Acceleration
 	d, err := a.readAccel()
 	if err != nil {
@@ -152,21 +208,73 @@ func (a *Accelerometer) GetAcceleration() (Acceleration, error) {
 	return acc, nil
 }
 
+// GetTemperature reads the die temperature of the sensor in °C.
+func (a *Accelerometer) GetTemperature() (float64, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.readTemperature()
+}
+
+// Read takes a combined acceleration, temperature and gyroscope readout in a single
+// bus transaction window, so both halves of the sample belong together.
+func (a *Accelerometer) Read() (Sample, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var s Sample
+	acc, err := a.readAccel()
+	if err != nil {
+		return s, err
+	}
+
+	temp, err := a.readTemperature()
+	if err != nil {
+		return s, err
+	}
+
+	gyro, err := a.readGyro()
+	if err != nil {
+		return s, err
+	}
+
+	s.Time = time.Now()
+	s.Acceleration.data = acc
+	s.Gyro.data = gyro
+	s.Temperature = temp
+
+	return s, nil
+}
+
 // Gyro represents a single readout of gyroscope data.
 type Gyro struct {
 	data []float64
 }
 
+// NewGyro returns a Gyro holding the given x, y, z values in °/s,
+// e.g. after they have been filtered.
+func NewGyro(x, y, z float64) Gyro {
+	return Gyro{data: []float64{x, y, z}}
+}
+
 // GetValues returns the raw x, y, z values parsed from the sensor.
 func (acc Gyro) GetValues() (x, y, z float64) {
 	return acc.data[0], acc.data[1], acc.data[2]
 }
 
 // Acceleration represents a single readout of acceleration data.
+// Values are in the device frame, i.e. with the mount transform applied,
+// so derived angles follow the enclosure rather than the chip.
 type Acceleration struct {
 	data []float64
 }
 
+// NewAcceleration returns an Acceleration holding the given x, y, z values
+// in g, e.g. after they have been filtered.
+func NewAcceleration(x, y, z float64) Acceleration {
+	return Acceleration{data: []float64{x, y, z}}
+}
+
 // GetValues returns the raw x, y, z values parsed from the sensor.
 func (acc Acceleration) GetValues() (x, y, z float64) {
 	return acc.data[0], acc.data[1], acc.data[2]
@@ -7,6 +7,6 @@ import (
 )
 
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00i\x02\xfdL\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00index.htmlUT\x05\x00\x01\x06\x08][\x84Q;o\xeb \x14\x9e\xe3_\xc1=\xf3%\xbe\xd9\xee\x00\x96\xa2\xf4\xb55R\x135\x1d	\x9c\x98\xd3\x02v\xe1$i\xfe}e\xbb\xaf\xad\x13|O}\x02\xf5\xe7\xea~\xb5yZ_\x0b\xcf14\x95\x1a\x0e\x11Lj5`\x82\x81@\xe3\x9aj\xa6\"\xb2\x11\xd6\x9b\\\x905l77\xf2?|\xf1\xc9D\xd4p\"<\xf7]f\x10\xb6K\x8c\x895\x9c\xc9\xb1\xd7\x0eOdQ\x8e\xe0\xaf\xa0DL&\xc8bM@\xbd\x98\xff\xfb\xee\xf1\xcc\xbd\xc4\xd7#\x9d4\xec\xe4v)W]\xec\x0d\xd3>\xe0\x8fRB\x8d\xae\xc51\xc6\xc4\x01\x9b\xdbn}\xb7\x13\x8f\x0f\xaa\x9ep5S\x81\xd2\x8b\xc8\x184\x14\xbe\x04,\x1e\x91A\xf8\x8c\x07\x0d5\x1b\ngJnnK\x81_\xdd\xd1P\xfap\xaazz\x0f\xb5\xef\xdce\x08\xfa\x85\xb0\xc1\x94\xa2\xe1\xd0%\x96\xc5\xa4\"\xc6[DG\xc7(\x18\xdfX\x86\x16\x9a\xa5\xb5\x180w\x11\x19\xb3\xaa\xfd\xa2\xa9\xaa\x99\xea3\nr\x1a\x9ca#\xd9\xe4\x16\x19\x1aU\xf7\x19G\xb9\xd8L=\x8b\x92\xed\xe7\x8c\xe72\xe8\x13?\xcc\x99v\xa8z\xfa\xbe\xf7\x00\x00\x00\xff\xffPK\x07\x08\xbf\xea\xfa\xb8&\x01\x00\x00\xcf\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe7\x00\xfdL\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x08\x00	\x00main.cssUT\x05\x00\x012\x05][\x01\x00\x00\xff\xffPK\x07\x08\x00\x00\x00\x00\x05\x00\x00\x00\x00\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xc6\"\xfeL\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x07\x00	\x00main.jsUT\x05\x00\x01t\x92^[\x84R\xcfo\xda0\x14\xbe\xf3W\xbc\xa5=$Rf\xa4\x1d\x8br\xd9T\xa9\x9b\xa0\x95J\xa7]q\xe3G\xea.<G~N\x03B\xf9\xdf';\x0ePZ\xb4\x1cP\xb0\xdf\xf7\xe3}\xf9\x92\x96\x11\xd8Y]\xbad6\xa9\xd1A\xc7P\x00a\x07\x7f\xf0yi\xca\xbf\xe8\xd2U\xc77\xd3\xe9\xf5\xbe6\xa5t\xda\x90x1\xec\xfai\xc7\xabl\xc00\x12\x1b\x0b\x05,\xc3K\x1a\x8f\x95t\xf2\xde(\x84\x02*t\x8fH\n\xed\x93\xb4\x15\xba4\xf1w_]\xf8\x93d\xa3\xf2\x9d$U\xa3'\xdaO\x00\x00\x0c-\x90YV\x98\xe2\x9b\xcb\xe2\xa1\x7f\x06A\xd16J\xbap)<_6;\x0c\x8c\xd2B\x13\xa1\xbd{Z\xcc\xa1\x80_\xcb\x87{\xe1\x97\xa5J\xafw\xe9@\x92\x03\xb5u\x9d\xc3\xb7\x88\xee\xf3(\xfd\xa36\xfcAX\xd6h]\x9a\x1c\xb2\x81\xd2\x10a\xe9S\x81\xd2\x03Tr\xe2\xa24\xc4\xa6F\xd1IKi\xd2\xf1M\x18Ir\xf0\xacgr\xb7\xd6\x1a\xfb_\xb90\xf5\x99\x04\x1e\xe0\x07\xde~6\xe9XH\xa5n\xdf\x90\xdc\\\xb3CB\x9b&\x9b!\xd3$?&.\x0eIg\x17@\xa3\xf3SHH\xe8\x12 \x18:\x03\x04\xf7\xd9l\xb2ni\x88\xec\xbc\x16Z\x8d\xdb\xfbB\xd0\xd0\x1de\xcav\x83\xe4D\x85\xee\xb6F\xff\xfa}\xf7S\xf9\xe1aW\xbd\x86\xf4\x8b\x1f>\x8d\xce\xbdX\xd3\x85\"\x0f\xc9\xae6\x9aYS\x05\xbe\x0c\x91\x06\xae\xae\xf7Z\xf5\xab1\xb3\xf0k\xd1\xb5\x96\x82\xf8l\xd2\x1f\xbd\xc6n\xbfs\xb8\x85\x02\x1e\x9e_\xb1tB2\xeb\x8a\xd2}\x9f\xc3\xe8+2\x1d=\xc5\xbe\xeeN}\xfa\xe7=\xc56\x1f\x9a\xdaH\xcb~\xf8\xe4k\xc7\xb6\x84\xfd\x8c\x1fJ\xcf\xa9\xa2\xe6\xf6SLi\x9a\xdd%D\\o\xfbQ\xad\xf71L\xa7W\xc0\xa6\xb5%.d\xd3h\xaa~?\xce\x8b\x8d\xd4$^Yld\xf3/\x00\x00\xff\xffPK\x07\x08\x97\x86#$\xe4\x01\x00\x00I\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xc6\"\xfeL\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0b\x00	\x00main.js.mapUT\x05\x00\x01t\x92^[\xa4S\xc1n\xd3@\x10\xfd\x979\xaf\x9a\x03\xa2\x08\xfb\xf4f\xecDi(\x95)\x91\xa8P\x0f\x96k\x12W\x8d\x1d\xec\xd0\xd2\"\xfe\x1d\xedl\xb2\xbb6\xf4\xc4\xc5\x9a\xf5\xbcy\xef\xcd\xcc\xee/z\xac\xfb\xa1\xe9ZJ\xde\x18\xfa\xd6<\xd4\x94\xd0\xael\xda\xb3\xfb\x81\x0c\x0d\xdd\x8f\xbe\xaa?u\xdd\x81\x12\xf2\xe7\x81\x92\xaftv6\x1b\xfaj\xa6\xd8\xc3@\xb7\x86\xdar\xa7\xa9[C\xbbr\xbfo\xda\xcd@	\xa5\x00`\x96\xc0\xd2\xe4@n\x16\xc0\xc2\x1d\xaf\x81k#\x80\x98\x02(\xdcG\x8f\x9a]\x01+w\xb4\x9f\x14\x906s\x99K\xe0\xd2\xd1h\xa4\xac'\xcc\x81\x1dF\xc9\x14S\x03\xb5\xe3)\x81r\xc4\x98\xbf\x97\xc8\x89E\xa7K\xc8]dl\x014\x9c\x16\x90\x17vb\xfa7D\xaa\x10<\x07\xf2\x02\xf2\x93\xa3\x96\xbcB\x04\x0c2\xa1\x8f0'\xcf\xe5\x08\x97\x90\xc7\xcc\x87\x99\xb9\x02\xae\xbc\x87\xda\xea\xf5\x1c\xcd\xec\x9c\x81s\x1e\x1b\xea%\xaa\n6\xd6\xc0:Z\xcdHr+\xafI\xae-\xe36\x96l\xd8\x0ek,\xf9\xc2QU\x80\xfe-\xd4\xbb\xc2T s\xbf\x9ey4\x89\x0d\x03\x1b\x8e\x06\xa7\xa90\xc2\x10\x1d\xabe\x9f\xbdR\xae\x8e&\xe5\xc1\xe5\xa9|\xfb_\xe5\xf963\xcf\x0c<\xb3\x85\xaem\x8b\xf7\xc7\xa1\xebG'\x10\xaeG\x05T.\x1a\xef^\xd7\x1e/\xcb\xdf\x0f;\xdd\xcf\xee\xe2L\x9e\xcb\x13\x03O\xec\x88&l+\xc8\xccr\xe6\xc7ez^\xdb\xf0\xdd)\xba\xc9\xa2~+k\xe1-G\x16\xbe3\xd0\x85=\xe7'\xa3\xf9;\xb7l\xeb\xecC\xf4F4}\x03\xf9\xf8\xcf\x17\x14\x9a\x0e\n\xe3\xa7?\xf9X\xfa\x87\x10f\xcae\xf9/\xa6[( _\"\x9c\xe5\xb7\xb8\xb9\xc3M,\x8c\xcaZ\xf6\xf3\xf2\xb7Q.4\xa2\xdf\x7f\x02\x00\x00\xff\xffPK\x07\x081\xf1\x06u\xca\x01\x00\x003\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xc6\"\xfeL\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0c\x00	\x00tailwind.cssUT\x05\x00\x01u\x92^[\xccYmo\xdbF\xf2\x7f\xafO1\xff\x16\x7f\\\xeb\x8a\x92\xe54IOE^4\xa9{5\xda\xb4E\x9c 8\x14\x05\xb4\xe4\x0e\xc5\xad\x97;\xc4\xee\xd2\xb2\xd2\xf6\xbb\x1ff\x97\x94\x96\x94d\xa7\xc5\x05=\xbf\x92\xa5\xe1<\xcfo\x1e8?\xfb?0dk\xa1\xd5;\x9c\x15\xce\xc1\xed\xd3\xd9\xf9\xec\x1c~\x87\x97W\xaf\xe1{U\xa0q\x08\xbf\xc3Z\xf9\xaa\xcdg\x05\xd5s\x83\x05i\xe1\xe6\xc3\xe7\xce\xe6\x93\xc9\xfc\x0c\xbe\xa6\xa2\xad\xd1\xf8	\x00<\xfb\xaf\xfdu\xdc\xcf&p\x06\x8b\x19\xbc k\xb1\xf0\xe0+\x04\xad\x0cB\x85j]yP\x06\x84\xd6\x90[\xda8\xb4n\xc6\xe4\x173\xf8\xc9\xe2-\x1a\x0fB\xfe\xda:\xcf\xda9\xa0\x12J2\x1e\x9cz\x87 J\x8f\x16\xc8*4^xE\x06\x8aJ\x985:P\x86y\x00\xc0\xd5%\x90\x81\xb7\xcaH\xda8\xf8\xa9\"\x83 \x8cd\x91\xea\xc7k\x964\x9fL*_k\xf8m\x02A\xa9,*\xb5\x84\xc5l\xf1\xf8K\x98\x9f\xc1\x82\x89 \xfce\xb5\xcb<\xde\xf9\x8c\xe5gQ\xb1%,\xce\xcf\xff?P^D\xcal\x83\xf9\x8d\xf2\x0fR\xfe\x11\\\x7f\x8d\x05\xeb\xee>\x9c\xeb_aM\xb7\x18\xdc^\x0b\xbbf\xdb\x87\x1e\x87O\xa8QF\x91\x11\x1e\xe5\xa7\x9dWr\x92\xdb\xe0\x95\xf8\xcc\x12\xce\xbf\x8c\x1a\x07\x9e_I\x19\x18\x16]L\xa5r\x8d\x16[\xe6|u	\xff\xcc:&\x1du\x1a\xf9$~F\xf6\n\x91\x81U\xb5X\x01j\x8c\x81\xde(_)\x03+\x17\xbd\xb3bb\xe6\xb4\x12\xd6\xabB\xe3\n\n2\xec`\x0e6\xbc\xa8,\xd58\x85o\x94\xc5\x92\xee\xa6\x81\xf5\xb5(\x85U}\x8c\x17\xc1\x16\x16\x1eB\xb2\x84\x0b\xac\xbfL\xac\x9b=y\x8a\xf5\xceF\xf8\x97\xa5\xb6Qf\x1d\xc5|\xc8\xc2x\x1fOr\xf5\xdcK\xd7Y9\xa9\x852\xc1\xd0}\xdevtK\xc85\x157\xf7\xc5p\x9f\x1cW\x97\xf0\xc50\x82G\x14\xc8\xe9\x8e\xe3\xc8NR\xa6w}_\xbc\xd7\x15m\x025\xdd\xa2-5m\x98\xe6R\xaec\xd4w\n\x1f\x07\x07e*\xb4\xca\x0bSDrW\x08\xcdb\x06\xd5\x7f\x025R>$%\xac\xb0^\xed\x9e\xeat\x1d?7\x9fL\x1a\x8b\xfb\x04)E\xad\xf4v	5\x19r\x8d(p\xba\xff\x98BB\x92M\x0b\xac\xc7\x85\xfd\x9a\xcb_\xe3-jpX\x0b\xe3U\xf1\x01\x8b|1K\xeb|m\xc5\x16rQ\xdc\xac-\xb5Fr}\x89\xc2\xab\xdb\x00\xbc7\xae\x8b\xf1\xe2\xbc\xf7Z\xf7\xe8Z4\xe1\xb7H\xd4\x1a\x896\x00u\x84K\xf8\xe2\xb3\xa4\xb0\xe0\x8b\xcf\x0e\x82\x98h\x90\x93\xf7TCNV\xa2\xdd\xd7(<~\x9a\x05&]\xbe\xc0\xa3.\xc3/\x0e\x13\x8c\xcb\x1b$\x16d#\xba'\x85\xce\x994\x85\xab\xcb)\xfc\xd8\xa0\x15G\n\xbeS\xaao\"\xccW\xb6\x8dV\x85\xf0\x08\xa2\x89\x9f\x98+\x95\xb0\xcaIK\xb4+\xc8\xb7\x81\xd0\xb0`\xdb\xea`xg\xed\x93!\xdf\xb1\xae!\xc36\xbb~6\xd4\xf3\x94r\x7f\x7f\xda\xdfg\x8d\xf3\xdb\xe8\x81\xaf\x8c\xb4\xa4$|>{\x94\xdd\xff\\\x92ql@A\x9a\xec\x89\x96p\\\xe4	\x0b\x0f\xe3\xb9rm\x1e\x9a\x02\x7fj\x92\xc6QZ\xaaA\x94%\xf7\x0d\xb3>2k0\x97\xa3\xfc\xe1\xb2\xceQJ\x94\xff\x1b\x98\xff^\xd4\\\x95\x9fgO\x87\xf4\x83*\x0c\xe5\xc7\xc5S\x8b8\x199%\xc7(0\x92\xf7-S\x8c\xb1;\x81l\xf8\x86l\xfda\xa1\xecE\x18\xe4\x92y\x81s\xd1=<\xb7$X6\x1cwz\xb89U\x8aG\xfbU\xb4\x99\xf59\xd9\xceNz>-f*#\x96y+\x8c+\xc9\xd6\xfd\xd3\xa3\x89e/\xef\xcf0J:\xef\xc0\x87\xbb\xf9\x19\xdeb\xfe\x9d\xf2\x90\xb7k\xd8Th\x11>\xb9\xf8\x14$:oi\xeb\xc0\x88\xd0\x18V\xa2\x95\x8a\xba\x9a\xbaU\x12i5\x89C4\x97\x83%\xed\x86Xp\x04x\x94\x11\xb9\xd2\xcao\xc1S\x07\x1f\x85V\xc5\x8d\xc85\x82\xdf61\x82\x9c\xb3\x07a\x88C\xf8\xcfL\xf4\xec\xa3\xbc\xf5\x9e\xccG\xbfL'\x93\xee\x1b\x8b\x0e=\x7f\xd1\xfd\xef\xda\xbcV\xfe\xa3_B\xcf\xee\xe7m\xd14(,;j	\x91\xc5\xa8%\x1f\xc6\xc8\xa0\xedk\x84Uj\x84\x94\x87\xf3\x0c\xab7\xd2l\xb9\xccjz\x97\x95T\xb4.\x0bl\xa6#U\xef\xa1\xe8\x95?$	\xe6D}\xb2\xe0\xbf%\x182\xc8sj\xa7\xdah\x0c\x7f\x85\xce\x93\xed\x0b\xa5h]_)\xadq\xe8\xfb~\xd6X\xbcU\xd4\xba\xd0\xd3NY\xb4\xd7\xc5*\xb3>0\xe7\xc4\xcf;[\x86\xbf\x07C\xa8\xf5\x0c\xbdKX4w \xc9{\x94\xf0<\x88\xe3\xd1(5#M\xa2\x9318\xde4C=l\xach\x9a\xee\xa1\xd1\x909\xce\xd1\xbe'\xed\x8b*t\x8cU\xa9PK\x87>i%{\x04x4\xa8\xc8^CG y\xbc\xa3\x86\xb1HX\x04C\x1e\n\xd1r\xa3\xa1\xd6s\xb1\x19~b\x0b\xef\xd0\x12\x7f\xd5U\xd4	i\xa7\xdb\xdeC\xf3?\x9c\x9e\xa2n\x917&\xa1Ah\xb56,\x0bNmLa\x96:\x89h\x12K\xd1\xea\x84\xa1+,i\x9d\x0b;\xea\x0f\xef\xb70\xec;\xcf\x08\xb4\x93\x04\x18t\xa7.\xe3\x8a\n\x8b\x9b\x9c\xee\x124\xb0B*\xea\xc0 \xa7\xbb,\nY\xf6\x95\x94\xd3]:\xb5'\x95t\x04\x1e\x06\xb9\xd2ZG\xb6C2*A\x99\xc2\x86h\x05oI\xec\xff\x8b5\x94l\xa2C\x8dM[\xe7hC\xbdwH\x15\x8a=s\x8d2Y|vz\x9a\x96Z?\xa4\x0df\xf6W\n\xd1zJ+iq8\n\xeea1\x19\xc3\x87\x00|0A\xc6\xca\xdd\x8f\x80\x03\xac\xeeK\x1f\x85-\xaa{0\x98K3$z\xea\xfd\x8euFe\xe9\xd0/!\xbbh\xee\xde\x0b\xa6\xfb\xb4\x08\x83%\x0b\xd0\x87~O\xd7\x132P\x8bbw\xe4\x19)\xbd\xf7o\xfc&\x8b,\xc7\xe18I\x9e\xac%\xa7\xcc\x8f\xc8}24\x7f\xb5Y\x86X\xc5\xf1(\x8cF\x8de\xfc\xf1\n\x1d3Zu\xc8\xb6:\x88\xda\xde\x84Ri\xcc\xdaF\x93\x90iJ=\xd0F\x93\x9dw\xd9\xe3\xe7x\xe5\xbd2\x1em\\4?\xc8x\xf8\xbe\xb3\xf3\x03x\xb9\xdf\x1e\x93Mt\x07_\x0f\x089\xbe;\\\x17V5\xbcq|\x10\xbb\x1f\xd2\xe9\xcf-\x0d\xe9(\xff\xad\x92\x12\xcd\xdf\xa5t\x02\xeeUP$\x82\xc9\xeed5.\xa1\x97\xc2\xb4B\xeb-\x94doP\xc6\xd6}\xfd\xe6\xea5\xbc\xb8\xbe\x86\xe7\xc2\xe1\x12*\xef\x1b\xb7\x9c\xcf\x93\xd3\xb7k\x95/\x9c\x9b\xe7\xc2aP\n\xc2qQ\x8bm\xdc\x8d<5\x0c\xef\xc3\xcb\xb8\xafD(.\x1e\x85\x1d\x08p^\xd8\xb0R6\xa4\x8c\x87\x9al\xe0\xc5\xbcC\xc9\x96da\x83yz[8l\xe2\xfdL\x9e\x82Y7\x7f\x8e\x16\xd7n.\x80\x8d\x92\xbe\xe2\xa7wv\x11\xcd\xd6z\xde\xfc\xdb\xe7\xdf=\x1d\xe2A\xda\xa5\x036\xc4C\xd6\xfdkSTqw\x03\x7f\xb8\x7f\x0eNdN\x18\x979\xb4\xaa\x1c`\xc1\xd9tr\xb6\\\xe6X\x92\xc5\xf01\xde\xea\xc7\x02z\x189\xc0}7\xb0\xc55\xa2\x18{\x8b,{\xdaRc\x95\xf0\xb8\x1b\xa3v\xeb\xc4b\xba\xbb\xe9\x1d=`\xbf%{\x03\xa2\xbbU\xf4\x180\xbf\xbaL\xb6\xa40^\xf2\xa6\xd5\x08\x1bn\x0e\x11\x0dW\xc9\x99\x839Yt\xad\xee\x068\xd0\xe4\xc2[\x8aT\xfd\xdds\xe9\x84>L\x8d\xd7B\xe9\x8d\xe2\xce\xd6:O5\x84\xa9\xbb\xa3\x1c\x96\xb5\xe6\xe5\xb3\xcf\x9e\xde\x1b\x9e@\x98]\xca\xe4[\xf8\xb5u~L\x95\x85Tb\xb9\xcc\xe8\xf9\xb6\xd7o\x1a\x94\xdd\x88\xb8+tI\x02\xae\xc1B\x95\xa1\xabp!$\xec]E\xad\x96P\x89[\x1ex\x99W\x7f\xe4s,\xda\xa1\x0f	\xac\xfc?\xdc`\x97	\xed\x89+:\xf4&\x96\xd5:\xb4\x99X\x87S\xcbYgm\x85\xe8{\x1d\xaf\x0c\xec\xecC\xe18\x95\x85\xecS \xb4\xbb\xdd\xf4\xdc\xdb\xdcKg\xf6\xab\xd4\xee\xb0\xccv\xbdr;\x85\x0dv/\x8b\x06\x91\x1a\xa8\x1bRL\xeb\xbd\x0c\xd6\xdf\x91Vr5\xed_G\xb4\x0e\x07\xdee\x9a\xaa;\x9f\xf0r\xee<\n9\x83\xd7\x95r\xc1\xc3\xd4\xda^\xad\x15\xb4\x9e\xfb?\xbb\x98\x8c\xde2;\x83(\xc38\x80\xfe\x88\x05;\xf5{\xc6}\xa2\xa1\xf1\xca\xee\xa8\x83\xa5\xae\"\xeb+a\xe4\x14jq\x13\xae\x88\xedn\xd5\xdd\x0b\xae\xdb\xa2\nP\x06\xce[\xc1#eIv#l\xd0\xa2\xa0\xba!\x87}4\x8e\x00\xab\xef\xd2\x96\xc15\xfd\xdc\xb4Z\xcf\x17\x8b'1u\xef\x05\x83\xbd}\xa1@\xc7\xebo\xf0w\xf2uX\xdf\x96\xf0\xb1\x14\xb8\xc0\xa7iA\xbf1\x92\x06NK\x16\xe8UWP!\x95\x7f\xe8a>Bu\x9c\x9b\xba\xf3\x98\xa3@\xc3\xfc\x92Pe\xbf\x05\x05\xffHC\x16\xd2?8\xff\xae\xc1\x82W[\x0c\xd8\xdd{\xebu\x85\x89\xa4(^\xf1^n\xb0@\xe7\x84\x0dM\x0c\x18\x0f\x14\x8f\xe6\x9b}\x16\xfaj\x98T\xcc\xce\x13\x9c\x87+~\x92\x90\x7f!0\xca\xb9\x16\xdd\xfc\xd1\x93\x8b!\xfc`\xdd\x90e\x9d\xa2\xa2!\xf5\xfb\x02Q\xc6[\x92m\x812\x19\xb4\x9f\\\xf0l\x08\x866\xc0-\xcdz\x94\xbd:o\x91\xe7s\xb0\xfd\x00\xcf\xa9\xdf-\xc2\x91\xd6\x85c~\x07\x98\xb1\xe9\xf6l-j\x14\xee\xd4\x8db|\x948~\x0e\xea\\\xc7Ka\xebz\xd4\x9f\xfclI\xe3\xb3\xc8)\xd2\xc5\xedn\x19\xfb9\xda@6\x8b\xaft\x84q\x87o\x83\xdc\xd6y\xac\xb3VM\xe1\xb9V\xe6\xe6\xa5(\xae\xc3W\xdf\x90\xf1S\xe0\xb9Yc\x16\xa9\xa6p\x8dkBxs5\x85W\x94\x93\xa7)\xfcx\xb7]\xa3\x99\xc2\x9b\xbc5\xbe\x9d\xc2\x0ba\xbc\xb0\xa8u\xd8\xc2\x05\\\x0b\xe3\xa6\xf0u8\xaf\xc5\xcf\xdf\xa2\xbeE\xde\xb5\xe1\x07lq\x9a6\xdb\xbd\xb25J\xd5\xd6{u7\xddf\xf8\xf8<Z>\x0b\xaf\xa1\xf5z\xfc\xfes1[\\<\xb6Xw5\xf418jm\x81/\xe3%\xe5\xcd\xab\xef\x9f\xf5\x89\xc3\xe3\xd0\xac\x16\x0d\x9c\xcd\xff\x13\x00\x00\xff\xffPK\x07\x08\x1as\xd7)\xcd	\x00\x00y \x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\x00\xfdL\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00	\x00tailwind.min.cssUT\x05\x00\x01(\x05][\xac\xbdk\x8f\xeb\xcaq6\xfaW\xe6\xf8\x85\x01\xef@\x1c\x8b\xd4u\xcd\xc2\x01\x82m\xc3I\x80l\x07\xc8~\xed/\x8e?P\x125C/\x8a\xd4\xa1\xa85\xd2\x9e\xcc\x7f?`_\xeb\xf24g\xec\xc4\x06\xf6\x1au=OUwu\xb3\xc9nv\xb1~\xfbO\xff\xcfC\xdb\xf5\xa7\xb2\xa9\x7f\xa9\x1e\xf7\x97\xcb\xc3\xf7\xcd\xe3\xfcq\xfe\xf0\xdf\x0f?\xfd\xdb\xff}\xf8\xf7z_\xb5\x97\xea\xe1\xbf\x1f\x9e\xeb\xe1\xe5\xba{\xdcw\xa7\xdf\xb6\xd5\xbek\xca\xcbo9\xef\x9f~\xfb2\x9c\x9a\xb7\xa6n\xab\xec\xa5\xaa\x9f_\x86\xa7\xfc1_}\xcdN\x97l\xa8nCv\xa9\x7f\xa9\xb2\xf2\xf0\xb7\xebex\xca\xe7\xf3_\x7f\xcd^\xab\xdd\xb7z\xc0\xd2\xf7]w\xb8\xbf\x9d\xca\xfe\xb9n\x9f\xe6\xefe?\xd4\xfb\xa6\x9a\x95\x97\xfaP\xcd\x8e]7T\xfd\xec\xa5*\x0fU?k\xcb\xef\xb3K\xb5\x1f\xea\xae};\xd4\x97sS\xde\x9fvM\xb7\xff\xf6\xfe\x92\xbf\x1d\xbb\xd6*\x7f*\xaa\xd3W\xa7\xefq\xbd\xa9N\x0f\xf3\xf7c\xfd\xbc/\xcf#qv\xac\x9f\xaf}5;\x95\xb5Tb%\xbe*yuzX\xce\xcf\xb7\xf7\x97\xfem\xd7\xddF\xd5u\xfb\xfc\xb4\xef\xda\xa1j\x87l\xd7\xdd\xbe\xba\xd6\xcf\xbfv\xdf\xab\xfe\xd8t\xafO\xdf\xebK\xbdk\xaa\xf7s_\xd9\n\x1d\xcbS\xdd\xdc\x9fN]\xdb]\xce\xe5\xbe\x9a\x85\xbf\xbe\xc6\n\xe7\xd5\xe9\xbd|\xdb\x95\xfbo\xcf}wm\x0f\xd9\xbek\xba\xfei\xe8\xcb\xf6r.\xfb\xaa\x1d\xb8\x0b\x0f\xd5\xbe\xeb\xcb\xb15\xd9\xe5[}~\xeav\x7f\xab\xf6\xc3\xe5\xbd\xdc\xed\xfa\xbf\x0c\xf5\xd0T\x7f}\xdbu\xfd\xa1\xea\xb3]7\x0c\xdd\xe9\xa9\xed\xda\xea\xab\xe0>]\xdbC\xd5\x8f\xdd\x98\xd2\x1e\x11\x0f\x87n\x18\xaaCZ\x85\x03\xbc\xeff\x97\xa1\xef\xdag\xdb\xfaW\xeb\xa0\xba}\xa9\xfaz\xc0\xc2]\xd7\x1c\xaa\xfe}\xdf\x1d\xaa\xd9\xb7\xddav)O\xe7\xbf\xd3w\x87c\xeb\xba\x7f\xb87\xd5S=\x94M\xbd\x7f?\x95\xfd7\xed\xd4\xffs<\xce\xbf\xba?\xe7\xf3\xf9\xfb\xe5T6\x0d\x19<\xdb\xf9\xaf\xdf/\xd7\xdd\xecr=\x93\xd2\xcd\xea\xd7_\xe9\x80\x9f\x7f=w\x97\xda\xb8\xa0\xaf\x9ar\xa8\xbfW_\xbfW\xe3\xd0-\x9b\xacl\xea\xe7\xf6iW^\xaa\x912j{s\xdd\x90=\x16\xab\xea\xf4>\xea\x1e\xba\xf3S\xf68\xfe*\xaf\x87\xba\x9b}\xaf\x0fU\x17\x06d\xdd\x1akv\\\x1a\xc0S\xdb\x0d\xbf\xf9\xcb8\xfa\xfa\xae\xb9\xfc\xf5\x87\x005]\xeb\xab\xf5^\x9f\x9e}\xd7[g\x8c\xe2\xf7\xcb\xf7g\xc3\x7f\xea\xbbn\xf8\xe1-\x0c\xd7\x97\xfap\xa8\xda\xf7\xddu\x18\xbavV\xb7\xe7\xeb0\xeb\xce\xc3\xe8\xb0\xf3\xecR5\xd5~\x98\x8d]^\xf6U\xc9\xfa\xe4R\xb6\x97\xecR\xf5\xf5\x91v\xc5x\xad\xabi!\\\xd9\xd4\xca\x9b\xbab\x9c\xd4\x1a}3\xe3\xcc\x8c\xffc\xd7\xdb\xe1\xfb\xfe\x97\xe1~\xae\xfe\xdf\xbe\xbaT\xc3_g\xf6\xc7\xe5\xba;\xd5\xc3_g\x8e<\xceK\x0fVbK\xfe\xfa\xe6Gvy>We_\xb6\xfb\xea\xc9\x8a\xde\x19\xee\xe9);u\xbfd\xc7n\x7f\xbddu\xdbV\xfd\x8c\x9aK\x8a]\x05\x80\xdc*\xd6\x02\xdd;_\xcf\xe5\xe10N-sQ\xa7H\xed\xeb\xf6\x99W\x08\xca|m\x84\xd0U\x85\x97\xbeu\xd7a\xec\xab\xa7\xfc|s\x17\xef\xc3\x8f\x06\xf8\x7f\xab\xdb\xf0~\xac\xab\xe6p\xa9\x867_\xb9\xc7\xc5\xaa:=<n\xcc\x7f\xd7f\x187\xd5s\xd5\x1e\xe8\xe4\x18&\x9d\x9b\xbb\xc4\xdc\x95\xff\xd5\x0f\xd6\xa1\xdc5\xd5\xd7Sy\xcb^\xeb\xc3\xf0b\x87Lh\xff\xd7\xd7\x97z\xa82s\x89?\xd9\x9b\xce\xfb\xb9\xef\x9e\xfb\xear\x81\x97F\xf2\x9a\x0b\x836\x8c\xb3\xf2:t\xce\xbf\xfb\x97j\xffm\xd7\xdd\xfc(\xea\xcbC\xdd\xfd5\xd1\x0e\xd99\xed\xf5\xb4\xab\xfa\xb1\xc7\xdd\xc82\xbd\x9a]\xceu\x9b\xb9a\x98\x00v\xd7\x81\x03\xdf\xdceB\xaav\xa9\xca~\xff\x02\x87\xed\xd8$\xd3+_]\xcfe\xdd\xf1x\xa9\x86\xa7\xac8\xdf8=\xda\xb4\x05\xd9~\xd4\xd0\xf0\xfa\xa5\xb0qvG\xb50Wb\xe4\x1c\xeb\xa6\xca\xae\xe7\xa6+\x0f\xbeQ\xc9+\xce\xcc\x14\xe1^p\xa8\x86\xb2n.\xb3S\xd5^\xc5}\xf8r=\x9d\xca\xfe\x1eJ\x9b\xfa2d\xf5P\x9d\xde\xf7e\xfb\xbd\xc4C\xe1}\xa8N\xe7\xa6\x1c*61\xbe\xff\xc5Nq\x7f\xe5\xa5\xe6\x01\x06\xf77\x9e\xe5\xde\xffi\xf6\xf4T\x1e\xc7g\x91\xa7\xa7]u\xec\xfa\x8a\xf2\xc3\x0dn\xac\xc9\xffw\xed\x86jv8\xcc\x0e\x8d\x7f\xd8x\xc9g/\xc5\xece1{Y\xce^V\xb3\x97\xf5\xec<;\xc7G\x0d?7\x92\xbb\xd5\xd3\xfcaN\xc6\x9e\xbb\x84\xcd\xd5\x0b\xae\\?$\x9eV\xe7\xdb\xc38\x9c\x1eB\x07\x99\x99g\xbc\xe0\xed\xfd/^\xd8\xde6\xb1\xd25\xb3k\x13+\x05\xdbl\x1ce/\xde\xf9W6\x97]\xba\xa6>\xf8\"w\x87=\x94U^m\xf4]\xc9`\xf9t7\xfbp~\xf7*\xc6\xeb\xf5zy\x9a\xc7\xab\xbc\xaf\xcc\x0d\xc8\xcf\x06\xc6\x9e\x98d\xe8\xb5\xf6\xf7\xdf\xef|\x07\x1b\n\xbd\xf6\xcf\xd7!;7\xe5\xbez1\x8f1\x81=\x81y\xe3Scw.\xf7\xf5p\x7fz\\9\xed\xe3s\xf4\x84b(\xfeH\xe7GJ\xffA\xadX\xd7\xa74\xfc\xa5\xef\x9a\xd8\xf7\xae\x83\xf7\xd7\xfe\xd2\xf5O\xe7\xaen\x87\xaa\x7f7w\x8b\xb78\xa2\x9a\xf2|\xa9\x9e\xfc\x1f\xef\x8f\xe3\xd3PY\x8f7\xd5\xd8\xd1\xef\xff|\xaa\x0eu\xf9\xf0\x9bS\xdd\xba\xfe_m\xd6\xe7\xdb\x0fo\x04\x1e\xc7\x86\x91\xbd\x03\xd2f\xbdM\x92\x8c\x0c\x91\xbe|)\x92$#C\xa4\xbc\x98\xcf\x93,+|\x7f\x7f4\x93\xa0\xb98\xde\xcc\x9f\xf0\xf9\xe11N\xba\xd9(J\xcd\xe0_\xcd\xb3\x80,\x94\xd3\xfc\xe3\xee9;\xd6\xb7\xea@\x9f\xa2\xcba(\xf7/\xa7\xaa\x1d\x9e\x8c\xcc\xa0\x9an_6	\x94\x91\x19\xd4e\xdfwM\nf\x85\x06G\xd6=\x93\x8b\"\x03\xde5\xe5\x1e=\xe6\x17E\xf1\xa58\x1a\xc8s_\xdd\xb3C\xd9\x7f\xab.@\xe1\xffY\x1c\x96\xdbU!\x90=\x00\xae\xe7\xeb\xe3f\xc7\x81\x00\xb6\xdd|Y\x95y\x80\x01\xc4n\xbb/\xf6\xfb\xa8\xa8\x19\xa7&\x80s\xb3'\xc7\xc1\xaa\x1d\xf3\xe3\xea\xb8\x95H\xd8\xdc\xe3\xf6X\x1e\xadq\xf3\xb8\x05\x97H\xd6q}u\x98\xf2\xdbn~\x98\xef9\x10\xba\xad\xc8w\xf9\x96\xe1\x00j\xbf\xcf\x8fy\xe9Q\x00P-\x16\xcb\"\xd6+\xe5\xb3\xea\xb8\xda\xac\x16\x1c\x86]\xf6\xa5\xdc\x97\xa5\x00b\x8f\xed\xab]e\x91]_\xb6\xcf\xd5\x84S\x96\xeb\xa2\xcc\xd7\n\x0b\xfd\x92/v\xf9QB\xd10\xa86+\x06Du\\\x7f\xf9\xb2`\xcaR\x0e:\x96\xe5a\xbdPH\xec\xa3\xfd\xe1\xcbn\xad\xb1\xd8M\xc7\xe3\xaa\xb2\x97\xc7\xbdj\x9a\xeeu\xcaM\xab\xc52\xcf\x15\x16\xbai\xbb<\xe6\x07	E\xf6\x8b\xc3\xbcX\x12 \xaccuX\x96TY\xd2M\xc7\xe3b[($v\xd3\xf1\xf8e\x0f\xb0\x89\xd1t\xdcUa\x16\xa9\xda	/\xcd\x8f\xc5\xb1\xc8%\x14\xd5 /\x97\x9b\x85D\"\xdc\xf1\xcba\xb5\x8a8tYo\xf7\xf9\xa6 \xaaR\x1eZ\xe5\x87\xed\xb6\x94@X\xbd\xb28\xaevG\x05\x85\x8d\xae\x16\xc7}e\xa7\x95\xa1*\x9b)\xf7\x1c\x16\x0b\xd7\xe8\x88D\xe6\x8b\xf9j\xbe<r ly\xb9\xfdr\x0804l\x0f\xfb\xf9n\x15\x15\xa5\\\xb3^\x1eV\xfbR\xe0\xb0g\xe6\xc7yu\x90H\xec\x98\xed\xf1x\xb4w\xe5]s\x9d\x9a\x84\xf2\xa2\xd8.J\x81\x84\xc3f\xbf8\xac\x04\x10\xf9o\xb3\xf9\xb2;\x04\x18r\xdd\xf2\xcb\xfc\xb0\x8f\x8a\x92\x8e\xd9\xef\n7\xf8#\x0eVm\xb7?T\xc7R\"\xb1c\x8e\xc7\xad\xbbc\xd5\xed\xa1~\xee\xa6\\\xf3%\xaf\x16[\x85\x85\xa3\xe6\xb8X\xaf\x94Zt!\xac\xd7\xf9nA\x80\xa8\xe1\xab\xcdr\x7f\xa0\xcaR.\xdal\xb7\xeb\xc3F!\xb1\x93\x8a\xdd\x86\xb7|\xcaM\xeb\xca\xbb\xe9|\xed\xcf\xcd\xd4\x08*\xf2|\xbb\xd8+,\xaa\xc3b[\xecVJ-j\xd8\x97e\xb9\xa7@\x80\xf9\xb2Z\xe7UA\x95\xa5\xdcTn6_\xaa/\n	\xabxX\xefv\xc7\xbd\xc6\xe2\xf9yQ\xed\xbc\x9b\xea\xf6\xdb\xe4M,/\x8a\x95@\xc2[\xd8\xb1\xc8\x17B%\xea\xa0\xdd\xaa\xd8\xae\x03\x0c\xd5m\xbd>|\xd9EE\xe9{\xfc\xa6*\xb7\x02\x97\xb8u\xedvn\xa6\"\xc8\xc4\xfd\xbd\xdaU\xc7\xf7\xc7\x97\xee{\xd5\xff\xd7\x13\x7fP\x7f2\xa5\x9a\xc5\x1e\xd7#\xd3<\xb5\xa78\xe1\xd9=\xe2\xe9#|\x92\xe6\x1f\xe4\x11\xadO\xb2\xfcS=`%9\xfe\x11\x9fs\x92p\xff\xbc/L\x18_'I\xfe\xe1\x1f\x91&\x9a\xe3W\x02\x906\xe1<\xbf,\x88<\xb3:H\xe3\x8f\xac\x83\xc8R!\xdd?n\xc1\x00X\x13\xdd\xe3V\x0f\x9a\x94\xa4\xf8\xa5\x04\xa3$\xd1~]\xc1\x0dL\xf7\x8d_d\x00\xceT\xd7\xb8\x15\x07bM\xf5\x8c[~D\x1a_\x85$\x89~-\x82\x89\x13.w\x0b\x13\xc8K\x8fW\xb7J\x91\xact\xbb\xdc\x92E\x99\x99\xf6\xbd_\xbf`\xda\x94\xfb\xddb&A\x9c\xea\x01\xb7\xb2\x89L\xbe\xc0I\xf7\x80[\xe6`\xe2D\x0f\xb85\x0f\xe4\xa5\xab\xe9\x16@\x925\xd1.\xbb\x1aRf>\xe8\x01\xb74\xc2\xb4\xa9\x1ep\xeb\xa4\x04q\xf2\x1a\xb0\x8b\xa6\xc8dk\xa7$\xcf\xaf\xa0 /]Q\xbf\x9cB\xb44\xc9\xad\xad\x04)=\x1d\xba\x85\x9642\xed|\xbf\xea\x82\xac\x89&\xf9%\x18\xe6M\xb8\xd0\xaf\xc7\"\x91.\xcb\xd2\x9ew\x8b3DK\xd7\xd2\xaf\xd4\x00k\xc2\x8fv\xd9\xc69\xe9k\xd2\xad\xe1\x84\x89i\xaf\xfb\x05\x1d\"M9\xdd\xad\xee m\xca\xe7n\xa9\x17yt\xc5\x97\x1e\x81n\xdd\x87h\x13\x83\xdd-\x02\x01+\xddOnE\xc89\xe9.r\xcbCa\xe2\x03\x9f\xbb\xb5\"\"M4\xc7/\x1c!m\xca\xe7n\x15\x19y|1\x99\xf6\x9f[Rb\xe2\xc4Xw\xebK\xc8K_\xffn\xb1)Yi7\xba\x95\xa723\xed}\xbf\x0c\xc5\xb4)\xff\xbb5i\x828\xd5\x03n\x81\x1a\x99|\x9d\x9a\xf6\xa4[\xadbb\xba\xaa~\xe9\nyi\xcf\xb8u\xacd%	~Q\xab\xccL\xf7\x80_\xe1b\xdaD\xb3\xfcr7A\x9c\xba\xcd\xba\xb5/a\x92%pzJu\x0baD\x9bx\xc8q\xabb\xc0J\x0f\x11\xb7D\xe6\x9ct{\xdczY\x98\xf8\xe8\x01\xd3.\x9e\x11i\xf2\xe1\xc6\xae\xa4!m\xf2\xe1\xd2.\xab\xcd\x9bz{\x8f \xcbj\xfb\xc6_\xb1\xd8\xb2:2\xed\xb2:\xc1	\xcb\xea\x88g\xcb\xea\x14\xcd/\xab\x11\xadO\xb2\xfc\xb2\x1a\xb0\x92\x1c\xbf\xac\xe6\x9c$\xdc/\xab\x85	\xdb\xbb)\x92_V#\xd2Ds\xfc\xb2\x1a\xd2&\x9c\xe7\x97\xd5\x91g\x97\xd5I\xfc\x91u\x10]V'\xfb\xc7-\xab\x01k\xa2{\xdc\xb2Z\x93\x92\x14\xbf\xacf\x94$\xda/\xab\xb9\x81\xe9\xbe\xf1\xcbj\xc0\x99\xea\x1a\xb7\xacF\xac\xa9\x9eq\xcb\xeaH\x13\xcb\xea\x14\xd1/\xab1q\xc2\xe5nY\x0dy\xe9\xf1\xea\x96\xd5\x92\x95n\x97[V+3\xd3\xbe\xf7\xcbjL\x9br\xbf[V'\x88S=\xe0\x96\xd5\x91)\x96\xd5\xc9\x1ep\xcbjL\x9c\xe8\x01\xb7\xac\x86\xbct5\xdd\xb2Z\xb2&\xdae\x97\xd5\xca\xcc\x07=\xe0\x96\xd5\x986\xd5\x03nY\x9d N^\x03vY\x1d\x99|Y\x9d\xe2\xf9e5\xe4\xa5+\xea\x97\xd5\x88\x96&\xb9e\xb5 \xa5\xa7C\xb7\xac\x96F\xa6\x9d\xef\x97\xd5\x905\xd1$\xbf\xac\xc6\xbc	\x17\xfaeu$\xb2eu\xd2\xf3nY\x8dh\xe9Z\xfae5`M\xf8\xd1.\xab9'}M\xbae\xb501\xedu\xbf\xacF\xa4)\xa7\xbbe5\xa4M\xf9\xdc-\xab#\x8f-\xab\x93#\xd0-\xab\x11mb\xb0\xbbe5`\xa5\xfb\xc9-\xab9'\xddEnY-L|\xe0s\xb7\xacF\xa4\x89\xe6\xf8e5\xa4M\xf9\xdc-\xab#O,\xab\x93\xfes\xcbjL\x9c\x18\xebnY\x0dy\xe9\xeb\xdf-\xab%+\xedF\xb7\xacVf\xa6\xbd\xef\x97\xd5\x986\xe5\x7f\xb7\xacN\x10\xa7z\xc0-\xab#S,\xab\x93\x9et\xcbjLLW\xd5/\xab!/\xed\x19\xb7\xac\x96\xac$\xc1/\xab\x95\x99\xe9\x1e\xf0\xcbjL\x9bh\x96_V'\x88S\xb7Y\xb7\xac&L\xba\xacN\xd1\xfc\xb2\x1a\xd1&\x1er\xdc\xb2\x1a\xb0\xd2C\xc4-\xab9'\xdd\x1e\xb7\xac\x16&>z\xc0\xb4\xcbjD\x9a|\xb8\xb1\xcbjH\x9b|\xb8\xb4\xcb\xeaq\xa22\xc1W\x14\x13\x82\xb7\xac\xc8\xa0\xf6U+^\x91\x07\x94\x15\x19TS\x1d\x07\x88\x19\x05\x011er\x94?\x10\xbb\x06?t\xe74x\xe8\xce\x06\xd9\xd7\xe2m\x7f\x8cA\x1b%\x113e\xde\x00\xa8}\xcbHU\xc0\xc2}\x0dR\xa8P\xc3\xea\\\x95\xac\x8a\xb6\xe4\xc9\xfec0m\x97\x86\x05\x19\xd1\x96\xdd\x92\xfa\xb2\x1b\xc5\xdd\xd3\xb8\xbb\xc1\x95\xd7\x81\x1d\xcd11\x03& \xc0\xf4\xbf\xdc\x9f1bS\xea\xe4\xe6t6@\x98\xf2\xf7GqN}\xe2\xdc\xba\x0f\x85\xa8\xcee_\x0e\x1a\xe9\x05\x01\xc9ND\xd3\x00\x0b~\x1a\xdaE\xb1\xd8\x13\xd1,\x0e#\x9c\x86\xb6\xa5\xfcD4C\x86\xd3\xd0\x12\xd9\x0b`8\x0d-\x80\x02\x16NCG\x98@\x84\xd3\xd0DQc\x87:\x8a%\xd18U\xb5p\x1aZ!Us\xc3ih\x17\xd8bODs\xc81:\x8e\x9d\x88\xe6~\xf3\xa7\xa1\x05P\xb9\xcd\x9f\x86\xe68\x81\n\xa7\xa1\x03J\x00\xc2i\xe8\xa8\x06\xf9,\x9c\x86\x160\xed2\x7f\x1aZ\x02\xb5\xc7\xfcih[*OD3l8\x0d\xad\xb1\xca/\xfe4\xb4\x82\xcaa\xe0OCS\xa0\xac\xa3?\x0d\xcd\x94!\x07\x85\xd3\xd0\x1a\xa9}\xe4OC\x03\xacv\x93?\x0dmK\xe5\x89h\xee&\x7f\x1aZc\x95\x9b\xfcih\x05\x95\xf6\xfdih\nTut\xa7\xa1\x992\xe8&\x7f\x1aZ#\xb5\x9b\xfcih\x80\x05\xa3\xc9\x9d\x86\xb6\xa5\xe2D4\x83\x86\xd3\xd0\n*k\x10NCK\xa4\xc4\xf9\xd3\xd0\x04'/k\x7f\x1a\x9a\xaaB\x1e\n\xa7\xa1\x15PU/\x9c\x86\xd6P\xd5\xe8p\x1a\xda\x96\xf2\x13\xd1\xdc=\xfe4\xb4DJ\xf3\xe14\xb4\x00\xaa\x96\xbb\xd3\xd0\x11&\x87\xad?\x0dM\x14!\xd7\x84\xd3\xd0\x12\xa7=\xe3OC+\xa4v\x8c?\x0dmK\xf9\x89h\xde\xcb\xfe4\xb4D\xaaa\xe3OC\x0b\xa0\xf4\x9f?\x0d\x1da\xd2u\xfe44Q\x04\x1d\xe3OCK\x9c\xaaZ8\x0d\xad\x90\xda1\xfe4\xb4-\x95'\xa2y\x8b\xfdih\x8dU\xa3\xc6\x9f\x86VPy!\xf8\xd3\xd0\x14(\x1b\xeeOC3e\xc8E\xe14\xb4Fj'\xf9\xd3\xd0\x00\xab\xdd\xe4OC\xdbRy\"\x9a\xb7\xdd\x9f\x86\xd6XY\x87p\x1aZAe\xc3\xfcih\n\x14\x98p\x1a\x9a)Cn\n\xa7\xa15RU1\x9c\x86\x06X=?\xfb\xd3\xd0\x0e\xccND3d8\x0d-\x91\xea\x16\xe6OC\x0b\xa0\xec \x7f\x1a:\xc2d\xdd\xfcih\xa2\x08\xdf\xe3\xddih\x89\x03\xb7.w\x1aZ!\xc1\xfd\x9d\x9f\x86\xb6Bp\"\x9a\xb2\xe0ih\x0b`'\xa2\xf9\xe8\x13\xa7\xa1\xad\x10\x9d\x88\xe6#Q\x9c\x86\x96\xb4\x1e\xb2\xe4ih\xc1\x82\x1cy\x1a:r \\\x9e\x86&&\x8c\xaf!I\x9e\x86\x96\xa4Ds\xe4ihEK8O\x9e\x86\xb6Bv\"Z\x8c\x06\xd5A\xe0D4\xef\x1fq\x1aZ\xb0\x12\xdd#NCs\x12\xa4\xc8\xd3\xd0\x81\x02\xd1\xf24t4\x90\xee\x1by\x1aZpR]#NCKV\xaag\xc4ih+\xc4'\xa2\x19Q\x9e\x86\xd6\xc4\x84\xcb\xc5ih\xc5\xc3\xe3U\x9c\x86\xa6,\xdc.q\x1a\x9a\x99I\xfb^\x9e\x86\xd6\xb4\x94\xfb\xc5ih@L\xf5\x808\x0dm\x85\xf8D4\xef\x01q\x1aZ\x13\x13= NC+\x1e\xae\xa68\x0dMY\x89v\xf1\xd3\xd0\xcc\xccD\x0f\x88\xd3\xd0\x9a\x96\xea\x01q\x1a\x1a\x10\x93\xd7\x00?\x0dm\x85\xf0D4\xe3\xc9\xd3\xd0\x8a\x87+*OCK\x1a&\x89\xd3\xd0\x84\x84\xa7Cq\x1a\x9a\x1aI;_\x9e\x86V\xacD\x93\xe4ih\xcdK\xb8P\x9e\x86\xb6Bt\"\x9a{^\x9c\x86\x964\\Ky\x1aZ\xb0\x12~\xe4\xa7\xa1#\x07_\x93\xe2441\x91\xf6\xba<\x0d-I)\xa7\x8b\xd3\xd0\x8a\x96\xf2\xb98\x0dm\x85\xe8D4\x1f\x81\xe24\xb4\xa4%\x06\xbb8\x0d-X\xb8\x9f\xc4i\xe8\xc8\xc1]$NC\x13\x13\x13>\x17\xa7\xa1%)\xd1\x1cy\x1aZ\xd1R>\x17\xa7\xa1\xad\x10\x9f\x88\xe6\xfe\x13\xa7\xa1511\xd6\xc5ih\xc5\xc3\xd7\xbf8\x0dMY\xd8\x8d\xe2443\x93\xf6\xbe<\x0d\xadi)\xff\x8b\xd3\xd0\x80\x98\xea\x01q\x1a\xda\n\xf1\x89h\xeeIq\x1aZ\x13qU\xe5ih\xc5\xc3\x9e\x11\xa7\xa1)\x0b\x12\xe4ihf&\xdd\x03\xf24\xb4\xa6%\x9a%OC\x03b\xea6+NC;&8\x11\xcdh\xf24\xb4\xa4%\x1er\xc4ih\xc1\xc2CD\x9c\x86\x8e\x1c\xdc\x1eq\x1a\x9a\x98\x98z\xc0\xe4\xa7\xa1%)\xf9p\xc3OC+Z\xf2\xe1\x92\x9f\x86\xb6Bp\"\x9a\xb2\xe0ih\x0b`'\xa2\xf9e\"NC[!:\x11\xcd\xaf\x12q\x1aZ\xd2z\xc8\x92\xa7\xa1\x05\x0br\xe4i\xe8\xc8\x81py\x1a\x9a\x98\xb0\xbd\x8bH\xf24\xb4$%\x9a#OC+Z\xc2y\xf24\xb4\x15\xb2\x13\xd1b4\xa8\x0e\x02'\xa2y\xff\x88\xd3\xd0\x82\x95\xe8\x1eq\x1a\x9a\x93 E\x9e\x86\x0e\x14\x88\x96\xa7\xa1\xa3\x81t\xdf\xc8\xd3\xd0\x82\x93\xea\x1aq\x1aZ\xb2R=#NC[!>\x11\xcd\x88\xf24\xb4&&\\.NC+\x1e\x1e\xaf\xe244e\xe1v\x89\xd3\xd0\xccL\xda\xf7\xf24\xb4\xa6\xa5\xdc/NC\x03b\xaa\x07\xc4ih+\xc4'\xa2y\x0f\x88\xd3\xd0\x9a\x98\xe8\x01q\x1aZ\xf1p5\xc5ih\xcaJ\xb4\x8b\x9f\x86ff&z@\x9c\x86\xd6\xb4T\x0f\x88\xd3\xd0\x80\x98\xbc\x06\xf8ih+\x84'\xa2\x19O\x9e\x86V<\\Qy\x1aZ\xd20I\x9c\x86&$<\x1d\x8a\xd3\xd0\xd4H\xda\xf9\xf24\xb4b%\x9a$OCk^\xc2\x85\xf24\xb4\x15\xa2\x13\xd1\xdc\xf3\xe24\xb4\xa4\xe1Z\xca\xd3\xd0\x82\x95\xf0#?\x0d\x1d9\xf8\x9a\x14\xa7\xa1\x89\x89\xb4\xd7\xe5ihIJ9]\x9c\x86V\xb4\x94\xcf\xc5ih+D'\xa2\xf9\x08\x14\xa7\xa1%-1\xd8\xc5ih\xc1\xc2\xfd$NCG\x0e\xee\"q\x1a\x9a\x98\x98\xf0\xb98\x0d-I\x89\xe6\xc8\xd3\xd0\x8a\x96\xf2\xb98\x0dm\x85\xf8D4\xf7\x9f8\x0d\xad\x89\x89\xb1.NC+\x1e\xbe\xfe\xc5ih\xca\xc2n\x14\xa7\xa1\x99\x99\xb4\xf7\xe5ihMK\xf9_\x9c\x86\x06\xc4T\x0f\x88\xd3\xd0V\x88ODsO\x8a\xd3\xd0\x9a\x88\xab*OC+\x1e\xf6\x8c8\x0dMY\x90 OC33\xe9\x1e\x90\xa7\xa15-\xd1,y\x1a\x1a\x10S\xb7Yq\x1a\xda1\xc1\x89hF\x93\xa7\xa1%-\xf1\x90#NC\x0b\x16\x1e\"\xe24t\xe4\xe0\xf6\x88\xd3\xd0\xc4\xc4\xd4\x03&?\x0d-I\xc9\x87\x1b~\x1aZ\xd1\x92\x0f\x97vYm\xce\xadV\x07\xfbq`\x07	\x9f\xd3\x0e\xd2\xcbI\xc8\x1e\xf3b\xd5W\xa7\x80\x90b.\xcd\x9ag	\xe0\xf2\xe3\xb5i\x04\xe2\xcb\x97/_\xce\xb7\x08\x19X\x15\x87\xeel\xcfI\xfb\xba~%\x02{\x80Y\xb7\xa2W*8\xd2\xeb\xb0g\xa1\x93jvL\x0d\x04\x0bM\xac\xa6\xc4+\x9fk\xd2\xb4\x92\x81t\x8eT\xe1z)\xe9\x1b\xd9\x8bY/\x94!\xf4\x94\x97\x94\xc2\x1dQ8A\x98h\xaaR\xd9|\xbe\xc1\x9fQ7$u}\xe0;\xe9\xba\xb4\xdf>v\x9b\xf4\xda\xa4\xcb>l\xa2t\xd8\xe7Z\xf8	M\x03\xb9\x90\x95\xb2io\xc9q\xc6\x15i\xec\xa4\xbb\xe4\x18\x8b\xca\x92\xf0\xa9V\xca\xf1\xf5\xc9F~\xacj`\x13\x9bTfg\xb8\xa4\xcb\xe4\x04\xd8+e\x00>\xe56\xa9p\xc7\x14\xa6\x19\x13\xed\x95*\x9b\xbf\xa3\xc1\x9fP7|0C\x12\xe4Gs;iv\xff\xf1\xfcM\xd0\x0dB\xa7j\xf1\x89\xb9\x89\xd5\xf9\xe3\xd9\x96\xd5\xfbSs)\xab\xbbbL\xd7\xe8\x83\xa9\x82\xd6\xfd\x83\xe9\x8e\xd6\xfb\xe3\xd9\x8c\xd6y\xaa\xc2\xaa\xbe\x1f]\xab\xcc\xdb\x1f\xcd9\xcc\xd7\x9f\x98S\x98\xa7\x15~\xaa.\x9f\xb9NX\xd5?q\xe9\xb3\xda\x7f\xee\xcaf\x0d\x00\x14T)\x870\xf9Z<\x9c\xa6p\xf1\x80Cyy\xa9\x04\xc2\x96E\x88IU# 6k\x9c\x87\xd0k\x8f\xa4/\xf3\xe2\xb9\x97\xb9\xfc3APpAAj\xbe\xe4\xa2%\x11m\xb9h\x1bE\\\x90\x13\xce\x10+1v\x8c\xac\x08\xa9\xa3\xed\x00	\xd8E\x80\xf3\xbaD4\x11a:D\xca\x87\xd8\xdaX\x03\xdab\xe2\x0eZ\x07\n\xd9E\x08\xab\x05\xc54\x11C\xeaQ0_,uM\x96\xac&KT\x93%\xab\xc9\x12\xd6d\xc9j\xb2\x045Y\xb2\x9aluM\xb6\xac&[T\x93-\xab\xc9\x16\xd6d\xcbj\xb2\x055\xa1\x88A\xd7\x83\x0e\x9f\x1e\xd5\x82\x02\xe4\xb3\xa0F4\xa0\x06Fn\x93\xe7\xd8(G\x97H\xc7\x067:\xc1\xa1:\x96\xd7f\xf02\xf73\x88]\xd2\x1d\x99\x83\xc7\x8b_\xcb:P\xc7\xbf\x83\xe0\xd4}\xaf\xbc`\xfc;\x08\xdan\xc8\xca\xa6\xe9^\xab\x83\x97\x93\xa2\xf7G\x93,K\xa4\xfez\xa4)\xbdp\x9e/\x07\x11\xc2\xf7G\x9b,\x88%\x9as\x85Y\xdf\xbdr\xc1X\xe2\x85\xfb\xaai\x84t,z\x7f\xb4\x99\xc3x\xe2\xb0\xc7cS\xddB\xd1\xf8#T\x99IH\x99\xe5\x98:\x98?\x0euoS\x98>\x99Jxa\xd6W\xdf\xab\xfeR\x01\x90\x179\xf0\xbek$h\xdf5\xd7S\x1b\xe5)e\x16'\xf4\xbd\xf6\xe5\xf9-\xfc\xf54\xfe\x87H\xb8\xaa\x80\x10:\xdaN\xaai;\xab\xa8\x1e\xaa\xd3%\xbb\x0ce?\xbc\x99$}&\x93\xdb\xc5\xf8\xce\x16{L\xd5\x1e4\xa2j\x0f^\xee\x02\xb4)\xc4\x07f[\x80\xcf\xfe\xc7 !%`\xa8I_\x0d\xfb\x17\x86qe\xef\x8f\x97\xaa9\xda\x0b\xc8\x8a\xc7\xdf\xee\"2\"\xda\x0c#\xa3\xad0\x88\xd8\x88(7m0R\xd6\x04\x03\xf0-p\xeai\xdd\x8c<T\xedo\xd7\xcbP\x1f\xef\xae\n\xfe\x97\xcbF\xcb\xea\xe1ecU \xce\xd4'Hl\x95$\xd0\xd7\xcb\x97\xef\xaa\xe1\xb5\xaaZ\x853\xb9\x1a\xbd4\xc2K\xf3\xa4\x91@[\xa1M\xdfU\xb5\x03\xf7\x8a\xac\x80\x07Q\xd7\xa3V{\\\xec\x00\xdd\xe6\x90\xbb\xd75\x86\xe3DS<\xd85\x05a}C\x8c\x85\xdc\x0c\xfe\xa7\xdc\xfd4\xa3\xc8\x94\xd8\xf1c\n\xeb\xb6\x1e\xea\xd2^\xbeO\xeeG\xb8\x82Z{\x8d\x91\x89&{\x0e\xb3\xc6\xf8W\xd0}y\xe9\xeb\xf6\xdb\x1b\xf9;\x88\xdaN\x92\xe6Q\x02xF\xda\x95\x83\xbd!\xbd\x99\xbf\xfd\xe7\x04\xac`\xbc\xcd\xb8\xf2\xc6|\xe3\xc0\x16\xbb\xfav&j\x7f\xac\xf0\xbe\xa9\xca\xfeX\xdfl\xca\xc07\xef\xa9_\xfdJ$\xff4\xb8\xa7]7\xbc\xbc?\xda\xdc\xb1e{\xe1\xc9e\xef\x97\xa1:e\xd7z\xf6cS\xb7\xdf~*\xf7?\x9b\x92?t\xed0\xcb\xca\xf3\xb9\xa92\x8b\x99\xfd\\=w\xd5\xc3\x9f\xfem\xf6\x9f\xdd\xae\x1b\xba\xd9\x7f\xdc\xee\xcfU;\xfb\xd3\xee\xda\x0e\xd7\xd9\xef\xcav(\xfb\xaaif\x7f\xa8\xfb\xf2\xe1\xe7\xb2\xbd\xcc~\xdfw\xf5\xc1\xfe\xf9\xafU\xf3\xbd\x1a\xea}\xf9\xf0\xc7\xeaZ\xcdH\xb6GW\xb1\xf1oV\xb3\xdfu\xede(\xdb\xa1.g\xff~\xdd\xd7\x87\xf2\xe1G\xe3+\xf7kG\x7f<\xfc<\xd2\xdd\x8f\xd9\xef\xab\xbf\x95\x7f\xbe\xba\xb2\x1f\xeba\xbc\xae\xcb\xd3\xc3\x9f\xab>\x00\xeb]e\xf3n\xba\x82\x7f\xa9\xba\xfe\xb9.g\xb4J\xa7\xae\xedX\x8d~\xaa\xda\xa6\x9b\xfd\xd4\xb5\xe5\xbe\x9b\x8d\xd5\xeb\x9a\xf2Bu\xfd\xd4\xb5\xa3\xe0\xda\xd7U\xff\xf0\xc7\xea5&Qv*_\xca\xdadpfI\x99\xf3\xf9\xdc\x89\x87\x97\xbae\xa2\"\x88\x1a;d\x88l\x11d6{+\x13.\x83\xf0T\x1d\xea\xeb\x89	WAx\xa9N\xf5\xaek\x0eL\xbc\x0eb%\xda\x04Qu\x1b\xfaR\xc9\xb7\x91j>\x8c@e_F\x99;\xa2\xc6\x9c\xe1\xce\xa6)\x97P\xec\xe8\x19\x80+$\xce\xbe\x04\xd0\xc0\x85\x04Z\x9f\x01\xe4R\"\xad\x03\x01r%\x91\xde\x9b\x00\xbb\x96\xd8\x04n#q\xc1\xcf\x00\xbcUJI\\\x9br\xbd{\x8d\xc1]o\xdf_(\xd7S\xacq\xbd\xc6\x15\x12G\xdf\xbf(\xd7S\xa0s\xbdF.%\xd2\xb9^#W\x12\x19\\\xaf\xb1k\x89M\xe06\x12\x17]\xaf\xc1[\xa5\x94\x9c}T\xae\x7f\xc9r\x9f\x7f\xd8\xef\xb3\xbcdE(\xf2%\x8bP\xb2\xf1EK_\x94\xbb\x82U(\x88\x9a\xd6\xb1\xcc\x17m}Q\xe1\n\xf2y(	\xa0<Ta\xe1K\x82\xaa\xa5\xafe\xa8\xc1\xda\xd72\xb0\xb6\xbe\x92\xc1Z\xee\xcd\xadc\xc5=\xcf\xdc\xa4if\xd8\xc7\x97\xec|\x0b\xb0qU\xf5bwJ^\xc2H\xfc\xf5Xv\xd9\x9bo\x05\xc4\xd2\xef/\xef\x8fMU\x1e\xea\xf6\xd9\xde\x1aY\x1e\xf4(\x1b\xc6\x02.|,V\x94k\xe6M\x0e \xf2\xa6\xeb.\\y\xf1\xfex\xca\xe61k\xef\xe3)\xcb\xfd/\xdf\x1f\xa7\xac\x08E\xbed\x11J6\xbeh\xe9\x8brW\xb0\n\x05Q\xd3:\x96\xf9\xa2\xad/*\\A\x1e\xeaS\x04P\x1e\xaa\xb0\xf0%A\xd5\xd2\xd72\xf0B\xbdC\x9d\xd6\xbe\xdeA\xcf\xd6\x95\x98^te\xb6\x17Oc/\xfaj\x8e\xbdx\xba\x07\x17\x8d+\xf3\xa7\xb9KK\xef\x96\xd9\xc6m\xb7\x08\x19\x1ft\"\xc6\xdc\xd5\x0d\xe4\x1e\\k\xb4\xb87*\\U\xf0\xd4-\x82\x8d>\x8e\xee\xd9\xa5w\xba\x87\x1e\xb2\x9a\x91\xe2\xa0\xb7\xe0z\x81\xda\xa0u\xc1\xb4n\x90\xdaM\xd0\xbb\xe0z7@\xf1&h^R\xcd\xb9\xd6\x9b{\xadK\xa65W:s\xafq\xc54B\xe7\xe6\xc4\xbb+\xae\x17\xb97'\xfe]s\xedPy\xd0\xbd\x16\xba\x91\xea\xa0yK5\x17Zo\xe1\xb5n\x99\xd6B\xe9,\xbc\xc6\x9c\x0d\xd6\x02U\xb6\x88\x95\xcd\xf9\xb8-@m\x8bX\xdb\x9c\x0d\xb4\x85\xd6\xbc\x08z\xf98[(\xad\x8b\xa0\x939w\xa9u.\x83N\xee\xd9\xa5\xd2\xb9\x0c\xd7\x03\xf3\x01\xf0@\xbc\x1cx\xfbu\xeb\xe35\xc6\x06\xedZ\xeb\\\x07\x9d|\xd4\xae\x95\xceu\xb8\xc2\x98?\xb7Z\xe76\\^\xdc\x9f[\xa5s\xebu\x92\x19\xcdh\x1d\x7f\x0b\xadn\xa2\xbb1\xac\xd1K\xc1=\xb9\xb5\x9d\xeeqV\xb4\x17\xc1\xf9&\xaf\x003W\xde\x08\xce\x8e\xff\x08\xec\xe3\x9d\xf14\x889\xf5\xfd\xf1\xd4\xc7\xa28k\xeeb!\x99n\x1b9\xdd\x1a\x8d`~5js\xae6HvQ\"\xe7\xdf\x06\xce\xbf\xc6\x8a\x9ek\x8d\x91B\x18	6\ni#\x98\x00S\xb1\xb1\x00\xe6]cb!Ll\x82\x8d\x85\xb4\xb1	F\xd0\xbcl\xac\xa89\xd8\xd8Xr\x1b\xb9\xb7\xb0\x14\x16r\xaf_\xcf\xd0F;\x9a\x8f\x8d\x81\x950@zc%m\x90\xee\x80\x13\xb6\xb1\x04\xe6fch-\x0d\x05;ke'\x98As\xb7\xb1\xa2\xe6icc\xcbm\x14\xde\xc2VX(\xbc~=\x8b\xdb\x91\x0b\xe6l;t\xc5%Q\xc4V\xe4\xf2\xc2(b3\xe0\xacn-\xa9\x19\xdc\xda\x11\xc3w\x11\xac\xc8\xe1\xbb\x086\xf4\x0co-\xa8\xf9\xdcZ\x10\xfd\xb1\x0c\x16dw,\x83\x05=\xdf\xdb+P\xcd\xee\xf6\n\x14\xbe\x8aW\xa0\xf4T\xbc\x02\xf5\xeco-\xa8\xb9\xdeZ\x10W\xc7:X\x90\x97\xc7:X\xd0\xf7\x02{\x8d\xab\x99\xdf^\xe2\xa2\x1f\xb6\xde\xc2B\xf6\xc3\xd6[\x00w\x06c\x01\xdd\x07\x8c\x0d*`s\xfc\x8e\x89\xf8\xbd\xa2\xc1\xf7\ncI\xdd\x19\x8c\x99XJ\xe7\xfd\x1d)g7\x8e\x06\xdc8\xde\x1fO\xe5-s\xab(\xf3']IY\x99[Mq\xe9\xb8\xa2\x1aK^\xb3\xdb\xc5\x88\xdc\x0b\xc8\xb9u\x8e\x91\\ND\xb2\xa0\x92\xd3\x81H\x96T\xd2<\x13\xc9\x8aJn\x0d\x91\xac\xa9\xa4`\xa2\x0d\x15-\x98hKEK&\xfaBE+&\xca\xe7T\x16<\x15\x84\xa3\xa3\xea6{\x19\xef\x98\xe3\xbfU\xb8\xb5\x9aRK\x88\x02\xca\xf0\xaeeR\xe3\xda\xba\xcd^\x9d\xc2\xf0\x8a\xd9\x16\x06}\xb4\x02\x19_dfd\x95\x99\xf9\x99<#\xeb\xcc\xec1\x94-b\xd9&\x14\x86u]\x96\xfb\xa2U,\"\x1a\xd7\xa44\x14\x86\x15gV\xf8\xa2\xb8\xe6\xcc\x8a\x08\x8c\xab\xcel\x11\xca\xa2\xcae\xa8wd\xc7\xb6\xc4:\xaeC[\xa2\xbe\xad/\x8bk\xcd\xcc\x0c\xf8\xec\x13\xab\xcd\xec\x13\xcb\xcdL\xae73\xb8&\"\xee\x97K\xce\x0c-\x8a\x08^\xac:3\xb4\xd0\x88\x1d)\x17\x9e\x19XhD\xb4X{fp\xf1IF\x84\\~fh\xfdI\xf0b\x05\x9a\x81%h\x18Zr\x11\x9a\xe9Uh\xc0\x8auh\x86\x17\xa2t\x88\xca\xa5\xa8\xa4x\x03\xd4\xefka\x03\x9b\x88\x16\xd6\xd2\x024\x10\xf5\xb3g\x9d\x0c,J\xc3\x85#\x97\xa5\x99^\x97\x06\xac\\\x99fpiJ\xae?\xb58\xcd\xd0\xea\x94\x10\xe4\xfa4\x03\x0b\xd4p%\xab%j\xa6\xd7\xa8\x01,W\xa9\x19X\xa6\x86\xf9@-T3\xbdR\x0d`\xb9V\xcd\x90O\xc8E$<\x02\xfcA\xaeO>\xc8\xc1\x925\xccMj\xd1\x9a\xe9Uk\x00\xcbuk\x06\x16\xaea\x86SK\xd7L\xaf]\x03X\xae23\xbd\xcc\xf4\xd3\xa4Zhfj\xa5\xe9\xa1z\xad\x99\xc1\xc5f\x86W\x9b\x19Znfr\xbdIfF\xb5\xe2$2\xbd\xe6$B\xb9\xea$\xa2\x01\xcd\xb6\xd6X!\x8dE[\xf2\xa11\xca\xe4\xea3J\x068\xf7ZS\x0bij\x13m\xc95(\x11\xcaU(\x11\x0d`&\xb6\xb6\xc4\xb3v\x90\xe8\xb5h\x10\xc9\xd5h\x10\x0cx^\xb6\x86V\xd2\x10\xed-\xb9&\xa5R\xb9*\xa5\xb2\x01\xce\xd2\xd6\xe0Z\x19\x8c\xf6\xe4b\x88\x08\xe5\xea\x94\x88\x060g[[b\x85\x1a$z\x8d\x1aDr\x95\x1a\x04r\x9dJ\xa6^\xbdR%B\xb0V%R\xb5Z%2\xb9^\x0d\x13\xb2^\xb1\x06\x11X\xb3\x06\x99Z\xb5\x06\x89\\\xb7\x86	Z\xaf\\\x83\x08\xac]\x83L\xad^\x83D\xae_3r%K\x1f\x92+Yy\x90\\\xc9\xe0\xa6\xe0,\xe9;\x80\xb3$\xaf\xaeu\xb4\xa4.\xafu\xb4\x04n\x12\xd6\x12\xb8#XKrE\x1bD`M\x1bdjU\x1b$r\xb5\xe9\xe7x\xbd\xde\xf4\x12\xb0\xe2\xf4\"\xb5\xe6\xb4\x82\xee\\\xee\xeba|\x0ew\x7f\x8d\x13\xbe/,V\xa1\xd4\xbc|\xf3\xe5\xab\x88~$\xc5\x1b\x02\xdf\x90\xf2|\x1e\xf1\xf9\xfbcw\x1d\xcc{9\xf3\xfa\xcf\xfdx\x8a\xafd\xa9\xd8\xbd\x94%\xa0\xee{\xd5\x1f\x9b\xee\xd5.\xd1\xfd/\xb7>\x0fBw$1\x88\xedo\x02\xf8^_\xea]SE\x84+ \x90\xcb\xbe\xef\x9a&\"\xeco\x02\xb8\xf1:d7Y\x8b\xbb\x00\xdc%\xe0&+\x9a\xddtU\xef\nt\xd7\xa0\x9bjQv\x03m\xbak\xd8\x1d\xc0n\xb2\xf1\xd9M7\xff\xae@\xf7\x00\xb2\xff\x9a\xf7\xb8\xddu\xff\xf2\x96\xbdV\xbbo\xf5\x90	\xe7\xd6\xed\xf3\x93\x01P\x86\xf1\xd9\x04\xc1\xfa\xf0\x9c\xcd\xdf\xce\xe5\xe10\x96\xcc\xc7\x9fy\xf8\xe9oL\xe7\xac\x88e\xbeh\x11\x8b6\xbel\x19\xcarW\xb2\x8a%Q\xdb\x9a\x14\xfa\xb2m(+\\I\x1e\xebU\x04X\x1ek\xb2\xf0EQ\xdd\xd2W7RC\x0bb\xdd\xd6\xbe\x05Q\xd7\xd6\x15\x9do\xb1j\xe3E}\xbeG\xef\xb8\x85\xb5\xff\x15\x1f\xf5\xce7\x02r+k\xff3<&\x9e\xef\xd1\xb1F\x93[\xb1	u\xc1I7\x027:\x05\xde*\x0e\xf0{\xec\"\xab\x1d*\x0f\xba\x0b\xa1\x1b\xa9\x0e\x9a\x17\\\xf3\x06\xaa\xde\x04\xdd\x0b\xa1{\x83\x94o\x82\xf6%\xd3\x9e\x03\xdd\xb9\xd7\xbc\xe4\x9as\xad7\xf7ZW\\+vvN\xbc\xbd\x12\xba\xa1\xbbs\xe2\xef\xb5\xb0\x80\x0d\x04\xfdk\xa9\x1f\xaa\x0f\xda\xb7L{\x01t\x17^\xf3\x96k.\xb4\xde\xc2k\xcd\xf9`.`\xa5\x8bX\xe9\\\x8c\xeb\x02\xd5\xba\x88\xb5\xce\xf9 \\\x00\xed\x8b\xa0[\x8c\xc1\x85\xd6\xbc\x08z\xb9\xb3\x97@\xef2\xe8\x15\x9e^j\xbd\xcbp\xcdp\x7f o\xc4KF\xf8\x02x\"^\x8b|P\xaf\x81\xdeu\xd0+F\xf5Z\xeb]\x87+\x91\xfbw\x0b\xf4n\xc3e(\xfc\xbb\xd5z\xb7^o\x9c\xf6\xec`>\xdf\xd4H6\xb3\xe1\x8d\"\xed8&P7\x88\x0dr\x90\xf3\xe6\xfb\xe3\xb9'eq^\xdc\x91R2\xa76jN5Z\xd1\x1cjT\xe7Bu\x10\xed\x88HN\xb2\x0d\x9ed\x8d%0\x9f\x1aC\x854\x14\xec\x14\xcaN0\x83\xe6[c\x05\xcd\xad\xc6\xccB\x9a\xd9\x04;\x0beg\x13\x0c\xc1\xc9\xd7X\xd2\xf3\xac\xb1\xb3\x14vroe)\xad\xe4\xde\x06\x98\x86\x8d\x058\xe7\x1a#+i\x84\xf4\xceJ\xd9!\xdd\x83gec\x0d\xcd\xbf\xc6\xd8Z\x19\x0b\xb6\xd6\xdaV0\x05'hcI\xcf\xc5\xc6\xceV\xd8)\xbc\x95\xad\xb4Rx\x1b`\xaa\xb6\xa3\x1a\xcd\xcbvX\xcbK\xa6\x88\xad\xc9\xd5\x85S\xc4\xe6\xe0\xa9\xdbZ\xd3\xb3\xb4\xb5%\x87\xf6\"XRC{\x11\xec\x80i\xdcZ\xd1s\xb6\xb5\"\xfbg\x19\xac\xa8\xeeY\x06+`R\xb7W\xa9\x9e\xc1\xedU*\xfd\x16\xafR\xe5\xb5x\x95\x82)\xdeZ\xd1\xf3\xb9\xb5\"\xaf\x9eu\xb0\xa2.\x9fu\xb0\x02&|;\x17\xe8\xd9\xddN\x05\xb2_\xb6\xde\xcaB\xf5\xcb\xd6[A\xd3\xbf\xb1\xa2\xe7zc\x84\x14\xd3y|G\x05\xec^\xd0\xa0{\xc1\xfb\xa3\x8b\xe2\xcb\xaa\xefU;\\\xec\xd2\x94\x97\xb9\xe8\x0d\x014\x8b\x15\x01tqPC9\xd4\xfb\xb7\x90\xfa\xd1\xfe~\x7f<\xd6\xb7\xea\x10\x8b\xcd\xcf\xf7\xc7rw\xe9\x9a\xebPE\x81/y\x7f\xec\xab\xa6\x1c\xea\xefD\xe6KF+\xf5\xfe\xdb=J\xfc\xba\xc9\x96\x7f%\xd6\xc7\xdf\xef\x8f\xe7\xba\xb5\x8d\x1b\xfci)\xeb6\xf3\xa7s\x94\xf9\xdb\xb8\xc6-\xb5\xea\xf6\xcd.\"\xdc\xed\xcf\x03\xe7_\xc3-\xaen\xb3\xbb\x03\x91\xbba\xddf\xb77O\xa2\xd8\xe1\xcd\xdf]\xeb6\xeb\xdf\xe2m\xb5nMd'\xd5\xd0\xbcyf_]\xea_\xdc\xbe\x81\xfd\xdbu\x8a\x13\xdc}\xe9\xf7\xaa\x1f\xea}\xd9\x04\xc9\xcdK^\xba\xbe\xfe\xa5k\x87(\xf3\x12\x1bhsy)\x0f&\xfd\xdb-\xb3\x7f>\xcd\x1f\x8a\xf3\xeday\xbe=\xcc\x1f\xfa\xe7]\xf9\x9b\xf9l\xfc\xffc\xfe\x83Gg\xa7\x03'\x8c\xe0\xad&\x14?\xccR\xca\xe6\xdb\xa8\xcdD\xcc\x13m\xf9\xea|{X\xcc\xb5\xbe|\xd47\n\x0d\"\xad\xb0n[\x13\xa9\x1dt\xd6\xed\xa5\x1a\x1e\x92uYG\xaa\xdbx\xe1\x15\x1a\xff\xbf8\xdf,iU\xcc\xf2\xe5rV\x14\xf3\xd9\xe3*\x12]\x80z`\xd9~r\xf1\x0e\xae\xcc\x7f\xd6\xf8S\xaef\xd4\xect@\xec\x7f\xd0\xef\\u\xf3\x8cT\xff\xe3\x9d\xc0\xb5\x9b\xbe\xd0\x06>\xd1#\\\x8f\xdf\x11\x03U\x9d\xec\x1e\xae\xc5l\xb1)\x15.\xae\xce\xee\xc6\xb92\xff\xad\xacO\xf5\x15\xa3\x8e}\x05\xd8\xff`_q\xd5\xcd3R\xfd\x8f\xf7\x15\xd7n\xfbJ\x19\xf8D_q=\xbe\xaf@U'\xfb\x8ak!\xdb\xa1\xba\xaf\xea\xa6\xc9\xf6\xd7\xde\xe4\xbd\x1d\x7f<\xb9\x1f\xbf\xeb\x9a\xae\x1f\xef\x12}\xf7\xad\n\x08\xfbS`lD\xb7\xb9\x9f\xd9?\x9b\xf2\xde]\xfd=\xc0\x16\xd9{\x17\x13\xbb\xfb\xd7P\xdd\\\x04\xa4\xf9\xcb\x04\x81\xba0HS\xe0BW\x89\xd0\xc7\xad\x9a\"s\x03\xa0RS\xe0\x84.8\x96\x8a]\x91\x03\xd0\xac\xbf\xf6;n\xecC\xe7\x06cc\xda\xfc'\n\xdd'\xcd\x8d\x84\xe5\xf8\xf5\xdf\"t\x1f/\x17\x80\xde\xcb\xfdg\xca\xb9\xdcK\xfd\x07\xc9\x83\xd4\x0b\xfc\xa7\xc7#\xcd\x86\x06\xfaO\x05\xba\x8f\x8c\x0bq4\xeb?'.\x01\xb1\xe2\xfe\xc3\xe1\x06a\x93\xf4\x86/\xdb\xf9\x06\xd3\xdc\xbc\xbe\xbd\xeec\xe0\\\x1e\x9b\xeb>\xfb\xcd\xc4^\xe8?\xf0\xed\x85\xbe\xdc\x7f\xca;\x90X[\xfdG\xbb\xb9\x944\xd5}\x9e[\xc8IK\xdd\x87\xb8\x0d@$\xd7\xf5_Bt\x9f\xdcV\x90\xd80\xf7qm\x89\x08=\xe2>\xa3M\xe4\xc1\xbe\xfb`6\xa5\xb2\x16\xfaOc+\x00i\xa4\xfb\x08\xb6\x86\x90v\xba\xcf]\x1b\x8c\xc8\x8e\x1b\xbe\xf8h?l\xad \xb1\x9d\xee\x13\xd6\x12\x11\x8c\xb8\x8fU\x13y\xb4o?KM\xa9\xbc\x9d\xee\x03\xd4\n@\xda\xe9>5\xad!\xb4?\xedG\xa5\xfd\xd8&\xe9m\x1d\xc2\x7f>Z\"\x82\x19\xff\xa1h\x01\x08b\xf7I\xe8(\x0e\xc3\xdf}\xfc\x99\x10Y\x13\xfdg\x9e\xa5<\x9a\xf6\x1ftV\x88X}\xff\xe9f\x03a\xf9i}\xfb\xdcG\x9a\x05 \xd8\xf0\x9fc\xe6\xf2\xd8\x06\xfb\xe1\xe5 \x0d\xa3\xc3}b9\xd2X\xdb\xfc\xc7\x94\x85\x984\xcd}6Y\x02H\xcb\xdc\x07\x92\x0d\x82%\x98\xf5\x9ew\x9fB\x16\x80\xd8q{\xfb\xd1c.\x0f\xedv\x9f7\x0e\xd2\xd0d\xf7!\xe3H\xe3-s\x9f,\x16\xe2h\xd6\x7f\x9cX\x02H\xcb\xdcg\x88\x0dBd\x88\xf5uw\x1f\x1cV\x90\xd8o\xee\xd3\xc2\x12\x11F\x97\xfb\x880\x91\x87&\xb8\xcf\x05S*k\xa3\xff0\xb0\x02\x90V\xbaO\x00k\x08i\xa7\xfb\xd8\xaf\xc1\x88\x14\xaf\xbe\x15\xee\xb3\xbe\n\xd2\xc7\x11h?\xe0+\x11\xa1\xae\xeeS\xbdD\xeeE\xfe\xa3\xbc\x94\xca\xda\xe9?\xbf\xab\x00\xd1\xbc\xff\xd0\xae\x86\x90I\xc6}R\xd7bh\x8eV\x7f\xb1\xb8\x8f\xe7\n@\x9cH\xddgr\xb9<\xb8\xd1}\x107H\x83]\xf7\xe9\xdbH\x13\xb7\n\xfb\x91[!\xa6\x13\xa8\xfd\x9c\xad\x04\xd0\xdb\x04K\xb3j/V\x95d\xd5\x82QvUw\x0d\xc4\x18t\xdf\xed<\xa9\xaa\x81\x81\x94\xaa~\x08\xf0\\\xaa\x02\xdds\xb0H\xa1\xca\xc1\x1c*2\xa7\x06(G\x89\x84\xa9Q!\xfd\xb2\x80\x1f-<O\xaa\xc0\xca\xaa\x8a\xf4\xa8\x12-\xdd \xb2\xa2\x1a8\xcd\x89\x1a\xfaL8V\xa7B\xf5~\xe59P9X\xba\x95\xa7>eX\x8e\x14\x19O=\x92\x83D\xa2\xd3\xa0\x0e\xf8T\xe47\xe5P\xe5R\x9e\xd6T\x80\x95Gy6S\x83\x86\xb9L\xfdu\xcc\x93\x98*\xbc\xf4\x19\xcf]*\xe1b\xe8\xf0\x94\xa5\x04,\xea\xcc3\x95R\xa5\xc0y\"A\xa9B+\xff\xf1\xbc\xa4\x1a\xaf\\\xc8\xd3\x91\x1a\x02LF\x1a\xa6B\x96\x85T\xe1\xa5\x0by\xf2Q	\x17u\xe19G	X\xd6\x99\xa5\x1a\xa5J\x91\x0by\x86Q\x85V.\xe4\x89E5^\x8fB\x96O\xd4O\x03*\x9b\xa8\x83\x8b4\xa2\x12.j#\xb2\x87\n\xb4\xc0\xf2\xa4\xa1\x11+\xa6\x0d\x9e+\x94\xa8\x04\xde\x13)B%XVWd\x06Up\xe9\x0c\x91\x10\xd4\xe0A:P\xef:\x9e\x07T\xa0EUD\xfaO\x0e\x96\x1eaY?\x03T\x0c}\x9e\xec3*\x04n\x139>\x05Vy\x8d\xa7\xf6\x94h\xe54\x9e\xd1\xd3\xc0A>O?*x\"O\x81\x96\xc3m\xcf\xf2wr\xb0\xf0/O\xdb\x19\xa0\xc2\xb5<[gT\x88\x9c\xc6\x93t\n\xac\xac\xaa\xc8\xcd)\xd1\xcai<%\xa7\x81\xc3\x84\x9c\xde\x13<\x13\xa7\xc2\xcb\xd1\xc6\x13pJ\xb8\xb8\xa8x\xdeM\x02\x16\x0e\xe1\xe96\xa9R\xe0>\x91eS\xa1\x95\x03yrM\x8dW.\xe495\x0d\x01f\xd4\xf4>\xe1\xa94\x15\xbe\x97W!\xcb\xa0)\xe1\xa2\xb1<q&\x01s\x9c\xc8\x97I\x95\x02\x17\x8a4\x99\n-\xab,\xb2cj\xbc\xbaW\xf0\xa4\x98\x96\xa0Sb\xfa\x19\x87\xe7\xc2\x14hy\xab\xe5)09Xt$\xcf|\x19\xa0\xa2\xae<\xe1eT\x08\x9fSX\x9eK\x81\xd5\xb7X\x96\xdeR\xa2\xf53\n\xcbji\xa7G\x95\xd3\xd2\x82Q2K75\xc4\xcf9\xf9\xf1\xc9sX\x1a\x18\xc8`\xe9\x87'O])\xd0=\x07\x8b\x8c\x95\x1c\xcc\xa1\"Qe\x80r\x94\xc8O\x19\x15\xda\xce`X\x91\x96R`eUE6J\x89\x96n\x10I(\x0d\x9c\xa6\xa0\x0c}&\x1c\xab3Oz\xbf\xf2\x94\x93\x1c,\xdd\xca3M2,G\x8a\x04\x93\x1e\xc9A\"\xafdP\x07|*\xd2Ir\xa8r)\xcf\")\xc0\xca\xa3<y\xa4A\xc3\xd4\x91~V\xe09#\x15^\xfa\x8c\xa7\x8a\x94p1tx\x86H\x02\x16u\xe6\x89!\xa9R\xe0<\x91\x0fR\xa1\x95\xffx\x1aH\x8dW.\xe4\xd9\x1f\x0d\x01\xe6~\x0c\x13+K\xfa\xa8\xf0\xd2\x85<\xd7\xa3\x84\x8b\xba\xf0\x14\x8f\x04,\xeb\xcc2;R\xa5\xc8\x85<\xa1\xa3B+\x17\xf2<\x8e\x1a\xafG!K\xdf\xe8\xa7\x01\x95\xbc\xd1\xc1E\xd6F	\x17\xb5\x11\xc9\x1a\x05Z`y\x8e\xc6\x88\x15\xd3\x06O\xcdHT\x02\xef\x89\x8c\x8c\x12,\xab+\x121*\xb8t\x86\xc8\xbfh\xf0 \xfb\xa2w\x1dO\xbb(\xd0\xa2*\"\xdb\"\x07K\x8f\xb0$\x8b\x01*\x86>\xcf\xad\x18\x15\x02\xb7\x89\x94\x8a\x02\xab\xbc\xc63)J\xb4r\x1aO\xa0h\xe0 }\xa2\x1f\x15<o\xa2@\xcb\xe1\xb6g\xe9\x129X\xf8\x97gI\x0cP\xe1Z\x9e\x1c1*DN\xe39\x11\x05VVU\xa4B\x94h\xe54\x9e\x01\xd1\xc0a\xfeC\xef	\x9e\xf8P\xe1\xe5h\xe3\xf9\x0e%\\\\T<\xcd!\x01\x0b\x87\xf0\xec\x86T)p\x9fHj\xa8\xd0\xca\x81<\x97\xa1\xc6+\x17\xf2\x14\x86\x86\x00\x13\x18z\x9f\xf0\xcc\x85\n\xdf\xcb\xab\x90%,\x94p\xd1X\x9e\xa7\x90\x809N\xa4'\xa4J\x81\x0bEVB\x85\x96U\x16\xc9\x085^\xdd+x\x0eBK\xd0\x19\x08\xfd\x8c\xc3S\x0f\n\xb4\xbc\xd5\xf2\x8c\x83\x1c,:\x92'\x1a\x0cPQW\x9e_0*\x84\xcf),\xad\xa0\xc0\xea[,\xcb&(\xd1\xfa\x19\xc5\xaeb\x0c\xee\xe6\xbe)k\x0e\x19\xfa\x83\xedFr9Q\xc9\x96\x8av\xe5\xa5\"\xc2<J\x9agZ\x1eR\x15YS\x0d\x93QQ!dD\xb4\x10\"V\x91%\x13\x16L\xe7\x8a\xc9\xec	\xeaz(\x9bz\xef\x8aMb\x1a[\xf2\xfe\xd8w\xa7\xb2\xa5\x02\xfb\x05\xd7\xf7\xc7\xeb\xf9\\\xf5\xfb\xb1\xc1F\xabY\xd6\x1d\xbb\xfe\xf4\x14\x04\xef\x8fM\xf7\x8a1A\xf0\xfe\xb8/\xcf\xc6\xd6/\n\x14%\xef\x8f\xd6j\x86t\xd9\xb3T\xd7\xf6P\xd9\xef|\x1b\xf1\xa1\xdaw\xf6\x0b\xe1OA\xf2\xfeh\x02T\x87\x97\xbe\xbb>\xbf(\x1c\x15\x8e\x06\xb3\xb4Jk\xd2|(\xbd\xa9\xcbKu\x08\x81\x8f\xd6Q\xa7\xae\x1b^L\xd0c\x84|\xcdN\xdd/Yw\xb9I\xccs_\xde/\xfb\xb2\xa9\xde\x1f/\xd7\xdd\xb9\xbeUM\xf6\x19\xd5\xd7\xa1K\xea\xb4\xa7\xbf\xdc\xea\xddv\xa5[\xb1\x83.v0\xd3\xd3\x1a\xe5\xfb\xdb\xa1B\xef:d\xba\xf3\x1d!t5&\x90\x91\xe0\x08\xb1\xdb1\x83\x0e\x0bG!\xa3\x03s\xd89\xd6\xd0\xaf\x14\x8b\x07\x8co\x03\x19\x1a	\x12\x1f=\xa1^\xd9G\xc6X\xcdH\xb7;\xf8\xff\xce\xb8r\xea\xd1\xf0\xfa\xc0\xce\xc7\x83\xccM\xaen\x90\xd9	\x15\x0c2\x07\xb3\x83L\xa1\xfc s\xa88\xc8,2=\xc8\x1c!\x0e2H \x83\xcc\x11\xc8 \x83\x0c:\xc8\x1c\x85\x0e2\xc8a\x07pc\xbf\x13,\x1ed\xbe\x0dt\x90a\x12\x1fd\xa1^\xd9G\xc6X\xcdh\xe7[\xf8\xff\xce s\xea\xe1 \x9b\xb6\xf3\xf1 \x1b\xfar\xff\xad\x8e\xdf\x17\xaf\x86\xa1\xea\xb3\xcb\xb9\xdc\x8f\x98\xecq\xbe2\xb77\x8f\xf2_\x19\xe7\xb09A\xbc\xd6\x87J\xca\x9d\x96K\xd5T{\x97\x00\xc4\xd7\xf8z\x19qF`\x9ci\xab\x0bJ/\xbaP\x16\x04\x0bc/A\x0b\xa3@[p\xa5\x17](\x0b\xde\x1fm6\x97\x900\xc8\x07V\xb8c\xb81g\x90\xc5\x0d\xddYB\x86\xee\xec\xa5\xa7\xfaph\x94\x0e[\x1a,\x99\xd0\x0fe\xc7\x94\x06+\xe6j\x01\xa6\\9\xc3a\x85D\xf4\xfe\xe8?\x89`\xfe\xad\x9bz \x9fD\xa8[ \xf5__0\x9b\xa16\xc3\x8d\x1b(\xa6\xc4\x8c\x838\x151\x94\xcd\xfe\xc4a6\xff\x13\x81\x9d\xfb\x8aA\xce}%\xe5\x99\xe9\x0e\x01\xcal_\x08\xa4\xb2\xe8\x0b\xdf\x1fw}U~\xcb^\xbb\xfepy\x1b\xffk3R\xc5R\x8f\xf0\xad\x0b\x10\xdf\xb6\xa1\xbf\xb6\xfbr\xa8\xe4\x976\xbe\x1a\x07\x87\xc2\xaai\xea\xf3\xa5\xbe|\x85\x0d\xcf\xf27\xfb\x01E\xfft\xf9\x9a\x15\xbe\xc4\x17,|\xc1\xc6\x97,]I\xee~\xaf\xfc\xef\xa8e\x1d\x8a|\xc9\xd6\x95\x14\xeew>\xf7\x05\x01\x92{\xdb\x0b_\xe0\xd5,}\xe5\xbc\xe9\xb5\xaf\x9c\xa7l}\xdd\xbc\x9d\xdc\x1bZ\x87\xeaz\x929io\xcb\xec\xd4\xf4\x9a\x9doo$M\xddk\x96\xff\xd7o\xbd\xe6\xd5\xfc\xd7\xae\xc4{b\xb1x\\\x8c\xff3\xe5E,_\xaf\x1f\xd7\xeb\xf5z\xe3\xf1\xdep\xb12%\x8bX\xb2Yy\x8c\xf7]1\xf7\xda|\xc9r\xeeY\xbedmK\x96\xb1d\x1b\xea\x16\x1cN\xeb\xb0\x8a\xe5[Zg\xf3\xf5L\xfa\xe5\xccW\xff\x11\xceP\xf8\xfd\xf5\xfd\xf1\x97l\xfe\xf6\xcb\xb8\xce\xafn\xe3\xc4\xfb\xcb\xd8g\xfewn\n\x8aXP\x98\x82E,X\x98\x82e,X\x9a\x82U,X\x99\x02\xd3\x1b\xbe\xc8\xf4\xc7?\x9f\xaaC]>\xfc&~\xdfs\xb5Y\x9fo?\xbc=^N\xe3=\xf62d}u\xa9\x867\xf3gL\xc2\xe9\xe3\xca\xc7\xca\x8e\xc8\xf2|\xae\xca\xbel\xf7\x15\xbf\x13\xc4rr#\x90\x85\xe2\xb7\xd5\xb8{v\x81\x18\xbbr\xff\xed\xd9\xa4\xd6\xca\xcaa(\xf7/'\xf3\x8e\xccFd8d\xd3\xed\xcb&\x814\xb2\x80t_s\xc1\xd0\xf0a\x17\x8b\xa5\x11\x17\x84`\xd7\xbf\xec\xc5\x9c#\xd8\xf0\x0b\x05\x0d/\xe6\x1c\x8c\xc5bh\xb4\x7f1\xa7\xd0=\x00\xfb\x17s\x12\x0c\xa0\xfe\xc5\x1c\x81\x02\x94\x7f1G\x15\x9a\x0d\x00\x80\xf5/\xe6\x14\x16V\xd5\xbf\x98\xd3h\xe8\x06\xffb\xce\xc1m\x98\x07\x80\x1d\xa3ci\xcc\x07\xf0\xab{1'\xc1\xd0\xad\xee\xc5\x9c\xc0\x02\xa4\x7f1\x17\x91\x00\xe4_\xcc\x11u)\x9f\xfa\x17s\x12\x8a]\xea^\xcc)0\xf6\xa8{1\xe7\xd0\"\x9cD\xe3\xfd\x8b9\x80\x87>s/\xe64\x1c\x0d\x1d\xf7b\x8e\x81Q\x9d\xdd\x8b9\xae4\xe5<\xffb\x0e\xa0\xb1\xff\xdc\x8b9\x84\xc7.t/\xe6\x1cAD\xaa\x00\x17\xba\x17s\x00\x0f]\xe8^\xcci8\xaa\x8b{1\xc7\xc0\xb0\xce\xf6\xc5\x1cW\x9at\xa1{1\x07\xd0\xd8\x85\xee\xc5\x1c\xc2'F\xa1}1\x17\xa7\x01\x12\x04\xa3\xe1\xfe\xc5\x9c\x86\xa3\xda\xf8\x17s\n\x8d\xb0\xee\xc5\x1c\xc5\xa2i\xc3\xbd\x98c*S\xde\xf3/\xe64\x18V\xd7\xbf\x98\x03p\xe8\x0c\xffb\xce\xe1Y|\x0dp\x9d{1\xa7\xd0\xa8*\xfe\xc5\x9c\x04C\x8f\xd8\x17s\x04\x8a\x86\xbe{1G\x15\xa6\xdc\xe6_\xcc),\xf6\x9a{1\xa7\xd1\xd8i\xee\xc5\x9c\x83\xb3\xd0\x1d0*\xdc\x8b9\x85\x86\xc3\xcd\xbd\x98\x93`\xe4_\xf7b\x8e@\x91k\xdd\x8b9\xaa0\xe94\xf7bNaaU\xfd\x8b9\x8d\xc6Ns/\xe6\x1c\\D\x05\x01O\xb8\x17s\x00\x0fG\x9b{1\xa7\xe1\xe8\xa2r/\xe6\x18\x189\xc4\xbd\x98\xe3JS\xee\xf3/\xe6\x00\x1a;\xd0\xbd\x98Cx\xecB\xf7b\xce\x11D\xc0\x11\xf0\x89{1\x07\xf0\xa8>\xfe\xc5\x9c\x86\xa3\xc6\xba\x17s\x0c\x0cp\xfe\xc5\x1cW\x9ar\xa1\x7f1\x07\xd0\xb0\xca\xfe\xc5\x1c\xc2\xe3{\x85{1\xe7	4\x96	\xcc8\xee\xc5\x9cB\xc3[\xad{1'\xc1\xa8#\xdd\x8b9\x02Euu/\xe6\xa8\xc2\xf4s\x8a}1\xa7\xb0\x89[\xac}1\xa7\xd1\x89g\x14\xfbbn\x84\xbb\xadl\xbe\x98q\x9b\xd8\x8a\xa9\x964\x91Mc\xa6\xc0\xc8%\xeb\x9b\xc8\x01\x01T`\x14\x93\xc5\x0e\xa2\xf6I&]\xf9\x00f\x92G\x97A\x9c\x97\xa4\xd05\x910e\xfa!I\xa4\x0b$D\x9ch\x1e]-A\xea\x84S\xe9\xd2)ril\x16\x1a5\xaa\x03u\xa0\x16\xe8?\xb2\xa8\x02\xcc\x89\xee#+,ML\xd2\xe8r\x8b\xd1\x92\x0c\xba\xf6\xe2\x86\xa6\xfb\x8e.\xc4\x00o\xaa\xeb\xc8\xaa\x0c1\xa7z\x8e,\xd1\"\x15\xc6\x80\x81\xf9\x8f\xac\xd70y\xa2;\xc8\xe2\x0dr\xd3c\x9c\xac\xe4$3\xddN\xb2\xacS\xe6\xa6\xfb\x85\xae\xf10u\xaak\xc8\x82/A\x9e\xea\x1d\xb2\xfa\x8bl\x18^\x86\xeeNq)\x88\xc9\x13\xbdC\xd6\x85\x90\x9b\xae2Y$J\xe6D;\xe3\x8aQ\x99\xfb\xa0w\xc8\xf2\x11S\xa7z\x87\xac%\x13\xe4\xc9k'.,#\x1bE\xaei.]eBn\xba\xd2t\xc9\x89\xa8i\"Y\x7f\nb\x92C\x17\xa3\xd2\xd8t\xc7\xd0\x95)dN4\x91.S1w\xc2\xb5t\xcd\x1a\xc9 (\x0e\xf4\nY\xc0\"j\xba\xc6t5\x0b\x98\x13\xfe\x8dK[\xceKR\xe8:W\x98\x9a\xee\x11\xba\xe8E\xc4\xa9\x0e!+`H\x9d\xea\x0f\xb2\x1c\x8e\\\x10o\x07F,Y\x1b#\xea\xc4EB\x16\xca\x80\x99\xeeG\xb2j\xe6\xbct\x17\x92%\xb40\xf5A\x7f\x90\xf54\"N4\x8f.\xae!u\xaa?\xc8J;ra(\x1f\xf0+Yvc\xf2\xc45B\xd6\xe0\x90\x9bd\xd2\x05\xb9d\xa6\xddKV\xe7\xca\xdct\xcf\xd0\xa5:\xa6N\xf5\x0dY\xb7'\xc8S\xbdC\x16\xf1\x91\x0d\xa3\x04\x81\x87\xc9\x8a\x1e\x93\xd3\xd5\xa6\xcb{\xc8M{\x8b\xac\xf5%3I\xa2\x0b\x7fen\xbaw\xe8.\x00\xa6N4\x93n	$\xc8S\xb7|\xb2?@\xd8:\x00\x11L\xdbd\xb3\x00Q'\x1e\xc6\xc8\xce\x01`\xa6\x87\x12\xd9F\xe0\xbct\xfb\xc8\x9e\x820\xf5\xd1Cr\xdc`@\xc4\xc9\x87\xb0\xb8\xdb\x00\xa9\x93\x0f\xc8q\xeb\xc1\x1dp\x12[\x0f\xf6h\x93b\xaa\xad\x87\xc8\xa6\x81\x8e\xe0\x12#[\x0f\x91\x03\xa2\x1e\xc1\x15F\xb6\x1e\x10\xb5O2\xe9\xd6\x03`&yt\xeb\x81\xf3\x92\x14\xba\xf5 L\xd9\x11\x90\"\xd2\xad\x07D\x9ch\x1e\xddz\x80\xd4	\xa7\xd2\xad\x87\xc8\xa5\x01\x95h\xd4\xa8\x0e\xd4\xd1\x95\xa0\xff\xc8\xd6\x03`Nt\x1f\xd9z\xd0\xc4$\x8dn=0Z\x92A\xb7\x1e\xb8\xa1\xe9\xbe\xa3[\x0f\x807\xd5ud\xeb\x011\xa7z\x8el=D*\x0c\xdc\x04\xb3)\xd9z\xc0\xe4\x89\xee [\x0f\x90\x9b\x1e\xe3d\xebA2\xd3\xed$[\x0f\xca\xdct\xbf\xd0\xad\x07L\x9d\xea\x1a\xb2\xf5\x90 O\xf5\x0e\xd9z\x88l\x18\x13\x8a\xeeuq\xeb\x01\x93'z\x87l=@n\xba\xcad\xebA2'\xda\x19\xb7\x1e\x94\xb9\x0fz\x87l=`\xeaT\xef\x90\xad\x87\x04y\xf2\xda\x89[\x0f\x91\x8d\xc2M5\x97n=@n\xba\xd2t\xeb\x01Q\xd3D\xb2\xf5 \x88I\x0e\xddz\x90\xc6\xa6;\x86n=@\xe6D\x13\xe9\xd6\x03\xe6N\xb8\x96n=D2\x88d\x05\xbdB\xb6\x1e\x105]c\xba\xf5\x00\x98\x13\xfe\x8d[\x0f\x9c\x97\xa4\xd0\xad\x07aj\xbaG\xe8\xd6\x03\"Nu\x08\xd9z\x80\xd4\xa9\xfe [\x0f\x91\x0b\x82d\xc1\x88%[\x0f\x88:q\x91\x90\xad\x07\xc0L\xf7#\xd9z\xe0\xbct\x17\x92\xad\x07a\xea\x83\xfe [\x0f\x888\xd1<\xba\xf5\x00\xa9S\xfdA\xb6\x1e\"\x17\xc6\xdf\x02\xbf\x92\xad\x07L\x9e\xb8F\xc8\xd6\x03\xe4&\x99t\xebA2\xd3\xee%[\x0f\xca\xdct\xcf\xd0\xad\x07L\x9d\xea\x1b\xb2\xf5\x90 O\xf5\x0e\xd9z\x88l\x18\xda\x0b<L\xb6\x1e09]m\xba\xf5\x00\xb9io\x91\xad\x07\xc9L\x92\xe8\xd6\x8327\xdd;t\xeb\x01S'\x9aI\xb7\x1e\x12\xe4\xa9[>\xd9z l\x1d5\x0c\xa6m\xb2\xf5\x80\xa8\x13\x0fcd\xeb\x010\xd3C\x89l=p^\xba}d\xebA\x98\xfa\xe8!9n= \xe2\xe4CX\xdcz\x80\xd4\xc9\x07\xe4\xb8\xf5\xb0\xf3\xe9p(.d\x89\xf1\x111\x0e\xe9>\xda\x8f\x90\xfe\xeb\xfd\x0ei\xbe\xfc\x8fp6\x05\x00AM\x99\x1f\xe5\x0f\xa2\x0e\x863t\xe74\xc1D\xfc8\xb4\xcd#\x80\xa0.\xa1\x00\xc5MU\xc5\x00d],+U\x19K\xa1\xb5I!Y\x8d\xabsU\xb2*\xdb\x92'\xfbO\xc0\xb5]\x1a\x1adBkvK\xea\xcdn\x12{Oc\xef\x01k\xc2$\x08\xceD~\xbb|Gn\xcc\xc8\xfd/\x031\xa5\x04\xd3\x0ee\xdd\x02\x94)w\xb8\xae?T=\x8f4\xb0EvX\xeb(\x03+u\x91\x06\x14\xca\xa3\x0c\xac\x84G\x1a04\x8b2\x90\xe8^\x80Y\x94\x81\x00\x0b(\x8b2\x88P\x81bQ\x06Dac\x876\xc3\xb2(\x03\x89UUeQ\x06\n\xad\xdc\xc0\xa2\x0c\xac\xc4E\x1ap\xd8\x91;\x96E\x1ap\xbf\xd2(\x03\x01Vn\xa5Q\x06\x1c+\x90,\xca  \x05\x88E\x19Du\xc8\xa7,\xca@@\xb5Ki\x94\x81\x04k\x8f\xd2(\x03+\x91\x91\x06\x0c\xcf\xa2\x0c4^\xf9\x8cF\x19(\xb8\x1c:4\xca\x80\x82e\x9di\x94\x01S\x8a\x9c\xc7\xa2\x0c4Z\xfb\x8fF\x19\x00\xbcv!\x8d2\xb0\x12\x19i\xc0]H\xa3\x0c4^\xb9\x90F\x19(\xb8\xac\x0b\x8d2\xa0`Ug\x12e\xc0\x94B\x17\xd2(\x03\x8d\xd6.\xa4Q\x06\x00\x0fF!\x892\xb0\x12\x11i\xc0\xe0,\xca@\xc1emX\x94\x81DK,\x8d2 X9m\xd0(\x03\xaa\x12y\x8fE\x19(\xb0\xaa.\x8b2\xd0p\xe5\x0c\x16e`%<\xd2\x80\xbb\x8eF\x19H\xb4\xac\n\x8b2\x10`\xe5\x11\x12e\x10\xa1r\xe8\xd3(\x03\xa2\x10\xb9\x8dE\x19H\xac\xf6\x1a\x8d2Ph\xed4\x1ae`%<\xd2\x80\x8f\n\x1ae \xd1j\xb8\xd1(\x03\x01\x96\xfe\xa5Q\x06\x11*]K\xa3\x0c\x88B\xe84\x1ae \xb1\xaa\xaa,\xca@\xa1\xb5\xd3h\x94\x81\x95\xc8H\x03\xee	\x1ae\xa0\xf1j\xb4\xd1(\x03\x05\x97\x17\x15\x8d2\xa0`\xe9\x10\x1ae\xc0\x94\"\xf7\xb1(\x03\x8d\xd6\x0e\xa4Q\x06\x00\xaf]H\xa3\x0c\xacDF\x1ap\x9f\xd0(\x03\x8d\x97\xf5aQ\x06\n.\x1bK\xa3\x0c(X\xe0X\x94\x01S\x8a\\\xc8\xa2\x0c4ZU\x99E\x19\x00\xbc\xbeW\xd0(\x03G`\x91\x06\x0c\xcd\xa2\x0c$Z\xddji\x94\x81\x00\xcb\x8e\xa4Q\x06\x11*\xebJ\xa3\x0c\x88B\xfc\x9cB\xa2\x0c$\x16\xdcbI\x94\x81B\x83g\x14\x1de`\x01 \xd2\x802\x93Q\x06\x16\xc4\"\x0d\xf8\xc8\x05Q\x06\x16\x80\"\x0d\xf8(\x06Q\x06\x92\xdaC&\x8a2\x10L\xc8CQ\x06\x91\x07)(\xca\x80\x982\xfd\x00\x89(\xca@\x12\x13\xcdCQ\x06\x8a\x9ap*\x8a2\xb0\x00\x16i F\x0d\xec@\x10i\xc0\xfb\x0fD\x19\x08f\xa2\xfb@\x94\x01'B\x1a\x8a2\x084\xc8@Q\x06\xd1P\xba\xefP\x94\x81\xe0\xa5\xba\x0eD\x19Hf\xaa\xe7@\x94\x81\x05\xe0H\x03FFQ\x06\x9a\x9c\xe8\x0e\x10e\xa0\xb8x\x8c\x83(\x03\xca\xc4\xed\x04Q\x06\xcc\\\xba_P\x94\x81\xa6\xa6\xba\x06D\x19\x00r\xaaw@\x94\x81\x05\xe0H\x03\xde; \xca@\x93\x13\xbd\x03\xa2\x0c\x14\x17W\x19D\x19Pf\xa2\x9d:\xca\x80\x99\x9b\xe8\x1d\x10e\xa0\xa9\xa9\xde\x01Q\x06\x80\x9c\xbcvt\x94\x81\x05\xc0H\x03\xc6EQ\x06\x8a\x8b+\x8d\xa2\x0c$\x15\x13A\x94\x01!B\x0e\x8a2\xa0\xc6\xd2\x1d\x83\xa2\x0c\x143\xd1D\x14e\xa0\xb9	\xd7\xa2(\x03\x0b@\x91\x06\xbcW@\x94\x81\xa4\xe2\x1a\xa3(\x03\xc1L\xf8WG\x19D\x1e\xa4\xa0(\x03b*\xdd#(\xca@\x12S\x1d\x02\xa2\x0c\x145\xd5\x1f \xca\xc0\x02P\xa4\x01\x1f\xb1 \xca@R\x13\x17	\x882\x10L\xdc\x8f \xca \xf2p\x17\x82(\x03bj\xa2?@\x94\x81$&\x9a\x87\xa2\x0c\x145\xd5\x1f \xca\xc0\x02p\xa4\x01\xf7+\x882\xd0\xe4\xc45\x02\xa2\x0c\x14\x172Q\x94\x01eb\xf7\x82(\x03f.\xdd3(\xca@SS}\x03\xa2\x0c\x009\xd5; \xca\xc0\x02p\xa4\x01\xf70\x882\xd0d\\m\x14e\xa0\xb8\xd8[ \xca\x802!	E\x190s\xe9\xdeAQ\x06\x9a\x9ah&\x8a2\x00\xe4\xd4-\x1fD\x1986\x884`T\x14e \xa9\x89\x871\x10e \x98x(\x81(\x83\xc8\xc3\xed\x03Q\x06\xc4\xd4\xd4C\xb2\x8e2\x90\xc4\xe4C\x98\x8e2P\xd4\xe4\x03\xb2\x8e2\xb0\x00\x10i@\x99\xc9(\x03\x0bb\x91\x06\xfc\x12\x03Q\x06\x16\x80\"\x0d\xf8\x15\x06\xa2\x0c$\xb5\x87L\x14e \x98\x90\x87\xa2\x0c\"\x0fRP\x94\x011eG\x00\"\xa2(\x03IL4\x0fE\x19(j\xc2\xa9(\xca\xc0\x02X\xa4\x81\x185\xb0\x03A\xa4\x01\xef?\x10e \x98\x89\xee\x03Q\x06\x9c\x08i(\xca \xd0 \x03E\x19DC\xe9\xbeCQ\x06\x82\x97\xea:\x10e \x99\xa9\x9e\x03Q\x06\x16\x80#\x0d\x18\x19E\x19hr\xa2;@\x94\x81\xe2\xe21\x0e\xa2\x0c(\x13\xb7\x13D\x190s\xe9~AQ\x06\x9a\x9a\xea\x1a\x10e\x00\xc8\xa9\xde\x01Q\x06\x16\x80#\x0dx\xef\x80(\x03MN\xf4\x0e\x882P\\\\e\x10e@\x99\x89v\xea(\x03fn\xa2w@\x94\x81\xa6\xa6z\x07D\x19\x00r\xf2\xda\xd1Q\x06\x16\x00#\x0d\x18\x17E\x19(.\xae4\x8a2\x90TL\x04Q\x06\x84\x089(\xca\x80\x1aKw\x0c\x8a2P\xccD\x13Q\x94\x81\xe6&\\\x8b\xa2\x0c,\x00E\x1a\xf0^\x01Q\x06\x92\x8ak\x8c\xa2\x0c\x043\xe1_\x1de\x10y\x90\x82\xa2\x0c\x88\xa9t\x8f\xa0(\x03ILu\x08\x882P\xd4T\x7f\x80(\x03\x0b@\x91\x06|\xc4\x82(\x03IM\\$ \xca@0q?\x82(\x83\xc8\xc3]\x08\xa2\x0c\x88\xa9\x89\xfe\x00Q\x06\x92\x98h\x1e\x8a2P\xd4T\x7f\x80(\x03\x0b\xc0\x91\x06\xdc\xaf \xca@\x93\x13\xd7\x08\x882P\\\xc8DQ\x06\x94\x89\xdd\x0b\xa2\x0c\x98\xb9t\xcf\xa0(\x03MM\xf5\x0d\x882\x00\xe4T\xef\x80(\x03\x0b\xc0\x91\x06\xdc\xc3 \xca@\x93q\xb5Q\x94\x81\xe2bo\x81(\x03\xca\x84$\x14e\xc0\xcc\xa5{\x07E\x19hj\xa2\x99(\xca\x00\x90S\xb7|\x10e\xe0\xd8 \xd2\x80QQ\x94\x81\xa4&\x1e\xc6@\x94\x81`\xe2\xa1\x04\xa2\x0c\"\x0f\xb7\x0fD\x19\x10SS\x0f\xc9:\xca@\x12\x93\x0fa:\xca@Q\x93\x0f\xc8q\xeb\xc1\x9c\xe9\xae\x0e\xf6\x83\xf7\x0e\xd6\x97\x87\xfaz\xf1_\xc5\xf7\x88\xcbI\xc8CF3\x82\x92\x10\x8d\xc8\x9ag	\xd2\x18\x93p\x80\xa3\xbe|\xf9\xf2\xe5|\xe3\xb0\x81U{\xe8\xce6\x06\xc1\xd7\xff+\x11\xd8\x80\x00\xdc\xb2^\xa9\xe1h\xaf\xc7\xc6\x18L\xaa\xda1U\x90 \xb4\xb1\x1a\x0bO}\xaey\x1f+\x1aH\xe7I5\xae\x17\x93\xbeB\xbd\x9c\xf5B!bLy\x0d*\xdd\x11\xa5\x13\xa4\x89fC\xb5\xcd\xe7\x1b\xffY\x95CR\xdf\x07\xbeD\xaeL\xfb\xf1c7\"/N\xba\xf0\xc3\xe6\"\x07~\xae\xb5\x9f\xd46\x90	@)\x9c\xf6\x1e\x1a\x87\\\x99\xc6O\xba\x0f\x8d\xc1\xa80I\x99j1\x1a\x7f\x9fl\xf0\xe7\xd4\x0dlr\x94\n\xed,\x99t!\x9aD{\xa5\x10P\xa6\xdc\x88\x94\xee\x98\xd24k\xa2\xedHm\xf3w4\xfe\x93*\x87\x0ffY\x81\xfe\xe8^!\xdc\xd0\x7f|?\x10\x8c\x061\xa6j\xf4\x89\xf9M\xb5\xe1\xe3\xd9[\xb5\xe3Ss\xb3j\x8bb}\\\xbb\x0f\xa6\x1b\xd9\x96\x0f\xa6O\xd9\x8e\x8fgG\xd9\x86\xa9\x06\xc0\xfa\x7ft\xbd\xab\xde\xf8h\x0eS}\xf1\x899J\xf5\x84\xe2|T\xaf\xcf\\k\xaa)\x9f\x98JTk>7S\xa8\x06\x01Z\xaa\x82\x0eu\xe9\x9a:<\xa1\xda4P\xa6\x88\x81\x0e\xe5\xe5\xa5\x12([\xc6a\xdd0(\x98)c0z-\xc7\xc4S\x0c2\xf7r\x9b\xbaj\xce\x84\x05\x17\x16\xa2EK.^\n\xf1\x96\x8b\xb7\\\xcc\x85\xb9\xe0\x0e\xb1bcG\xa2\xca\x91\xba\xdb\x0eC\xa0]\x04\xb9^B\xa8&\xa2L'\"\xcc\x10\xbd\x11k$=B\\F\xeb$a\xbb\x08c\xb5\x92\xb8&\xe2H\xbd$j\x88\x1d\x11k&;\x83\xf4\x16\xad\x99\x84\xed\"\x8c\xd5L\xe2\x9a\x88#5\x93\xa8!\x8e\x81X\xb3\xad\xaa\xd9\x16\xd5L\xc2v\x11\xc6j&qM\xc4\x91\x9aI\xd4\xa0\xeb%\x87`\x8fj%A\xf2y\x17\xa3\x1aP\xa3\x80\xd9_\xfbK\xd7\xdb\x88i\xfb7	\x94v\xc2Cu,\xaf\xcd\xe0\xe5\xee'\x83\x9c\xbb\xda\x04\xe1;\x88\xfb\xc9 \xafe\x1dT\x8c\x7f3\xe1\xa9\xfb^y\xe1\xf87\x13\xb6\xdd\x90\x95M\xd3\xbdV\x07\x8f!E\xae\xadM\xb7\xff\xf6v\xa8/\xe7\xa6\xbc\xdb_VP\xb7&\x8b,\x97\xd3B\n\x13\x00+\x1a\xca]\x13%\xe6\x17\x11d}\xf7\xca\x85c	\x05\xec\xab\xa6\x11\x88\xb1\xc8Bl\"\xca \x8e3\xe4\xb1\xa9n\xa1x\xfc\xc1\x9a\xc3\xa4\xa4,rM\xbd\xcc\x1f\x87\xba\xaf\xf66\xf6\xdfW\xcc\x03\xb2\xbe\xfa^\xf5\x97\n\x00\xbd\x88\x10\xf6]#\x81\xfb\xae\xb9\x9eZ\x8eI)\xb5X\xa0\xd7\xa4\xfe\x0c\x7f=\xd9|\x9bL\xcaU\x06\x14\xd0\xe5s\x97F\xa0O\xe0i\xbc7T\xa7Kv\x19\xca~x\xb3\xd9WM\x89\xa5\x9ab\x8a\xab\xda\x83FU\xed\x81b\xdc\xc7'(\x8c~t\xc2\x82BbZ\n\x8bYii\xcd\xfaj\xd8\xbf0\x9c+\xb3\xb0K\xd5\x1c\xed\xc5j!\xe3or\xc1\x1a1m\x9e\x91\xcb\xd6\x19Tl\\\xc4\x84\xb6\x19\x04k\x9a\x01\xd1\x969S\xb4\xbe\x06\xc3\xaa\xfb\xb7\xebe\xa8\x8fwW%\xffk\xdf\xb5\x839\xe0#\xea\xe5\xe5c\xd5 6\xd4/Hm\x15%\x98\xd6\xd3\xcbv\xd5\xf0ZU\xad\xc2\xda\x0c\xb4N\xca)\xa5y\xe2J0\xac\xd0\xcdSV\xc2=\x86*\xe3\x81\xb4\x8bR\xde\xf0\xd8\xd8Q\xd8\x17\x1e\xe7\x1b\xc8\xb1\xa0y\x9e\xe0\x9a\x87\xf0\xb4q\xc6Zn.\xa8\xa7\x9c\x14\x99QhJ\xe3\xf83\x82\xba\xad\x87\xba\xb4S\xc5\x93\xfb\xc1\xae\xd0\xd6^\xc7b\xb2\xcb\x9e\xc3\x8c5\xfe\xc5l]^\xfa\xba\xfd\xf6F\xfef\xe2\xb6\x93\xe49\x97\x02~@t\xe5`o\xb2o\xe6o\xfa	\x16+\x1co\x9bN\xd6\x84\xef\xc4X\x91kKW\x0e\xa41\xfb\xa6*\xfbc}{*\x8f\xe6\x96\xe8<\xfb\xab_}e7\x81\xaf\x06\xf7\xb4\xeb\x06w\xb1\xd8L\xe0e\xeb2\xc5\x1f\xcbS\xdd\xdc\x9f.\xf7\xcbP\x9d\xb2k=\xfb\xb1\xa9\xdbo?\x95\xfb\x9fM\xc9\x1f\xbav\x98e\xe5\xf9\xdcT\x99\xc5\xcc~\xae\x9e\xbb\xea\xe1O\xff6\xfb\xcfn\xd7\x0d\xdd\xec?n\xf7\xe7\xaa\x9d\xfdiwm\x87\xeb\xecwe;\x94}\xd54\xb3?\xd4}\xf9\xf0s\xd9^f\xbf\xef\xbb\xfa`\xff\xfc\xd7\xaa\xf9^\x0d\xf5\xbe|\xf8cu\xadfc=\xb2K\xd5\xd7GZ\xb9\xf17\xab\xdd\xef\xba\xf62\x98\xcc\xe7\xb3\x7f\xbf\xee\xebC\xf9\xf0\xa3\xf1\x9f\xfb\xb5\xa3?\x1e~\x1e\xe9\xee\xc7\xec\xf7\xd5\xdf\xca?_]\xd9\x8f\xf50\xce\x1b\xe5\xe9\xe1\xcfU\x1f\x80\xf5\xae\xb2	\xdd]\xc1\xbfT]\xff\\\x973Y\xadS\xd7v\xacV?Um\xd3\xcd~\xea\xdar\xdf\xcd\xc6*vMy\xa1\xfa~\xea\xdaQp\xed\xeb\xaa\x7f\xf8c\xf5:\x1bU\x98\xa1O\xd4\xbe\x94\xb5I2oU\xbfVcS\x9e\xf2\xf9\x9c@\x86\x97\xbae\xe2\x82\x89\x1b;\xac\x88|\xc1\xe4.a5\x05,\x19\xe0T\x1d\xea\xeb\x89\x01V\x0cp\xa9N\xf5\xaek\x0e\x0c\xb2f\x10%\xde0qu\x1b\xfaRa\xb6\\\x85\xf9X\x0d\x95\x7f\xf1rw\xf4\x939\xcc\x9d\xf9\x84n\xa3\xf8\xd1{\x00[ \xac}\x91\xa4\xc1\x0b\x04\xb6~\x05\xe8%B['\x03\xf4\n\xa1\xbd\xc7\x01~\x8d\xf0	\xec\x06aC_\x00\xc2\x16*'\xb1\xb6\xb0{\xdc\xeb1\xde=\xf6\xbd\x18\xec\x1e\x8a7\xdd\xa3\xb1\x05\xc2\xd2\xf7|\xb0{(\xd8u\x8fF/\x11\xdau\x8fF\xaf\x10:t\x8f\xc6\xaf\x11>\x81\xdd l\xec\x1eM\xd8B\xe5\xe4<2\xec\x9e\x97,\x7f{\xb1et?\xed%+B1-]\x84\xd2\x0d-^\xfa\xe2\x9c\x14\xaeB!\xd7\xbc\x8e\xe5\xb4x\xeb\x8b\x0bR\x98\xcfC)\x03\xe7\xa1z\x0bZ\x1aT/iKB\xed\xd6\xb4%A\xc3\x966$\xd4\"\xa7\xd5X\xc7\x06R\x1d\xe6\x01\xc4	\xe2#\xc8Kv\xbe\x05\xb8_\xe5\xbe\xd8\x1d\xb3\x970\xda\x7f\xed\xcb].\xf7(\xf9\xee\xee\xc6MU\x1e\xea\xf6\xd9\xde\xe2\xcd\xfa\xcac\xb8|\x18\x0b9\xe0\xd1\xbf\xb5\x8f:\xcc<\xcfA\x02\xd3t\xdd\x85\x1brg\x1dN\xd9\xfc\xedT\xf6\xcfu\xeb\x9fYNY\xeeKh\xdf\x9e\xb2\"\x14\xd3\xd2E(\xdd\xd0\xe2\xa5/\xceI\xe1*\x14r\xcd\xebXN\x8b\xb7\xbe\xb8 \x85y\xa8o\xc1\xc0y\xa8\xde\x82\x96\x06\xd5K\xda\x92\xa0\x83\xb5/\xd4yM\xdb\x17\xf4nI\xa9\x19\x1d\xae<\x8e\x8e\xd38:|S\xfc\xe88\xdd\x83\x8b\xb3\xa1;?\xcd\xbf\xba\x1fv[%\xb8\xfd\x16a\xe3\x83`\xc4\x99\xa7\x9c\x00\xbb\x87\xee1\xda\xdc\x9bB\xae\x92y\xf7\x16	F/g\xf4jz8\xddCO[\x0b\xc8\x00\xd3_p\xfd@=\xd3\xbe`\xda7H\xfd\x86\xe9_p\xfd\x1b``\xc3,,\xa9\x85\\\xeb\xcf\xa9\xf6%\xd3\x9e+\xdd9\xd5\xbcb\x9a\xa1\xf3s\xe1\xfd\x15\xd7\x8f\xdc\x9f\x0b\xff\xaf\xb9\x15h\x84\xd9X\x0b\x1b\xc8\x04\xb3\xb0\xa5\x16\n\xad\xbf\xa0\xda\xb7L{\xa1t\x17Ts\xce\x06{\x81*_\xf0\xca\xe7|\xdc\x17\xa0\xf6\x05\xaf}\xce\x06\xe8B[X0\xfd||.\x94\xf6\x05\xd3\xcd\x9c\xbf\xd4\xba\x97L7\xf7\xfcR\xe9^\xb2\xeb\x8a\xf9\x06x\x86_V\xdc/\xda+\xfc\x9ae\x83~\xadu\xaf\x99n>\xea\xd7J\xf7\x9a]\xb1\xcc\xdf[\xad{\xcb.W\xee\xef\xad\xd2\xbd\xa5\xba\xc9Lj\xb4\x8f\xbf\x85v2\xc1\xde\x18\xde\xe8\xa7\x84^\xdc\xaeO\xf78#\xdb\x8b\xe9|\x93WR\x98\xa7o\x04k\xaf\xa3\x08\xee\xf9\x1d\xff4\x889\xdd\x15\xf7\xb1\x98\xcf\xda\xbb(\x10\xd3~#\xa7\xfd`\x01\xcc\xf3\xc1L\xce\xcd0\xe9.J\xd1\xfd\xa0\x81\xf7\x83`U\xcf\xfd\xc1h!\x8c2\x9b\x85\xb4\xc9L\x82[D\xb0\x08\xee\x07\xc1\xe4B\x98\xdc0\x9b\x0bis\xc3\x8c\xa2\xfbF\xb0\xaa\xee\x11\xc1\xe6\x92\xdb\xcc\xa9\xc5\xa5\xb0\x98S{\xfaN\x12\xac\xa1\xfbF0\xb8\x12\x06Eo\xae\xa4M\xd1\x9d\xf0\x06\x13,\x83{I0\xbc\x96\x86\x99\xdd\xb5\xb2\xcb\xcc\xa2{N\xb0\xaa\xee/\xc1\xe6\x96\xdb,\xa8\xc5\xad\xb0XP{\xfa.\x14\xaf\x14p\xcf\x89\x97\x8a\xb8$\x0b\xde\xca\\^\x98\x05o&\xbc;E\xcb\xeaN\x14\xed\x8a\xcbe\xc1\xac\xca\xcbe\xc1l\xea;V\xb4\xa8\xeeO\xd1\xa2\xe8\xcf%\xb3(\xbbs\xc9,\xea\xfbX\xb0\xa8\xefZ\xc1b!|\xcbg\x04\xe9Y>#\xe8\xbb[\xb4\xa8\xeee\xd1\xa2\xb8:\xd7\xcc\xa2\xbc<\xd7\xcc\xa2\xbe\xe7\x05\x8b\xfa\x0e\x17,.D?n\xa9\xc5\x85\xec\xc7-\xb5\x08\xee\x84\xc1\"\xba\xef\x05\x9bT\xa8\xeei;&\xd6\xf7\xc8\x06\xdf#\x83euG\x0cf\xa3D\xde\xefvD\xa6n\x9c\x0d\xb8q:Qy\xcb\xdc\xea\xd8\xfc)W\xc8V\xeeV\xc9\x1c\xe1W\xcac\xe9kv\xbb\x18\xb1;$0\x8f\x8e4\xd2\xcb\x89H\x17Rz:\x10\xe9RJ\x9bg\"]I\xe9\xad!\xd2\xb5\x94\x16L\xbc\x91\xe2\x05\x13o\xa5x\xc9\xc4_\xa4x\xc5\xc4\xf9\\\xca\x83W\x03\xc0;\xb5n\xb3\x97\xf1\xa9b\xfc\xb7b\x8f!Fb\x89Q(\x99\xbe;\x18\"tG\xddf\xafN9;Jb\x05A\xb7\xacT\xa67\x1a2\xb2\xd3\x90\xd1\xbbVF\xf6\x1a\xb2GV\xbe\x88\xe5\x1b&\x08k\xf7,\xa7\xc5\xabX,,\xac\x89\x84	\xc2\xaeCV\xd0\xe2\xb8\xef\x90\x15\x9c\x10w\x1e\xb2\x05+\x8f&\x96\xacmQ\x13osl\xc3\x9a\xb59\xea\xdf\xd2\xf2\xb8\xd7\x90\x85\x0b.\xfb\xe4nC\xf6\xc9\xed\x86L\xee7dp\xcd+\xbaOn9dh\xd1+8b\xd7!C\x0bG>\x18\xe4\xc6C\x06\x16\x8e\x9c!\xf6\x1e2\xb8\xf9 F\x96\xdc~\xc8\xd0\xfe\x83\xe0\x88\x1d\x88\x0clA\xb0a*7!2\xbd\x0b\xc1\xf0b\x1f\"\xc3\x1b\x11r\xc8\xcb\xad\x08I\xf3\x86d\xbf\xac\x85-l\x8a[ZKK\xd0\x10\xb7\xc3\x9e\x193\xb0)\xc1.H\xb9-\x91\xe9}	\x86\x97;\x13\x19\xdc\x9a\x10\xd7\xb6\xda\x9c\xc8\xd0\xee\x84 \xc9\xfd\x89\x0clP\xb0\x99BmQdz\x8f\x82\x11\xe4.E\x06\xb6)\xd8\x9c\xa36*2\xbdS\xc1\x08r\xaf\"C\xbe\x12\x17\xa3\xf0\x14\xf0\x93\xb8\xde\xf9E\x02\xb6,\xd8<\xa86-2\xbdk\xc1\x08r\xdf\"\x03\x1b\x17lFU[\x17\x99\xde\xbb`\x04\xb9\xbb\x90\xe9\xed\x05:5\xab\x0d\x86L\xed0P8\xdec\xc8\x92\x9b\x0cYz\x97!Km3dr\x9fA\xcc\xcaj\xa7A\xc8\xf5^\x83\x00\xc8\xdd\x06!\x1e\xd0\xac\x1f\x8d\x17\xd28\xb7-\x1f\xbe\xb9\\\xee:p\xe9\x00\xef\x05\xd1\xf4B\x9a\xdep\xdbr\xefA\x00\xe4\xee\x83\x10\x0f\xe0\x0e\x11m\x8b5\x0e\x93\xea=\x08&\x96\xbb\x10L8\xe0\xfbF4\xbc\x92\x86eo\xcb\xbd\x08\x89\x90\xbb\x11R>\xc0\xbbI\xac\xc0ZU\x80\xdb\x97\x8bX\x01\x90\xbb\x12B<\x80{L\xb4-v&\x98T\xefM0\xb1\xdc\x9d`B\xb9?!n\x17z\x87B\x00\xc0\x1e\x85@\xa8]\n!\x97\xfb\x14\xecf\xa2w*\x98\x18\xecU0\xb9\xda\xad`R\xb9_\xc1n2z\xc7\x82\x89\xc1\x9e\x05\x93\xab]\x0b&\x95\xfb\x16\x99\x98Y\xa4\xcf\xc5\xcc\xa2<.f\x16p\xb3#\x96\xf5\x9d\x8dX\x96W\xf7\x9a[V\x97\xf7\x9a[\x067\xc1h\x19\xdc\xf1\xa2e\xb9\x93\xc1\xc4`/\x83\xc9\xd5n\x06\x93\xca]\x05z\x1f\xd3\xfb\nT\nv\x16\xa8X\xed-Daw.\xf7\xf50\xaew\xdc_\xfe\xa6\xe6\x05\xc5*H\xc2Ks/[E\xd6\xa3\x10m\x08m#d\xf9<\xf2\xdc\xab\xfa\xee{\xd5\x1f\x9b\xee\xd5\xee\xba\xf8_d\xcb%\x00\xdci\xec\x00\xb1\xbf\x05\xe8{}\xa9wM\x15Q\xae@\xc0.\xfb\xbek\x9a\x88\xb2\xbf\x05\xe8\xc6\xeb\x94\xddP\xad\xee\x02tG\xa0\x9b\xac|v\xc3\xd5\xbf+\xe0\x1d\x03o\xaa\xa5\xd9-\xd1\xd6\xbb\x86\xde\x13\xd0\x9btLv\xc3\xae\xb9+\xe0\x9d\x01\xed\xdf\xe6\x08Fw\xdd\xbf\xbce\xaf\xd5\xee[=d\xa2\x03\xea\xf6\xf9\xc9\x00$\xcb\xf8t\x82\x14}|\xce\xe6o\xe7\xf2p\x18K\xe7\xbe(\x0fE\xf4\xfey\xce\x8aXN\x8b\x17\xb1xC\xcb\x97\xa1<'\xa5\xabX\xca\xb5\xaf\x89\x80\x96oCyAJ\xf3X\xef\x82\xc1\xf3X\xcb\x05-\x8e\xea\x97\xb4IQ\x0dki\xac\xfb\x9a\xb64\xea\xde\x92\xe2\xf3-V\xdd\xcf\x0f\xe7{\xf4\xac\xdb\x0b\xf1\xbf\xf8\xd3\xf1\xf9F\x80n3\xc4\xffdO\xd8\xe7{\xec\x18\xa3\xd1-\xa0\x85Z\xe6\xd4\x1b\xa1\x18\xdd\x82c\x0d0\xca=v\xb3\xb5\x02\x8d0\x1b\x85\xb0\x81L0\x0b\x0bna\x03Ml\x98\x8d\x85\xb0\xb1AF6\xcc\xca\x92Y\xc9\x81\x8d\x9cZXr\x0b\xb9\xd6\x9fS\xed+\xae\x1dwF.zc%l\xc0\xee\xc8E\x7f\xac\x85%l\x88\xd9YK;\xd0\x0c\xb3\xb2eV\n`\xa3\xa0\x16\xb6\xdcB\xa1\xf5\x17T{\xce/\x86\x026\xa2\xe0\x8d\xc8\xc5uQ\xa0V\x14\xbc\x159\x1f\xbc\x0b`e\xc1l\x88\xb1\xbb\xd0\x16\x16L?\xef\x8c%\xd0\xbfd\xfaEO,\xb5\xfe%\xbb\xf6\xb8\x9f\x90\x97\xf8\xa5'|\x04<\xc4\xafm~Q\xac\x81\xfe5\xd3/\xae\x8a\xb5\xd6\xbffW6\xf7\xff\x16\xe8\xdf\xb2\xcbZ\xf8\x7f\xab\xf5o\xa9\xfe8\xd5\xda\x8b\xe1|SWB\x98\x81o\x14m\xaf\x03\x02w\x17A@\x0fr\xbev\xe5=)\xe7\xf3\xf1\x8eH\xc4\x9c\xde\xa89=XAsx0\x95\x0bSL\xbc#b4\xd97x\xb2\x0f\x96\xc1\xbc\x1e\x0c\x17\xd20\xb3[(\xbb\xcc,\x9a\xff\x83U4\xd7\x07\xb3\x0biv\xc3\xec.\x94\xdd\x0d3\x0co\n\xc1\xb2\x9e\xff\x83\xdd\xa5\xb0\x9bS\xabKi5\xa76\xc1m\"X\x84\xf7\x84`t%\x8d\x8a\xde])\xbb\xa2{\xf1\xdd#XG\xf7\x89`|\xad\x8c3\xdbkm\x9b\x99\x867\x94`Y\xdf;\x82\xdd\xad\xb0[P\xab[i\xb5\xa06\xc1-&^E\xe8~\x12/#y\xc9\x16\xbc\xb5\xb9\xbap\x0b\xde\\|\xeb\x89\xd6\xf5]&\xda\x96\x97\xd2\x82YV\x97\xd2\x82\xd9\x05\xb7\xa3hU\xdf{\xa2U\xd9\xbfKfUu\xef\x92Y\x057\xa9`\x15\xdc\x91\x82\xd5B\xfa\x99\xcf\x1a\xca\xcb|\xd6\x00\xb7\xaehU\xdf\xa7\xa2Uy\xf5\xae\x99Uu\xf9\xae\x99UpC\x0bV\xc1\xdd+X]\xc8~\xddR\xab\x0b\xd5\xaf[j\x15\xdd\xe6\x82U}O\x0bF\x89H\xde\xafvT\xa8\xee}\x0d\xba\xf79\x99\x0d`\xcf\xaa\xefU;\\l\x10\x00/#\x01\x7f\x02l\x16\x96\x02LBt\x87r\xa8\xf7o!\xcb\xba\xfdme\xc7\xfaV\x1d\xa2\xc8\xfc\xb4\x92rw\xe9\x9a\xebPE\xa1/\xb1\xf2\xbej\xca\xa1\xfeN\xe4\xbe\xc4[\xad\xf7\xdf\xeeQ\xea\xd7\xbd\xb6\xfc+\xa9\xcd\xf8\xdb5\xabnm\xc3\x07\x7f\xe8\xd3\xba\xd7\xfc\xe9\x9ci\xfe6\xae#\xcb\xe5\xba}\xb3\x8b9\xf7(\xe0\xc1\xf3\xaf\xec6_\xb7\xd9\xdd\x01\xc5\x93A\xddf\xb77O\x96\x9c\xe1\x8d>y\xd4m\xd6\xbf\xf1G\x8e\xba5\x1fF\x90\x1a\x9b7\xaa\xa9\xaf.\xf5/\x95m\x9f\xfd\x9bt\xa8\x13\xde\xbd\xe4{\xd5\x0f\xf5\xdeG\xb1:\xe9\xcdK_\xba\xbe\xfe\xa5k\x07.\xf7\xd2\x18\xdfyy)\x0f&\xeb\xf2-\xb3\x7f>\xcd\x1f\x8a\xf3\xeday\xbe=\xcc\x1f\xfa\xe7]\xf9\x9b\xf9l\xfc\xffc\xfe\x03ed\xa7\x03'\x8d\x84\xad&\x15?\xccR\n\xe7[\xae\xd1|\x10\x87h\xccW\xe7\xdb\xc3b\xaeu\xe6\xa3\xceQh\x10\xd3J\xeb\xb65\x1fV	z\xeb\xf6R\x0d\x0f\xc9:\xad9\xbd\xbb\x0e&\xda\x92Ul\xfc\xff\xe2|\xb3\xc4U1\xcb\x97\xcbYQ\xccg\x8f+Nv\xdf\x9a	\xcc\xd8\x97.\\\xce\x95\xfbl\x14\x9f\xee\x02F\xcfN\x07\xa4\xe1\x7f\xd0\x1f\\}\xf3\x8c\xd4\xff\xcf:\x87[0}\xa4\x8d|\xb2\xa7\xb8.\xd7a\xa8\xca\x1fv\x1b\xd74\xf6\x96VC\xc2\xc3mL\x9d+\xf7\x9f\xf5\xfct\x1f2\xfa\xd8\x87@\xc3\xff\xa0\x0f\xb9\xfa\xe6\x19\xa9\xff\x9f\xf5!\xb7`\xfbP\x19\xf9d\x1fr]\xbe\x0fA\x95?\xecC\xae\xc9\xf4\xa1R\x13\xfb\xd0~\xf8\xc4\xdc\x1b\xed\x9fMy\xef\xae\xf4\xbea\x8b\xed=\x90A\xc8}p\xa8n. \xdf\xfce\xbe]@\xa2\xf2M\xa1\xfb\n\x03\x01\xd0O0\x98bs\xc3\xa0\x08S@\x00\xee\x9b\x0f\x14\xe2\x8a\x08\x88d\xa3y\xb3\x9f\x97U\xf9i\x0c\xce\x86O\xfb/.\x93L4FJ\x13\xd0x\x10\xcd9#@\xbd\xc7\xd0\xec2\x1c\xe3\x114\x8fL@x!\xcd\x18\x13\xe96b\xdd\x7f\xf9\x98\xe4\x86\x11\x90X\x0d\x9a\x05F\x82b\x83h\xbe\x17\x832i^\x82\xf4H\x1dB\x12\xba\x04\x7f\x90\x1c.\x1c\x13\xddA\xb2\xb50\x88\x07\xd0\xbc,\x1e\xe0e4\x03K 3_\xd0\\+\x1cA\\A\xb2\xaa\x08\x0c\xf1\x04\xc9\x9fb@<m\x8a\x87\xd1L)\n\x16\x1bMr\xa2HT\xe8E\x92\xfd\x84`B}H\x9e\x13\xaa\x82\xb5\x9ef4Q \xe2\x00\x92\xbbD\xc3\x88\x0fH\x96\x12\x83\xe3\xc9I\x82\x0fH>\x12\x05\x8b> \x99G$*\x18$9F\x08&\xd6'f\x13\xa1*\xb8\x0fH\xde\x10\x05\"> \x19B4\x8c\x8e\x83\x98\x0b\xc4_71\x05\x88G\xd1\xac\x1f\x12\x15L\xd2\xfc\x1e\x02\x14 $\x93G\x84\x84\xcb\x8b\xe4\xec \nX\xf3iv\x0e\x89\x89U\xa1y8\x14*6\x8bf\xdc00\x9ah#\xb4\x9d\xe4\xd6\x10\xa0`\x8ff\xd1\xe0\x98\xd8\xb6\x98/# \xc2\x08#\x991\"\x9d\xb5\x9b\xe6\xc0\x10\x10\xd2l\x92\xedB\x82H\xabI^\x0b\x83\xa2\xe9,BO\x91\x0c\x16\x02\x14;|\x1fsUpL\xf0\x0b\xc9J\x11\x10\xc1%$\xffD\xa4\xf3V\x93L\x13\x02\x12\xabAsJH\x10i5\xc9\x1eaP<iDh\x13\xc9\x13\xa1`\xb1\xbfIF\x08\x89\n#\x95\xe4~ \x98\xd04\x92\xe5\x81\xaa`\xed\xa7\xf9\x1c\x14\x88x\x80dn\xd00\xe2\x03\x92\xa3\xc1\xe0xj\x86\xd0:\x92\x8dA\xc1\xfa8\xa2c\xde\x05\x89\n\xf5'\x19\x16\x08\xc6\x8bi.\x05\xaa\x82\xf9\x80fMP\xa0X\x1d\x9a\x1fA\xc3\xc8\x84G2!X\x1cI\x80\x10.H\x92\xf3@\x80\xe2\x84O\xb2\x1bpLp7\xc9c\x10\x10\xa1\x1e$cA\xa4\x8b\xdb]\xccM  t\xa2\x8fY\x08$\x88\xde\xeab\xbe\x01\xb7\xe8\xb1\x13\x04IthW>\x96\xa0\x9e )\x89~\xee\xc5\x0f\x19\xf28I\xa1,\xad!c\xd0gK\xcc\xe89\x81>hB\x02\x87\xd3\xa7N	\xe7H\xfa\x08\xaa\x14\xd3\x0f\xfe\xf8\x91F\x9eG1^V\x9d>\x9c&\x18\xd2=\xf4I\x95Rl^B\x0e=\x02\xc7\xd3t\x84\xdc\xef\xe4\x19\x16\x12\xa4\xdb\xc9\x03-\xc2s4}\xba\x15h\x0e\xa4\x8f\xbaR-\xf09}\xee\x85p\xe5r\xf2\x10\x8c	\xca\xe3\xe4\x89\x982D>A\xc6\xa1\x8f\xc7)\x8e\xf4'yVNP\xc4p#\x0f\xce\x9a \xda@\x9e\xa2\x81r\xe0X\xfaH\x9db(\xdf\x92\xe7\xeb$G\xb9\x97<lS\x92H\x08\xc8\xddK\x9e\xbcS\x1c\xe9^\xf2\x18\x9e\xa0\x88z\x91grM\x90m\x88\x0f\xe8@9r/yZO1\x94{\xc9\xa3{\x92\xa3Go|\x8e\xa7$\x9e\xd1\x8fQ\xe8C}\x82\"jF\x9f\xf01C\xe0\xc9\xe3\xbe\xc2s(}\xf6\xd7\xaa\x81g\xe9B A\x90\xd5\xa7\xab\x82\x14E:\x89.\x11(\x87\xa5\xe4\xe3n%\xeb\x05\xcc\x10\xd5\x02\xd9\xff9AzJ%\xfd\x0fp\x8e\x04\xb9\xfe\xa3b\xe0R\x90\xe2_\xe0\x95Guf\x7f\xc9P\x0e\xd5	\xfd\x0d\x05\xa4\xf3\xf7\xa3H\xe7\xf1\x17\x0c9L\xf7*}?'\x08\xff\xeb\xac\xfd\x01.\\\xaf\x93\xf5G\xc5\xc8\xa1:G\xbf\xc0\xcb\xaa\x83\xd4\xfc\x92\xa1\x1c\xaa3\xf2\x1b\n\xcc\xc7\xef=\xa4\x13\xf1+\x8e\x1c\xa5:\xff\xbe\xa4p\x02H\xbbO\x08\xc2Q:\xdb>U\x0e\\\x0b\x92\xec+\x86r\xae\xce\xad\xaf9\xca\xbd:\xa5\xbe!\xc1\x84\xfa\xdeW:\x93\xbe\xe2\x88\xba\x81\x04\xfa\x92\"\x1c\xa0\xf3\xe6\x13\x02\xc7\x82t\xf9T9p/\xc8\x92\xaf\x18\xb2	 9\xbe\xe6\xa8\xfb\x96\xce\x89oI:#\xbe\x9f\xd5t*|\xc1\x90\x8f\x04:\x03>'\x88\x0e\xd7\x89\xef\x03\\\xd4]\xe7\xbb\x8f\x8a\xe1\xb3\x96Js/\xf0\xfaQ@e\xb7\x97\x0c\xfd\x9c\xa5\x92\xda\xdb)Y\xa5\xb4\xb7\x84T.{7\xed\xc4/G\xfa\xb1\xadS\xd8\x1b(H`\xef\x87\xb6\xce\\/\x18='\x80\x84\xf5\x9c\xc0\xe1 O}\x80s$HO\x1f\x15\xdb\x0ecx\x90\x95^\xe0e\xd5A2z\xc9\x90\xee\x019\xe8\x0d\x85f\xa0\x0f}\x0b\x1c\xaf\x13\xcf{\xbf\xeb\x8c\xf3\x9c \xdd\xae\x13\xcd3<G\x83\xfc\xf2\x1e\xcd\x81 \xad|P\x0b|\x0e\xb2\xc9s\xb8r\xb9N\"/\x08\xca\xe3:w\xbca\xc0\xcc\xf1~\xd6\xd1)\xe3\x15G\xfaSg\x8a\x97\x141\xdct\x82xB\x10m\xd0y\xe1\xa9r\xe0X\x90\x0e^1\x94ou\x16x\xcdQ\xee\xd5\xc9\xdf\x0d	\xa6~\x0f\x93\xba\xca\xf9\xae8\xd2\xbd:\xd5\xbb\xa4\x88z\xe9\x0c\xef\x84 \xdb\xa0\x12\xbbS\xe5\xc8\xbd:\x9f\xbbb(\xf7\xea4\xee\x9a\xa3G\xaf\xca\xde\xee\xa7\x18\x95\xbb\xddQ@\xd2vI\x115\x03\xb9\xda\x05C\xe0u\x8a\xf6\x88\xe7P\x90\x99\x9d\xa8\x06\x9e\x05	\xd9%AV\x1f\xe4aW\x14\xe9$\x90~\xddp@\xf2u\xefV\x9du]0D\xb5@\xb2uN\x90\x9eR9\xd6\x03\x9c#Aj\xf5\xa8\x18\xb8\x14dT\x17x\xe5Q\x9dH]2\x94Cu\xfetC\x01\xd9\xd3\xfd(\xd2i\xd3\x05C\x0e\xd3\xbd\xca\x96\xce	\xc2\xff:Iz\x80\x0b\xd7\xeb\xdc\xe8Q1r\xa8N\x89.\xf0\xb2\xea \x13\xbad(\x87\xea\x04\xe8\x86\x02\xd3\x9f{\x0f\xe9\xbc\xe7\x8a#G\xa9Nw.)\x9c\x00\xb2\x9c\x13\x82p\x94NnN\x95\x03\xd7\x82\x9c\xe6\x8a\xa1\x9c\xabS\x99k\x8er\xaf\xce`nH0\x7f\xb9\xf7\x95N\\\xae8\xa2n _\xb9\xa4\x08\x07\xe84\xe5\x84\xc0\xb1 ;9U\x0e\xdc\x0b\x92\x92+\x86l\x02\xc8E\xae9\xea\xbe\xa5S\x90[\x92N@\xeeg5\x9dy\\0\xe4#\x81N8\xce	\xa2\xc3u\x9e\xf1\x00\x17u\xd7\xe9\xc5\xa3b\xf8\xac\xa5\xb2\x8a\x0b\xbc~\x14P\xc9\xc4%C?g\xc5\x95\x9e\xc1\xde\xdc\xe7\xfd\xcd\x81_\x1a`c\xa4\x97\x13\x95n\xa5xW^*\x02\xc8\xb9\xb4y\xa62\x965\xd4\x9an\x98\\\x8a\x0b!\x17\xe2\x85\x10\xab\xca-\x19\xa0P\xfaWL\x1e#1\xea\xa1l\xea\xbd\x13\x99\xdc\x8f\xb6\xc4J\xfb\xeeT\xb6Th?\x90o\x85\xd7\xf3\xb9\xea\xf7\xa3S\x8c\x05\xb3L>v\xfd)\n,\xae\xe9^1.\x08,n_\x9e\x8d\xed_\x140J,\xd2\xd6\"C:\xe39\xc6k{\xa8lZ\x18\x039T\xfb\xce&\x95\x89\x12W\xbf\xba\xad\xb2\xe1\xa5\xef\xae\xcf/\nK\x85\xdex\x96V\x1d\xcd\x9b\\;M]^\xaaC\x08^\xb7\x8e<u\xdd\xf0b\x02\xd7#\xe4kv\xea~\xc9\xba\xcbMb\x9e\xfb\xf2~\xd9\x97>:\xffr\xdd\x9d\xeb[\xd5d\x9fQ\x7f\x1d\xba\xa4\xdex\x9a\xd3\xed\x9a\xd8nw;%\x89\xe1\xe0\xa0fTh$\x1d\x1b\x0e\x19F\x82CO\x0f\x14G\n\xc3\x02\x93\xc4\xa8q\xa48D0K\x0e!G##	\xf3\xd4\xf9\xf4\xd0\xf7\x14\x9f\x1e\\\xbeMd\x18%\x88z\xa4\x85:f\x1f\x19U\xb5$\xc3\xc3Q\xfe\xf7\xc6\xa03\x81\x86\xe2\x07\xb6>7 \xdd\xe4\xee\x06\xa4\x9d\xd0\x13\x03\xd2A\xed\x80TH: \x1d2\x0eH\x8b\x9e\x1e\x90\x8e\x14\x07$$\x89\x01\xe9Hd@B\x96\x1c\x90\x8eF\x07$\xe4\xa9\xc3\xf6ql\x10|z@\xfa6\xd1\x01\x89\x89z@\x86:f\x1f\x19U\xb5\xa4\x83\xc4R\xfe\xf7\x06\xa43\x01\x07\xe4\xb4\xad\xcf\x0d\xc8\xa1/\xf7\xdf\xea\x98B\xa6\x1a\x86\xaa\xcf.\xe7r?\xe2\xb2\xc7\xf9*\xdcj=\xd2'\x92\xe1\xd0\xb9@\xbd\xd6\x87Jb\x88\xb6K\xd5T{\x97\xbb\xce\xb7\xe0z\x19\xb1F`\x9cl\xab\x0fJ/\xbaP\x160+c\x0fB+\xa3@[q\xa5\x17](\x0b\xdc\xed\xd0$/\x0c\xf95}\xa0\x96;\xb2\xcfSlZ\xec\xd0\x9d%l\xe8\xce\x14q\xaa\x0f\x87F\xe9\xb2\xa5\xcc\xaa	1S6M)\xb3h\xae4`\xd6\x95+,VLD\x96\xe1?\xb9c\xfe\xad\x9bz\x10\x9f\xdc\xa9[\x80\xa0_\xfa1\x1b\xdd6\xe1\xa3\x1bX\xa6\xc4\x8c\x19>\xcd1\xa4M\xb0\xca\xa11\xc5*\x81\x9e\xfb\x8a\xc1\xce}\x850\x99\xe9:\x01\xccb\xbf	\xb4\xb2\xee\x0b-z\xd7W\xe5\xb7\xec\xb5\xeb\x0f\x97\xb7\xf1\xbf6	l,\xa5(\xdf\xea\x00\xa3m\x1e\xfak\xbb/\x87J~\x0d\xea\xab\xe9\x88PX5M}\xbe\xd4\x97\xafI\x87d\xf9\x9b\xfd\xd62}z~\xcd\n_J\x0b\x17\xbepCK\x97\xae4'e+_\xc6\xb5\xaeC1-\xdd\xba\xd2\x82\x94\xe5s_\xc8\xa0\xb9\xaf\xd7\x82\x16z\xb5K\xda\x00_\xad5m\x80\xa7oi\xfd\xbd\xfd\x9cV`\x1d\x9aE\x15\x98\x08![\x1e\xa7\xca\xd7\xec|{\x13\x99\xab_\xb3\xfc\xbf~\xeb\xad\xad\xfcW\xacM\xa9\xf7\xe2b\xf1\xb8\x18\xff\x17dE\x94\xad\xd7\x8f\xeb\xf5z\xbd\xa1<_\xa1b\x15J\x17\xb1t\xb3\xa2X\xef\xffbN\xb5\xfb\xd2\xe5\x9cj\xf0\xa5\xebX\xba\x8c\xa5[V\xf7\xd0\x81\xb2~\xab(\xdb\xcav\x99\x8fz\xcb\x0fz\xbf\xfa\xef\x84\x07\xc1w\x97\xfe\xf9\x97l\xfe\xf6KV\xb7\x87\xea\xe6o\x1e\xbf\x8c\xe3\xc1\x97\xe5\xa1\xb0\x88\x85E(\\\xc4\xc2E(\\\xc6\xc2e(\\\xc5\xc2U(4=\xec\x8bM\x1f\xbf\xff\xf3\xa9:\xd4\xe5\xc3o\xe2w\xc97\xeb\xed\xf9\xf6\xc3\xdb\xe3\xe90>T\\\x86\xac\xaf.\xd5\xf0f\xfe\xf4\x8fam\xe5?T2\xb6bD\x96\xe7sU\xf6e\xbb\xaf\xf8\xed-\x96\x93\xbb\x9b,\x14\xbf\xad\xc6\xdd\xb3\x8bH\xdb\x95\xfbo\xcf&-nV\x0eC\xb9\x7f9\x99\x17\xba64\xcd!\x9bn_6	\xa4\x91\x05\xa4\xfb\xb4\x19\x86\xfa\xaf\x9c9,\x0d3#\x04\xbb\x19\xc1\xde\";\x82\x8d7S\xd0\xf0\x06\xd9\xc1X\xe0\x99F\xfb\xb7\xc7\n\xdd\x03\xb0\x7fs,\xc1\x00\xea\xdf\x1a\x13(@\xf97\xc6T\xa1\xd9\x8d\x01X\xff\xb6XaaU\xfd\x9bb\x8d\x86n\xf0o\x89\x1d\xdc\xc6\xad\x01\xd81:\x96\x06\xb0\x01\xbf\xba\xb7\xc3\x12\x0c\xdd\xea\xde\x0c\x0b,@\xfa\xb7\xc2\x11	@\xfe\x8d0Q\x97\xf2\xa9\x7f\x1b,\xa1\xd8\xa5\xeeM\xb0\x02c\x8f\xba\xb7\xc0\x0e-\xe2\xdf4\xde\xbf\x01\x06x\xe83\xf7\xf6W\xc3\xd1\xd0qo~\x19\x18\xd5\xd9\xbd\xf5\xe5JS\xce\xf3o|\x01\x1a\xfb\xcf\xbd\xedEx\xecB\xf7\xa6\xd7\x11D\xf8\x1cp\xa1{\xcb\x0b\xf0\xd0\x85\xee\x0d\xaf\x86\xa3\xba\xb8\xb7\xbb\x0c\x0c\xebl\xdf\xecr\xa5I\x17\xba\xb7\xba\x00\x8d]\xe8\xde\xe8\"|b\x14\xda\xb7\xb9q\x1a \xd1w\x1a\xee\xdf\xe4j8\xaa\x8d\x7f\x8b\xab\xd0\x08\xeb\xde\xe0R,\x9a6\xdc\xdb[\xa62\xe5=\xff\xe6V\x83au\xfd[[\x00\x87\xce\xf0ol\x1d\x9e\x05\xef\x01\xd7\xb9\xb7\xb5\n\x8d\xaa\xe2\xdf\xd4J0\xf4\x88}KK\xa0h\xe8\xbb7\xb4Ta\xcam\xfe\xed\xac\xc2b\xaf\xb97\xb3\x1a\x8d\x9d\xe6\xde\xca:8\x8b\xfd\x03\xa3\xc2\xbd\x91Uh8\xdc\xdc\xdbX	F\xfeuob	\x14\xb9\xd6\xbd\x85\xa5\n\x93Nso`\x15\x16V\xd5\xbf}\xd5h\xec4\xf7\xe6\xd5\xc1E\xe8 \xf0\x84{\xeb\n\xf0p\xb4\xb97\xae\x1a\x8e.*\xf7\xb6\x95\x81\x91C\xdc\x9bV\xae4\xe5>\xff\x96\x15\xa0\xb1\x03\xdd\x1bV\x84\xc7.toW\x1dAD\x1e\x02\x9f\xb87\xab\x00\x8f\xea\xe3\xdf\xaaj8j\xac{\xa3\xca\xc0\x00\xe7\xdf\xa6r\xa5)\x17\xfa7\xa9\x00\x0d\xab\xec\xdf\xa2\"<\xbeW\xb87\xa8\x9e@\x03\x17\xc1\x8c\xe3\xde\x9e*4\xbc\xd5\xba7\xa7\x12\x8c:\xd2\xbd5%PTW\xf7\xc6\x94*L?\xa7\xd8\xb7\xa5\n\x9b\xb8\xc5\xda7\xa5\x1a\x9dxF\xb1oIG\xb8\xdb\xdb\xe7\x8b\x19\xb7\xab\xaf\x98jI\x13\xd94\x00\x12\x8c\\\xb2\xbe\x89\x1c\x10		F1Y\xec j\x9fd\xd2\x95\x0f`&yt\x19\xc4yI\n]\x13	S\xa6\x1f\x92D\xba@B\xc4\x89\xe6\xd1\xd5\x12\xa4N8\x95.\x9d\"\x97\x06R\xa2Q\xa3:PGT\x82\xfe#\x8b*\xc0\x9c\xe8>\xb2\xc2\xd2\xc4$\x8d.\xb7\x18-\xc9\xa0k/nh\xba\xef\xe8B\x0c\xf0\xa6\xba\x8e\xac\xca\x10s\xaa\xe7\xc8\x12-Ra@&\x98\xff\xc8z\x0d\x93'\xba\x83,\xde 7=\xc6\xc9JN2\xd3\xed$\xcb:en\xba_\xe8\x1a\x0fS\xa7\xba\x86,\xf8\x12\xe4\xa9\xde!\xab\xbf\xc8\x86\xf1\x9c\xe8\xee\x14\x97\x82\x98<\xd1;d]\x08\xb9\xe9*\x93E\xa2dN\xb43\xae\x18\x95\xb9\x0fz\x87,\x1f1u\xaaw\xc8Z2A\x9e\xbcv\xe2\xc22\xb2Q8\xa8\xe6\xd2U&\xe4\xa6+M\x97\x9c\x88\x9a&\x92\xf5\xa7 &9t1*\x8dMw\x0c]\x99B\xe6D\x13\xe92\x15s'\\K\xd7\xac\x91\x0c\xa2IA\xaf\x90\x05,\xa2\xa6kLW\xb3\x809\xe1\xdf\xb8\xb4\xe5\xbc$\x85\xaes\x85\xa9\xe9\x1e\xa1\x8b^D\x9c\xea\x10\xb2\x02\x86\xd4\xa9\xfe \xcb\xe1\xc8\x05\xc1\xa8`\xc4\x92\xb51\xa2N\\$d\xa1\x0c\x98\xe9~$\xabf\xceKw!YB\x0bS\x1f\xf4\x07YO#\xe2D\xf3\xe8\xe2\x1aR\xa7\xfa\x83\xac\xb4#\x17\xc6\xb2\x02\xbf\x92e7&O\\#d\x0d\x0e\xb9I&]\x90Kf\xda\xbddu\xae\xccM\xf7\x0c]\xaac\xeaT\xdf\x90u{\x82<\xd5;d\x11\x1f\xd90\x14\x16x\x98\xac\xe819]m\xba\xbc\x87\xdc\xb4\xb7\xc8Z_2\x93$\xba\xf0W\xe6\xa6{\x87\xee\x02`\xeaD3\xe9\x96@\x82<u\xcb'\xfb\x03\x84\xad#i\xc1\xb4M6\x0b\x10u\xe2a\x8c\xec\x1c\x00fz(\x91m\x04\xceK\xb7\x8f\xec)\x08S\x1f=$\xc7\x0d\x06D\x9c|\x08\x8b\xbb\x0d\x90:\xf9\x80\x1c\xb7\x1e\xdc).\xb1\xf5`\xcfo)\xa6\xdaz\x88l\x1a\x91\x0b.1\xb2\xf5\x109 4\x17\\ad\xeb\x01Q\xfb$\x93n=\x00f\x92G\xb7\x1e8/I\xa1[\x0f\xc2\x94\x1d\x01)\"\xddz@\xc4\x89\xe6\xd1\xad\x07H\x9dp*\xddz\x88\\\x1a\xd9\x8bF\x8d\xea@\x1d\xe2\x0b\xfa\x8fl=\x00\xe6D\xf7\x91\xad\x07ML\xd2\xe8\xd6\x03\xa3%\x19t\xeb\x81\x1b\x9a\xee;\xba\xf5\x00xS]G\xb6\x1e\x10s\xaa\xe7\xc8\xd6C\xa4\xc2\x08a0\x9b\x92\xad\x07L\x9e\xe8\x0e\xb2\xf5\x00\xb9\xe91N\xb6\x1e$3\xddN\xb2\xf5\xa0\xccM\xf7\x0b\xddz\xc0\xd4\xa9\xae![\x0f	\xf2T\xef\x90\xad\x87\xc8\x86\x01\xc6\xe8^\x17\xb7\x1e0y\xa2w\xc8\xd6\x03\xe4\xa6\xabL\xb6\x1e$s\xa2\x9dq\xebA\x99\xfb\xa0w\xc8\xd6\x03\xa6N\xf5\x0e\xd9zH\x90'\xaf\x9d\xb8\xf5\x10\xd9(>Ys\xe9\xd6\x03\xe4\xa6+M\xb7\x1e\x105M$[\x0f\x82\x98\xe4\xd0\xad\x07il\xbac\xe8\xd6\x03dN4\x91n=`\xee\x84k\xe9\xd6C$\x83\xf0f\xd0+d\xeb\x01Q\xd35\xa6[\x0f\x809\xe1\xdf\xb8\xf5\xc0yI\n\xddz\x10\xa6\xa6{\x84n= \xe2T\x87\x90\xad\x07H\x9d\xea\x0f\xb2\xf5\x10\xb9 :\x1a\x8cX\xb2\xf5\x80\xa8\x13\x17	\xd9z\x00\xcct?\x92\xad\x07\xceKw!\xd9z\x10\xa6>\xe8\x0f\xb2\xf5\x80\x88\x13\xcd\xa3[\x0f\x90:\xd5\x1fd\xeb!rap5\xf0+\xd9z\xc0\xe4\x89k\x84l=@n\x92I\xb7\x1e$3\xed^\xb2\xf5\xa0\xccM\xf7\x0c\xddz\xc0\xd4\xa9\xbe![\x0f	\xf2T\xef\x90\xad\x87\xc8\x86\xb1\xd9\xc0\xc3d\xeb\x01\x93\xd3\xd5\xa6[\x0f\x90\x9b\xf6\x16\xd9z\x90\xcc$\x89n=(s\xd3\xbdC\xb7\x1e0u\xa2\x99t\xeb!A\x9e\xba\xe5\x93\xad\x07\xc2\xd6\xa1\xdd`\xda&[\x0f\x88:\xf10F\xb6\x1e\x003=\x94\xc8\xd6\x03\xe7\xa5\xdbG\xb6\x1e\x84\xa9\x8f\x1e\x92\xe3\xd6\x03\"N>\x84\xc5\xad\x07H\x9d|@\x8e[\x0f;\x9fs\x8c\xe2B\xaa-\x1f\xca\xe3\x90.k	B\xfa\xf4%\x0ei\xd2\x9f \x9c\xcd\x83BPS\xe6G\xf9\x83\xa8\x83\xe1\x0c\xdd9M0\xe1J\x0em\x93\xa8 \xa8\xcb\xa6BqSU1\x00Y\x17\xcbJU\xc6RhmRHV\xe3\xea\\\x95\xac\xca\xb6\xe4\xc9\xfe\x13pm\x97\x86\x06\x99\xd0\x9a\xdd\x92z\xb3\x9b\xc4\xde\xd3\xd8{\xc0\x9a8	\x823!\xf66&\xc6\x8f\x19\xb9\xffe \xa6\x94`\xda\xa1\xac[\x802\xe5\x0e\xd7\xf5\x87\xaa\xe7\x91\x06\xb6\xc8\x0ek\x1de`\xa5.\xd2\x80By\x94\x81\x95\xf0H\x03\x86fQ\x06\x12\xdd\x0b0\x8b2\x10`\x01eQ\x06\x11*P,\xca\x80(l\xec\xd0fX\x16e \xb1\xaa\xaa,\xca@\xa1\x95\x1bX\x94\x81\x95\xb8H\x03\x0e;r\xc7\xb2H\x03\xeeW\x1ae \xc0\xca\xad4\xca\x80c\x05\x92E\x19\x04\xa4\x00\xb1(\x83\xa8\x0e\xf9\x94E\x19\x08\xa8v)\x8d2\x90`\xedQ\x1ae`%2\xd2\x80\xe1Y\x94\x81\xc6+\x9f\xd1(\x03\x05\x97C\x87F\x19P\xb0\xac3\x8d2`J\x91\xf3X\x94\x81Fk\xff\xd1(\x03\x80\xd7.\xa4Q\x06V\"#\x0d\xb8\x0bi\x94\x81\xc6+\x17\xd2(\x03\x05\x97u\xa1Q\x06\x14\xac\xeaL\xa2\x0c\x98R\xe8B\x1ae\xa0\xd1\xda\x854\xca\x00\xe0\xc1($Q\x06V\"\"\x0d\x18\x9cE\x19(\xb8\xac\x0d\x8b2\x90h\x89\xa5Q\x06\x04+\xa7\x0d\x1ae@U\"\xef\xb1(\x03\x05V\xd5eQ\x06\x1a\xae\x9c\xc1\xa2\x0c\xac\x84G\x1ap\xd7\xd1(\x03\x89\x96UaQ\x06\x02\xac<B\xa2\x0c\"T\x0e}\x1ae@\x14\"\xb7\xb1(\x03\x89\xd5^\xa3Q\x06\n\xad\x9dF\xa3\x0c\xac\x84G\x1a\xf0QA\xa3\x0c$Z\x0d7\x1ae \xc0\xd2\xbf4\xca B\xa5ki\x94\x01Q\x08\x9dF\xa3\x0c$VU\x95E\x19(\xb4v\x1a\x8d2\xb0\x12\x19i\xc0=A\xa3\x0c4^\x8d6\x1ae\xa0\xe0\xf2\xa2\xa2Q\x06\x14,\x1dB\xa3\x0c\x98R\xe4>\x16e\xa0\xd1\xda\x814\xca\x00\xe0\xb5\x0bi\x94\x81\x95\xc8H\x03\xee\x13\x1ae\xa0\xf1\xb2>,\xca@\xc1eci\x94\x01\x05\x0b\x1c\x8b2`J\x91\x0bY\x94\x81F\xab*\xb3(\x03\x80\xd7\xf7\n\x1ae\xe0\x08,\xd2\x80\xa1Y\x94\x81D\xab[-\x8d2\x10`\xd9\x914\xca Be]i\x94\x01Q\x88\x9fSH\x94\x81\xc4\x82[,\x892Ph\xf0\x8c\xa2\xa3\x0c,\x00D\x1aPf2\xca\xc0\x82X\xa4\x01\x1f\xb9 \xca\xc0\x02P\xa4\x01\x1f\xc5 \xca@R{\xc8DQ\x06\x82	y(\xca \xf2 \x05E\x19\x10S\xa6\x1f \x11E\x19Hb\xa2y(\xca@Q\x13NEQ\x06\x16\xc0\"\x0d\xc4\xa8\x81\x1d\x08\"\x0dx\xff\x81(\x03\xc1Lt\x1f\x882\xe0DHCQ\x06\x81\x06\x19(\xca \x1aJ\xf7\x1d\x8a2\x10\xbcT\xd7\x81(\x03\xc9L\xf5\x1c\x882\xb0\x00\x1ci\xc0\xc8(\xca@\x93\x13\xdd\x01\xa2\x0c\x14\x17\x8fq\x10e@\x99\xb8\x9d \xca\x80\x99K\xf7\x0b\x8a2\xd0\xd4T\xd7\x80(\x03@N\xf5\x0e\x882\xb0\x00\x1ci\xc0{\x07D\x19hr\xa2w@\x94\x81\xe2\xe2*\x83(\x03\xcaL\xb4SG\x190s\x13\xbd\x03\xa2\x0c45\xd5; \xca\x00\x90\x93\xd7\x8e\x8e2\xb0\x00\x18i\xc0\xb8(\xca@qq\xa5Q\x94\x81\xa4b\"\x882 D\xc8AQ\x06\xd4X\xbacP\x94\x81b&\x9a\x88\xa2\x0c47\xe1Z\x14e`\x01(\xd2\x80\xf7\n\x882\x90T\\c\x14e \x98	\xff\xea(\x83\xc8\x83\x14\x14e@L\xa5{\x04E\x19Hb\xaaC@\x94\x81\xa2\xa6\xfa\x03D\x19X\x00\x8a4\xe0#\x16D\x19Hj\xe2\"\x01Q\x06\x82\x89\xfb\x11D\x19D\x1e\xeeB\x10e@LM\xf4\x07\x882\x90\xc4D\xf3P\x94\x81\xa2\xa6\xfa\x03D\x19X\x00\x8e4\xe0~\x05Q\x06\x9a\x9c\xb8F@\x94\x81\xe2B&\x8a2\xa0L\xec^\x10e\xc0\xcc\xa5{\x06E\x19hj\xaao@\x94\x01 \xa7z\x07D\x19X\x00\x8e4\xe0\x1e\x06Q\x06\x9a\x8c\xab\x8d\xa2\x0c\x14\x17{\x0bD\x19P&$\xa1(\x03f.\xdd;(\xca@S\x13\xcdDQ\x06\x80\x9c\xba\xe5\x83(\x03\xc7\x06\x91\x06\x8c\x8a\xa2\x0c$5\xf10\x06\xa2\x0c\x04\x13\x0f%\x10e\x10y\xb8} \xca\x80\x98\x9azH\xd6Q\x06\x92\x98|\x08\xd3Q\x06\x8a\x9a|@\xd6Q\x06\x16\x00\"\x0d(3\x19e`A,\xd2\x80_b \xca\xc0\x02P\xa4\x01\xbf\xc2@\x94\x81\xa4\xf6\x90\x89\xa2\x0c\x04\x13\xf2P\x94A\xe4A\n\x8a2 \xa6\xec\x08@D\x14e \x89\x89\xe6\xa1(\x03EM8\x15E\x19X\x00\x8b4\x10\xa3\x06v \x884\xe0\xfd\x07\xa2\x0c\x043\xd1} \xca\x80\x13!\x0dE\x19\x04\x1ad\xa0(\x83h(\xddw(\xca@\xf0R]\x07\xa2\x0c$3\xd5s \xca\xc0\x02p\xa4\x01#\xa3(\x03MNt\x07\x882P\\<\xc6A\x94\x01e\xe2v\x82(\x03f.\xdd/(\xca@SS]\x03\xa2\x0c\x009\xd5; \xca\xc0\x02p\xa4\x01\xef\x1d\x10e\xa0\xc9\x89\xde\x01Q\x06\x8a\x8b\xab\x0c\xa2\x0c(3\xd1N\x1de\xc0\xccM\xf4\x0e\x882\xd0\xd4T\xef\x80(\x03@N^;:\xca\xc0\x02`\xa4\x01\xe3\xa2(\x03\xc5\xc5\x95FQ\x06\x92\x8a\x89 \xca\x80\x10!\x07E\x19Pc\xe9\x8eAQ\x06\x8a\x99h\"\x8a2\xd0\xdc\x84kQ\x94\x81\x05\xa0H\x03\xde+ \xca@Rq\x8dQ\x94\x81`&\xfc\xab\xa3\x0c\"\x0fRP\x94\x011\x95\xee\x11\x14e \x89\xa9\x0e\x01Q\x06\x8a\x9a\xea\x0f\x10e`\x01(\xd2\x80\x8fX\x10e \xa9\x89\x8b\x04D\x19\x08&\xeeG\x10e\x10y\xb8\x0bA\x94\x0115\xd1\x1f \xca@\x12\x13\xcdCQ\x06\x8a\x9a\xea\x0f\x10e`\x018\xd2\x80\xfb\x15D\x19hr\xe2\x1a\x01Q\x06\x8a\x0b\x99(\xca\x802\xb1{A\x94\x013\x97\xee\x19\x14e\xa0\xa9\xa9\xbe\x01Q\x06\x80\x9c\xea\x1d\x10e`\x018\xd2\x80{\x18D\x19h2\xae6\x8a2P\\\xec-\x10e@\x99\x90\x84\xa2\x0c\x98\xb9t\xef\xa0(\x03MM4\x13E\x19\x00r\xea\x96\x0f\xa2\x0c\x1c\x1bD\x1a0*\x8a2\x90\xd4\xc4\xc3\x18\x882\x10L<\x94@\x94A\xe4\xe1\xf6\x81(\x03bj\xea!YG\x19Hb\xf2!LG\x19(j\xf2\x019n=\x983\xdd\xd5\xc1~\xf0\xde\xc1\xfa\xf2P_/\xfe\xab\xf8\x1eq9	yH'GP\x12\xa2\x11Y\xf3,A\x1ac2\x12p\xd4\x97/_\xbe\x9co\x1c6\xb0j\x0f\xdd\xd9\xc6 \xf8\xfa\x7f%\x02\x1b\x10\x80[\xd6+5\x1c\xed\xf5\xd8\x18\x83IU;\xa6\n\x12\x846Vc\xe1\xa9\xcf5\xefcE\x03\xe9<\xa9\xc6\xf5b\xd2W\xa8\x97\xb3^(D\x8c)\xafA\xa5;\xa2t\x824\xd1l\xa8\xb6\xf9|\xe3?\xabrH\xea\xfb\xc0\x97\xc8\x95i?~\xecF\xe4\xc5I\x17~\xd8\\\xe4\xc0\xcf\xb5\xf6\x93\xda\x062\x01(\x85\xd3\xdeC\xe3\x90+\xd3\xf8I\xf7\xa11\x18\x15&)S-F\xe3\xef\x93\x0d\xfe\x9c\xba\x81M\x8eR\xa1\x9d%\x93.D\x93h\xaf\x14\x02\xca\x94\x1b\x91\xd2\x1dS\x9afM\xb4\x1d\xa9m\xfe\x8e\xc6\x7fR\xe5\xf0\xc1,+\xd0\x1f\xdd+\x84\x1b\xfa\x8f\xef\x07\x82\xd1 \xc6T\x8d>1\xbf\xa96|<{\xabv|jnVmQ\xac\x8fk\xf7\xc1t#\xdb\xf2\xc1\xf4)\xdb\xf1\xf1\xec(\xdb0\xd5\x00X\xff\x8f\xaew\xd5\x1b\x1f\xcda\xaa/>1G\xa9\x9eP\x9c\x8f\xea\xf5\x99kM5\xe5\x13S\x89j\xcd\xe7f\n\xd5 @KU\xd0\xa1.]S\x87'T\x9b\x06\xca\x141\xd0\xa1\xbc\xbcT\x02e\xcb8\xac\x1b\x06\x053e\x0cF\xaf\xe5\x98x\x8aA\xe6^nSW\xcd\x99\xb0\xe0\xc2B\xb4h\xc9\xc5K!\xder\xf1\x96\x8b\xb90\x17\xdc!Vl\xecHT9Rw\xdba\x08\xb4\x8b \xd7K\x08\xd5D\x94\xe9D\x84\x19\xa27b\x8d\xa4G\x88\xcbh\x9d$l\x17a\xacV\x12\xd7D\x1c\xa9\x97D\x0d\xb1#b\xcddg\x90\xde\xa25\x93\xb0]\x84\xb1\x9aI\\\x13q\xa4f\x125\xc41\x10k\xb6U5\xdb\xa2\x9aI\xd8.\xc2X\xcd$\xae\x898R3\x89\x1at\xbd\xe4\x10\xecQ\xad$H>\xefbT\x03j\x140\xfbk\x7f\xe9z\x1b1m\xff&\x81\xd2Nx\xa8\x8e\xe5\xb5\x19\xbc\xdc\xfdd\x90sW\x9b |\x07q?\x19\xe4\xb5\xac\x83\x8a\xf1o&<u\xdf+/\x1c\xfff\xc2\xb6\x1b\xb2\xb2i\xba\xd7\xea\xe01\xa4\xc8\xb5\xb5\xe9\xf6\xdf\xde\x0e\xf5\xe5\xdc\x94w\xfb\xcb\n\xea\xd6\xa4\xcd\xe5rZHa\x02`EC\xb9k\xa2\xc4\xfc\"\x82\xac\xef^\xb9p,\xa1\x80}\xd54\x021\x16Y\x88\xcd\x8c\x19\xc4q\x86<6\xd5-\x14\x8f?Xs\x98\x94\x94E\xae\xa9\x97\xf9\xe3P\xf7\xd5\xde\xc6\xfe\xfb\x8ay@\xd6W\xdf\xab\xfeR\x01\xa0\x17\x11\xc2\xbek$p\xdf5\xd7S\xcb1)\xa5\x16\x0b\xf4\x9a\xbc\xa4\xe1\xaf'\x9b\x00\x94I\xb9\xca\x80\x02\xba|\x92\xd5\x08\xf4\x19E\x8d\xf7\x86\xeat\xc9.C\xd9\x0fo6m\xac)\xb1TSLqU{\xd0\xa8\xaa=P\x8c\xfb\xf8\x04\x85\xd1\x8fNXP\xc8\xb2Ka1\xc5.\xadY_\x0d\xfb\x17\x86se\x16v\xa9\x9a\xa3\xbdX-d\xfcM.X#\xa6\xcd3r\xd9:\x83\x8a\x8d\x8b\x98\xd06\x83`M3 \xda2g\x8a\xd6\xd7`Xu\xffv\xbd\x0c\xf5\xf1\xee\xaa\xe4\x7f\xed\xbbv0\x07|D\xbd\xbc|\xac\x1a\xc4\x86\xfa\x05\xa9\xad\xa2\x04\xd3zz\xd9\xae\x1a^\xab\xaaUX\x9b\x1e\xd7I9\xa54O\\	\x86\x15\xbay\xcaJ\xb8\xc7Pe<\x90vQ\xca\x1b\x1e\x1b;\n\xfb\xc2\xe3|\x039\x164\xcf\x13\\\xf3\x10\x9e6\xceX\xcb\xcd\x05\xf5\x94\x93\"3\nMi\x1c\x7fFP\xb7\xf5P\x97v\xaaxr?\xd8\x15\xda\xda\xebXLv\xd9s\x98\xb1\xc6\xbf\x98\xad\xcbK_\xb7\xdf\xde\xc8\xdfL\xdcv\x92<\xe7R\xc0\x0f\x88\xae\x1c\xecM\xf6\xcd\xfcM?\xc1b\x85\xe3m\xd3\xc9\x9a\xf0\x9d\x18+rm\xe9\xca\x814f\xdfTe\x7f\xacoO\xe5\xd1\xdc\x12\x9dg\x7f\xf5\xab\xaf\xec&\xf0\xd5\xe0\x9ev\xdd\xe0.\x16\x9b\xee\xbcl]\xca\xfccy\xaa\x9b\xfb\xd3\xe5~\x19\xaaSv\xadg?6u\xfb\xed\xa7r\xff\xb3)\xf9C\xd7\x0e\xb3\xac<\x9f\x9b*\xb3\x98\xd9\xcf\xd5sW=\xfc\xe9\xdff\xff\xd9\xed\xba\xa1\x9b\xfd\xc7\xed\xfe\\\xb5\xb3?\xed\xae\xedp\x9d\xfd\xael\x87\xb2\xaf\x9af\xf6\x87\xba/\x1f~.\xdb\xcb\xec\xf7}W\x1f\xec\x9f\xffZ5\xdf\xab\xa1\xde\x97\x0f\x7f\xac\xae\xd5l\xacGv\xa9\xfa\xfaH+7\xfef\xb5\xfb]\xd7^\x06\x93\xde}\xf6\xef\xd7}}(\x1f~4\xfes\xbfv\xf4\xc7\xc3\xcf#\xdd\xfd\x98\xfd\xbe\xfa[\xf9\xe7\xab+\xfb\xb1\x1e\xc6y\xa3<=\xfc\xb9\xea\x03\xb0\xdeU6{\xbd+\xf8\x97\xaa\xeb\x9f\xebr&\xabu\xea\xda\x8e\xd5\xea\xa7\xaam\xba\xd9O][\xee\xbb\xd9X\xc5\xae)/T\xdfO];\n\xae}]\xf5\x0f\x7f\xac^g\xa3\n3\xf4\x89\xda\x97\xb26\x19\xf5\xad\xea\xd7jl\xcaS>\x9f\x13\xc8\xf0R\xb7L\\0qc\x87\x15\x91/\x98\xdce\xd1\xa6\x80%\x03\x9c\xaaC}=1\xc0\x8a\x01.\xd5\xa9\xdeu\xcd\x81A\xd6\x0c\xa2\xc4\x1b&\xaenC_*\xcc\x96\xab0\x1f\xab\xa1\xf2/^\xee\x8e~2\x87\xb93\x9f\xd0m\x14?z\x0f`\x0b\x84\xb5/\x924x\x81\xc0\xd6\xaf\x00\xbdDh\xebd\x80^!\xb4\xf78\xc0\xaf\x11>\x81\xdd l\xe8\x0b@\xd8B\xe5$\xd6\x16v\x8f{=\xc6\xbb\xc7\xbe\x17\x83\xddC\xf1\xa6{4\xb6@X\xfa\x9e\x0fv\x0f\x05\xbb\xee\xd1\xe8%B\xbb\xee\xd1\xe8\x15B\x87\xee\xd1\xf85\xc2'\xb0\x1b\x84\x8d\xdd\xa3	[\xa8\x9c\x9cG\x86\xdd\xf3\x92\xe5o/\xb6\x8c\xee\xa7\xbddE(\xa6\xa5\x8bP\xba\xa1\xc5K_\x9c\x93\xc2U(\xe4\x9a\xd7\xb1\x9c\x16o}qA\n\xf3y(e\xe0<ToAK\x83\xea%mI\xa8\xdd\x9a\xb6$h\xd8\xd2\x86\x84Z\xe4\xb4\x1a\xeb\xd8@\xaa\xc3<\x808A|\x04y\xc9\xce\xb7\x00\xf7\xab\xdc\x17\xbbc\xf6\x12F\xfb\xaf}\xb9K\xf4\x1e%\xdf\xdd\xdd\xb8\xa9\xcaC\xdd>\xdb[\xbcY_y\x0c\x97\x0fc!\x07<\xfa\xb7\xf6Q\x87\x99\xe79H`\x9a\xae\xbbpC\xee\xac\xc3)\x9b\xbf\x9d\xca\xfe\xb9n\xfd3\xcb)\xcb}	\xed\xdbSV\x84bZ\xba\x08\xa5\x1bZ\xbc\xf4\xc59)\\\x85B\xaey\x1d\xcbi\xf1\xd6\x17\x17\xa40\x0f\xf5-\x188\x0f\xd5[\xd0\xd2\xa0zI[\x12t\xb0\xf6\x85:\xafi\xfb\x82\xde-)5\xa3\xc3\x95\xc7\xd1q\x1aG\x87o\x8a\x1f\x1d\xa7{pq6t\xe7\xa7\xf9W\xf7\xc3n\xab\x04\xb7\xdf\"l|\x10\x8c8\xf3\x94\x13`\xf7\xd0=F\x9b{S\xc8U2\xef\xde\"\xc1\xe8\xe5\x8c^M\x0f\xa7{\xe8ik\x01\x19`\xfa\x0b\xae\x1f\xa8g\xda\x17L\xfb\x06\xa9\xdf0\xfd\x0b\xae\x7f\x03\x0cl\x98\x85%\xb5\x90k\xfd9\xd5\xbed\xdas\xa5;\xa7\x9aWL3t~.\xbc\xbf\xe2\xfa\x91\xfbs\xe1\xff5\xb7\x02\x8d0\x1bka\x03\x99`\x16\xb6\xd4B\xa1\xf5\x17T\xfb\x96i/\x94\xee\x82j\xce\xd9`/P\xe5\x0b^\xf9\x9c\x8f\xfb\x02\xd4\xbe\xe0\xb5\xcf\xd9\x00]h\x0b\x0b\xa6\x9f\x8f\xcf\x85\xd2\xbe`\xba\x99\xf3\x97Z\xf7\x92\xe9\xe6\x9e_*\xddKv]1\xdf\x00\xcf\xf0\xcb\x8a\xfbE{\x85_\xb3l\xd0\xaf\xb5\xee5\xd3\xcdG\xfdZ\xe9^\xb3+\x96\xf9{\xabuo\xd9\xe5\xca\xfd\xbdU\xba\xb7T7\x99I\x8d\xf6\xf1\xb7\xd0N&\xd8\x1b\xc3\x1b\xfd\x94\xd0\x8b\xdb\xf5\xe9\x1egd{1\x9do\xf2J\n\xf3\xf4\x8d`\xedu\x14\xc1=\xbf\xe3\x9f\x061\xa7\xbb\xe2>\x16\xf3Y{\x17\x05b\xdao\xe4\xb4\x1f,\x80y>\x98\xc9\xb9\x19&\xddE)\xba\x1f4\xf0~\x10\xac\xea\xb9?\x18-\x84Qf\xb3\x906\x99Ip\x8b\x08\x16\xc1\xfd \x98\\\x08\x93\x1bfs!mn\x98Qt\xdf\x08V\xd5=\"\xd8\\r\x9b9\xb5\xb8\x14\x16sjO\xdfI\x825t\xdf\x08\x06W\xc2\xa0\xe8\xcd\x95\xb4)\xba\x13\xde`\x82ep/	\x86\xd7\xd20\xb3\xbbVv\x99Yt\xcf	V\xd5\xfd%\xd8\xdcr\x9b\x05\xb5\xb8\x15\x16\x0bjO\xdf\x85\xe2\x95\x02\xee9\xf1R\x11\x97d\xc1[\x99\xcb\x0b\xb3\xe0\xcd\x84w\xa7hY\xdd\x89\xa2]q\xb9,\x98Uy\xb9,\x98M}\xc7\x8a\x16\xd5\xfd)Z\x14\xfd\xb9d\x16ew.\x99E}\x1f\x0b\x16\xf5]+X,\x84o\xf9\x8c =\xcbg\x04}w\x8b\x16\xd5\xbd,Z\x14W\xe7\x9aY\x94\x97\xe7\x9aY\xd4\xf7\xbc`Q\xdf\xe1\x82\xc5\x85\xe8\xc7-\xb5\xb8\x90\xfd\xb8\xa5\x16\xc1\x9d0XD\xf7\xbd`\x93\n\xd5=m\xc7\xc4\xfa\x1e\xd9\xe0{d\xb0\xac\xee\x88\xc1l\x94\xc8\xfb\xdd\x8e\xc8\xd4\x8d\xb3\x017N'*o\x99[\x1d\x9b?\xe5\n\xd9\xca\xdd*\x99#\xfcJy,}\xcdn\x17#v\x87\x04\xe6\xd1\x91Fz9\x11\xe9BJO\x07\"]Ji\xf3L\xa4+)\xbd5D\xba\x96\xd2\x82\x897R\xbc`\xe2\xad\x14/\x99\xf8\x8b\x14\xaf\x988\x9fKy\xf0j\x00x\xa7\xd6m\xf62>U\x8c\xffV\xec1\xc4H,1\n%\xd3w\x07C\x84\xee\xa8\xdb\xec\xd5)gGI\xac \xe8\x96\x95\xca\xf4FCFv\x1a2z\xd7\xca\xc8^C\xf6\xc8\xca\x17\xb1|\xc3\x04a\xed\x9e\xe5\xb4x\x15\x8b\x85\x855\x910A\xd8u\xc8\nZ\x1c\xf7\x1d\xb2\x82\x13\xe2\xceC\xb6`\xe5\xd1\xc4\x92\xb5-j\xe2m\x8emX\xb36G\xfd[Z\x1e\xf7\x1a\xb2p\xc1e\x9f\xdcm\xc8>\xb9\xdd\x90\xc9\xfd\x86\x0c\xaeyE\xf7\xc9-\x87\x0c-z\x05G\xec:dh\xe1\xc8\x07\x83\xdcx\xc8\xc0\xc2\x913\xc4\xdeC\x067\x1f\xc4\xc8\x92\xdb\x0f\x19\xda\x7f\x10\x1c\xb1\x03\x91\x81-\x086L\xe5&D\xa6w!\x18^\xecCdx#B\x0ey\xb9\x15!i\xde\x90\xec\x97\xb5\xb0\x85MqKki	\x1a\xe2v\xd83c\x066%\xd8\x05)\xb7%2\xbd/\xc1\xf0rg\"\x83[\x13\xe2\xdaV\x9b\x13\x19\xda\x9d\x10$\xb9?\x91\x81\x0d\n6S\xa8-\x8aL\xefQ0\x82\xdc\xa5\xc8\xc06\x05\x9bs\xd4FE\xa6w*\x18A\xeeUd\xc8W\xe2b\x14\x9e\x02~\x12\xd7;\xbfH\xc0\x96\x05\x9b\x07\xd5\xa6E\xa6w-\x18A\xee[d`\xe3\x82\xcd\xa8j\xeb\"\xd3{\x17\x8c w\x172\xbd\xbd@\xa7f\xb5\xc1\x90\xa9\x1d\x06\n\xc7{\x0cYr\x93!K\xef2d\xa9m\x86L\xee3\x88YY\xed4\x08\xb9\xdek\x10\x00\xb9\xdb \xc4\x03\x9a\xf5\xa3\xf1B\x1a\xe7\xb6\xe5\xc37\x97\xcb]\x07.\x1d\xe0\xbd \x9a^H\xd3\x1bn[\xee=\x08\x80\xdc}\x10\xe2\x01\xdc!\xa2m\xb1\xc6aR\xbd\x07\xc1\xc4r\x17\x82	\x07|\xdf\x88\x86W\xd2\xb0\xecm\xb9\x17!\x11r7B\xca\x07x7\x89\x15X\xab\np\xfbr\x11+\x00rWB\x88\x07p\x8f\x89\xb6\xc5\xce\x04\x93\xea\xbd	&\x96\xbb\x13L(\xf7'\xc4\xedB\xefP\x08\x00\xd8\xa3\x10\x08\xb5K!\xe4r\x9f\x82\xddL\xf4N\x05\x13\x83\xbd\n&W\xbb\x15L*\xf7+\xd8MF\xefX01\xd8\xb3`r\xb5k\xc1\xa4r\xdf\"\x133\x8b\xf4\xb9\x98Y\x94\xc7\xc5\xcc\x02nv\xc4\xb2\xbe\xb3\x11\xcb\xf2\xea^s\xcb\xea\xf2^s\xcb\xe0&\x18-\x83;^\xb4,w2\x98\x18\xece0\xb9\xda\xcd`R\xb9\xab@\xefcz_\x81J\xc1\xce\x02\x15\xab\xbd\x85(\xec\xce\xe5\xbe\x1e\xc6\xf5\x8e\xfb\xcb\xdf\xd4\xbc\xa0X\x05Ixi\xeee\xab\xc8z\x14\xa2\x0d\xa1m\x84,\x9fG\x9e{U\xdf}\xaf\xfac\xd3\xbd\xda]\x17\xff\x8bl\xb9\x04\x80;\x8d\x1d \xf6\xb7\x00}\xaf/\xf5\xae\xa9\"\xca\x15\x08\xd8e\xdfwM\x13Q\xf6\xb7\x00\xddx\x9d\xb2\x1b\xaa\xd5]\x80\xee\x08t\x93\x95\xcfn\xb8\xfaw\x05\xbcc\xe0M\xb54\xbb%\xdaz\xd7\xd0{\x02z\x93\x8e\xc9n\xd85w\x05\xbc3\xa0\xfd\xdb\x1c\xc1\xe8\xae\xfb\x97\xb7\xec\xb5\xda}\xab\x87Lt@\xdd>?\x19\x80d\x19\x9fN\x90\xa2\x8f\xcf\xd9\xfc\xed\\\x1e\x0ec\xe9\xdc\x17\xe5\xa1\x88\xde?\xff\x7f\xd6\xbe\xae7r%\xb9\xf2\xaf4\x0c,0\x03\x88m\x91\xf5\xa1\xba\xdd/\x8b\xb1\xe1\xdd\x05v\xbc\xc0\x0e\xec'\xbfPUl\x89\xbe\xacb\x81E\xb5J-\xf8\xbf/*?OD\x9c\xa4\xb4\xb83\x17\x98\x962\xce\x89\xc8\x8cH2\x93I\x05\xe3\\5\xb9\x1d\x9bW\xb9\xf9\x01\xdb\xd7\xa9\xbd\x86\xd6Mn\x95\xda\xb7 \xc0\xf6]jo\xa0\xb5\xce\xfdn\x04\xbc\xce\xbd\\asV\xbf\xc6!e5b\xa4\xb9\xef[\x1ci\xd6\xbd\x83\xe6\xf35w=\xde\x1f\xceo\xd9\xb3\xe1,$\xfe&w\xc7\xe7+\x00\xc3aH\xfcU\xec\xb0\xcfo90Ncx\x80Vj\x85S\xaf@q\xba\x15\xc7\x1b\x10\x94\xb7\x1cfo\x85\x1a\x116\x1ae\x83\x99\x10\x16V\xd2\xc2\x035\xf1 l\xac\x94\x8d\x07f\xe4AXY\x0b+5\xb1Q\xa3\x85\xb5\xb4P[\xfd5j\xdfH\xed<\x18\xb5\x8a\xc6F\xd9\xa0\xe1\xa8U<\xb6\xca\x127$\xecl\xb5\x1djFX\xd9	+\x0d\xb1\xd1\xa0\x85\x9d\xb4\xd0X\xfd\x0dj\xaf\xe5\xc5\xd0\xd0A4r\x10\xb5\xba.\x1a6\x8aF\x8e\xa2\x96\x93wE\xac\xac\x84\x0d5wW\xd6\xc2J\xe8\x97\xc1X\x13\xfdk\xa1_Ebm\xf5\xaf\xc5\xb5'\xfd\xc4\xbc$/=\xe5#\xe2!ym\xcb\x8bbK\xf4o\x85~uUl\xad\xfe\xad\xb8\xb2\xa5\xffwD\xffN\\\xd6\xca\xff;\xab\x7f\x87\xfa\xf3\xad\xd6_\x0c\xe7\xab\xb9\x12\xd2\x1d\xf8\x8ah\x7f\x1d\x00<\\\x04	=\xeb\xfbuh\x9f\xa0]\xde\x8f\x1fA\xa2\xee\xe9\x83\xb9\xa7'+\xec\x1e\x9eL\xd5\xca\x94\x10?\x82\x98\xdd\xec\x07~\xb3O\x96\xc9}=\x19n\xb4aa\xb71v\x85Yv\xffOV\xd9\xbd>\x99]i\xb3\x0f\xc2\xee\xca\xd8}\x10\x86\xe9\xa2\x90,\xdb\xfb\x7f\xb2\xbbVvk\xb4\xba\xd6Vk\xb4I\x96\x89d\x91\xae	\xc9\xe8F\x1bU\xd1\xdd\x18\xbb*\xbc|\xf5H\xd6\xd9:\x91\x8co\x8dqa{km\x0b\xd3tAI\x96\xed\xda\x91\xec\xee\x94\xdd\x06\xad\xee\xb4\xd5\x06m\x92%&_El=\xc9\x97\x91\xbed\x1b9\xda\xda\\\xb8\x8d\x1c._z\xb2u\xbb\xcad\xdb\xfaRZ	\xcb\xe6RZ	\xbbd9\xcaV\xed\xda\x93\xad\xea\xf8\xae\x85U\x13\xde\xb5\xb0J\x16\xa9d\x95\xacH\xc9j\xa3\xfd,\xef\x1a\xc6\xcb\xf2\xaeA\x96\xael\xd5\xaeS\xd9\xaa\xbez\xb7\xc2\xaa\xb9|\xb7\xc2*Y\xd0\x92U\xb2z%\xab+\x1d\xd7\x1dZ]\x99\xb8\xee\xd0*[\xe6\x92U\xbb\xa6%\xa3 \xd2\xeb\xd5#\n\xcd\xda7\xb0\xb5/\xc8|\x02{\xd5\xfd\xecN\xf3\xc5'\x01\xc86H\xf8S`\xf7`\xa9\xc0\x90\xa2;\xb7s\xbf\x7fOU\xd6\xfd\xef^\xf6\xa3\xbfv\x87,r\xbfzI\xfbx\x19\x87\x97\xb9\xcb\xc2\xd8\xe2\xe5S7\xb4s\xff\x13\xe4\xb1%Z\xed\xf7\xbf\xbfei|\xee\xf5\xed\xdf\xa17\xb7\xdf\xc3\xb0\xfa\x93\x1f\xf8\x1c\xff\xe8\xd3\xbb\xd7\xfd\x18\x9c\xe9~v\xae\x83\xc7\xe5\xfe\xf4\xee\x1f\xe6\xc2V \x82\xef\xbf\x8be\xbe?Uo\x01\xa8v\x06\xfd\xa9\xba\xbeG\xb2\xe6\xcc\xef\xb8\xf3\xe8O\xd5\xf4.\xb7\x1c\xfd\xc9}\x18Ak\x1c\xdeQ\xd3\xd4]\xfa_\x9d\x1f\x9f\xff\x19\x02\x1a\x84oQ\xf2\xb3\x9b\xe6~\x1f\xb3X\x83\xf4\x1a\xa5\xcf\xe3\xd4\xff\x1aO\xb3\x94Gi\xce\xef\xbc<\xb7\x07Wu\xf9Z\xf9\x1f\xbf\xdd\x7fi\xce\xd7/\xeb\xf3\xf5\xcb\xfd\x97\xe9\xe9\xb1\xfd\xd3\xfd\xdd\xed\xbf\xaf\xf5\x9f\x91Q\x1d\x0f\x92t#\xec,\xa9\xf9\xf3]I\xe1\xfdNjt\x1f\xc4\x01\x8d\xf5\xe6|\xfd\xb2\xba\xb7:\xeb\x9b\xce\x9b\xd0!\x96\x95\xf6\xa7\x93\xfb\xb0J\xd2\xdb\x9f.\xdd\xfc\xa5\xd8\xa7\xad\xa4\x8f/\xb3\xcb\xb6\x14\x1d\xbb\xfd\xb7:_=q\xd3\xdc\xd5\xeb\xf5]\xd3\xdc\xdf}\xddHr\xf8\xd6Lb\xe6X\x86t\xb9\xd0\x1e\xabQ|:\x04\x82^\x1d\x0fL\xc3\x1f\x88\x87T?<1\xf5\x7f,8\xd2\x82\x8b\x915\xf2\xc9HI]!`\xac\xcb\x1f\x86Mj\xbaE\xcb\xaa\x81\xf4p\x9fS\x17\xda\xe3g=?\x1dCA\xbf\xc5\x90h\xf8\x031\x94\xea\x87'\xa6\xfe\x8f\xc5PZ\xf014F>\x19C\xa9+\xc6\x90t\xf9\xc3\x18JM.\x86FM\x8e\xa1\xff\xf0\x89[\x1b\xfd\x8fC\xfb6\xbe\xe0\xba\xe1\x9b\xfd\x1a( \xb0\x0e\xce\xdd5$\xe4\xbb\x9f\xdc\xb7\x0b +\xdf5\x86\xaf0\x00\x00?\xc1\xe0\x9a\xdd\x82\x81\x08\xd7\x00\x80\xf0\xcd\x07\x84\x84&\x00A5\x9aw\xffyYS\x9f\xc6\xe1|\xfat\xfc\xe22T\xa2qR,@\x13AXsF\x81\xa6\x88\xc1\xea2\x12\x13\x11XG&!\xa2\x10+\xc6d\xba\xcfX\x8f_>\x86\xda0\n\x92\xbb\x81U`4(\x0f\x08\xeb\xbd8\x94+\xf3\x92\xa4?\xd0!P\xd0%\xf9\x03j\xb8HLv\x07Tk\x11\x90\x08\xc0\xba,\x11\x10eX\x81%\x91\x85/\xb0\xd6\x8aD\x80+\xa0\xaa\x8a\xc2\x80'\xa0~\x8a\x03\xc9\xb2)\x11\x86\x95R\x0c,\x0f\x1aj\xa2hT\x8a\"T?\x01L\xea\x0f\xd49A\x15b\xf4X\xd1\xc4\x80\xc0\x01P\xbb\xc4\xc2\xc0\x07P\xa5\xc4\xe1dq\x92\xe4\x03\xa8Gb`\xd9\x07PyD\xa3\x92A\xa81\x02\x98\xdc\x9f\\M\x04UH\x1f@\xdd\x10\x03\x02\x1f@\x85\x10\x0b\xc3y\x90k\x81\xc4\xeb&\x97\x00\x89(\xac\xfa\xa1Q\xc9$\xd6\xf7P\xa0\x04\x81J\x1e\x19\x92./\xa8\xd9\x01\n\xc4\xf0\xb1:\x87\xc6\xe4\xae`\x1d\x0e\x83\xca\xc3\xc2\x8a\x1b\x0e\x86\x856\xd2\xd8\xa1\xb6\x86\x02%{XECb\xf2\xd8r\xbd\x8c\x84H3\x0c*cd\xba\x187\xd6\xc0P\x10\x186T\xbb\xd0 \x185\xd4\xb5p(,g\x91\"\x05\x15,\x14(\x07|\x9fkUHL\xf2\x0bT\xa5H\x88\xe4\x12\xa8?\x91\xe9r\xd4PiBAr7\xb0\xa6\x84\x06\xc1\xa8\xa1z\x84C\xc9\xa2\x11iLP'\xc2\xc0r\xbc\xa1\"\x84F\xa5\x99\n\xb5\x1f\x00\x93\x86\x06U\x1eP\x85\x18?\xd6s0 \xf0\x00Tn\xb00\xf0\x01\xd4hp8Y\x9a!\x8d\x0e\xaa1\x18\xd8\x94gt\xae\xbb\xa0Q\xa9\xffPa\x010Q\x8c\xb5\x14P\x85\xf0\x01VM0\xa0\xdc\x1d\xac\x8f`ap\xc3\x83J\x08\x1e\x07\x05\x10\xd2\x05	5\x0f\x14(\xdf\xf0\xa1\xba\x81\xc4$wC\x1d\x83\x84H\xfd\x80\x8a\x05\x99\xae\x96\xbb\\\x9b@A\xf0F\x9f\xab\x10h\x10.u\xb9\xde@x\xe8\xf17\x08(t\xe8\x9f|<\xc1\xec \x91\x84\x9f{\x89S\x06\xb6\x93\x08\x15e\x0d\x05\x03\xf7\x96\x9c1I\x02n4)A\xc2q\xd7\xa9\xe1\x12\x89[P\xa3\x18?\xf8\x13g\x1a\xecG9^w\x1d7\xa7\x05\x86v\x0f\xeeT\x91\xe2\xeb\x12J\xe8\x0f\xe2x,G(\xfd\x0e{XJ\xd0n\x87\x0d-\xc3K4\xeen\x15Z\x02q\xab\xab\xd5\x12\x9f\xe3\xbe\x97\xc2\x8d\xcba\x13\xcc	\xc6\xe3\xb0#F\x86\xaa'(8\xb8=.q\xb4?a\xaf\\\xa0\xa8\xe9\x06\x1bgKPc\x80]4QN\x1c\x8b[\xea\x12\xc3\xf8\x16\xf6\xd7E\x8eq/l\xb6\x91\xa4\n\x02J\xf7\xc2\xce\xbb\xc4\xd1\xee\x85mx\x81\xa2\xfa\x05{rK\xd0c\xc8\x1bt\xa2\x9c\xb9\x17v\xeb%\x86q/l\xdd\x8b\x1c;{\xf3>\x1eI\xb2\xa2\x9f\xa0\xe0\xa6\xbe@Q=\xc3\x1d>g(<l\xf7\x0d^Bq\xefoU\x13\xcf\xe2\x83@\x81\xa0\xbb\x8fO\x05%\x8av\x12>\" G\x94\xe4\x93n\x85\xe7\x05\xceP\xdd\"\xd5\xff%A{\xca\x14\xfdOp\x89$\xb5\xfe\xb3b\xe2RR\xe2_\xe1\x8dGme\x7f\xcd0\x0e\xb5\x05\xfd\x1d\x85\x94\xf3\x8f\xb3\xc8\xd6\xf1W\x0c=M\xf7\xa6|\xbf$(\xff\xdb\xaa\xfd	\xae\\o\x8b\xf5g\xc5\xcc\xa1\xb6F\xbf\xc2\xeb\xae\x93\xd2\xfc\x9aa\x1cj+\xf2;\n\xad\xc7\x1f=d\x0b\xf1\x1b\x8e\x9e\xa5\xb6\xfe\xbe\xa6H\x02)\xbb\x0f\x04\xe5([m\x1f\x95\x13\xd7\x92\"\xfb\x86a\x9ckk\xeb[\x8eq\xaf-\xa9\xefH\xb4\xa0~\xf4\x95\xad\xa4o8\xaao\xa4\x80\xbe\xa6(\x07\xd8\xba\xf9@\x90XR.\x1f\x95\x13\xf7\x92*\xf9\x86\xa1\x87@\x8a\xe3[\x8eY\xb7lM|O\xb2\x15\xf1\xe3]\xcd\x96\xc2W\x0c\xbd%\xb0\x15\xf0%A\x05\xdc\x16\xbeOp\xd5w[\xef>+\xa6{-S\xe6^\xe1\xedV\xc0T\xb7\xd7\x0c\xbb\xcf2E\xed\xfd-\xd9\x94\xb4\xf7\x84R-\xfbp\xdb\xc9_\x8e\x8cs\xdb\x96\xb0wPR\xc0>Nm[\xb9^1&I \x05\xeb%A\xc2I\x9d\xfa\x04\x97HR\x9e>+\xf6\x01\x13xR\x95^\xe1u\xd7I1z\xcd\xd0\xee!5\xe8\x1d\x05+\xd0\xa7\xd8\x12\xc7\xdb\xc2\xf3\xd1\xef\xb6\xe2\xbc$h\xb7\xdbB\xf3\x02/\xd1\xa4\xbe|DK )+\x9f\xd4\x12\x9f\x93j\xf2\x12n\\n\x8b\xc8+\x82\xf1\xb8\xad\x1d\xef\x18\xb4r|\xbc\xeb\xd8\x92\xf1\x86\xa3\xfdi+\xc5k\x8a\x9an\xb6@<\x10\xd4\x18l]xTN\x1cK\xca\xc1\x1b\x86\xf1\xad\xad\x02o9\xc6\xbd\xb6\xf8\xbb#\xd1\xd2\xef\xe9\xa6nj\xbe\x1b\x8ev\xaf-\xf5\xae)\xaa_\xb6\xc2;\x10\xf4\x18LawT\xce\xdck\xeb\xb9\x1b\x86q\xaf-\xe3n9v\xf6\x9a\xea\xed\xf1\x16cj\xb7\x07\n)\xda\xae)\xaag\xa4V\xbbb(\xbc-\xd1\x9e\xf1\x12J*\xb3\x83j\xe2YR\x90]\x13t\xf7I\x1dvC\xd1N\"\xe5\xd7\x1d\x87\x14_\x8fn\xb5U\xd7\x15Cu\x8b\x14[\x97\x04\xed)Sc=\xc1%\x92\x94V\xcf\x8a\x89KIEu\x857\x1e\xb5\x85\xd45\xc38\xd4\xd6Ow\x14R==\xce\"[6]1\xf44\xdd\x9bj\xe9\x92\xa0\xfco\x8b\xa4'\xb8r\xbd\xad\x8d\x9e\x153\x87\xda\x92\xe8\n\xaf\xbbN*\xa1k\x86q\xa8-\x80\xee(\xb4\xfcy\xf4\x90\xad{n8z\x96\xdar\xe7\x9a\"	\xa4\xca9\x10\x94\xa3lqsTN\\Kj\x9a\x1b\x86q\xae-en9\xc6\xbd\xb6\x82\xb9#\xd1\xfa\xe5\xd1W\xb6p\xb9\xe1\xa8\xbe\x91z\xe5\x9a\xa2\x1c`\xcb\x94\x03AbIurTN\xdcK\x8a\x92\x1b\x86\x1e\x02\xa9En9f\xdd\xb2%\xc8=\xc9\x16 \x8fw5[y\\1\xf4\x96\xc0\x16\x1c\x97\x04\x15p[g<\xc1U\xdfmy\xf1\xac\x98\xee\xb5LUq\x85\xb7[\x01SL\\3\xec>+?\xe99\xec5|\xde\xdf\xfd\xc1/&\xd88\xe9\xe5\x88\xd2\x9d\x16?\xb6\x97\x0e\x00\xb5\x94\x0eO(\x13UC\xbd\xe9A\xc8\xb5\xb8Qr%^)\xb1\xe9\xdcZ\x00\x1a\xa3\x7f#\xe49\x13\xa3\x9f\xdb\xa1\xdf\x07\x91\xab\xfd\xe8[\xbct\x1a\x8f\xed	\x85\xfe\x03\xf9^\xf8r>w\xd3\xfe\xe6\x14g\xc1=&\xff\x18\xa7c\x16x\xdc0\xber\\\x12x\xdc\xbe=;\xdb\xbf\x0c0K<\xd2\xf7\xa2b:\xf3\xdf1\xbe\x9c\x0e\x9d/\x0b\xe3 \x87n?\xfa\xa22Y\x12\xfa\xd7\x9f\xbaj~\x9e\xc6\x97\xa7g\x83Ea4^\x95Ug\xf3\xae\xd6\xce\xd0\xb7\x97\xee\x90\x92\xd7\xbd#\x8f\xe38?\xbb\xc4\xf5\x0c\xf9^\x1d\xc7_\xd5x\xb9j\xcc\xd3\xd4\xbe]\xf6m\xcc\xce\xbf\xbc<\x9e\xfbk7T\x9fQ\xff2\x8fE\xbd\xf9\xaf9\xc3\xa9\x89\x0f{8))L\x87\x00u\xb3\xc2\"qn\x04d\x9a	\x01\xbd<Q\x02)M\x0bNR\xb3&\x90\xf2\x14\xe1,=\x85\x02\x0df\x12\xe7\x99\xbfOO\xb1G|yr\xc51\xc14*\x10\xedLK}\xac>2jz	\xd3#P\xfe~s0\x98`S\xf1\x03[\x9f\x9b\x90\xe1\xe6\x1e&\xa4\xbf\xa1\x17&d\x80\xfa	i\x908!\x032OH\x8f^\x9e\x90\x81\x94'$%\xa9	\x19H0!)KO\xc8@\xc3	Iy\xe6\x8f\xed\xf3\xdc\x00|yB\xc61\xe1\x84\xe4D;!S\x1f\xab\x8f\x8c\x9a^\xe2$\xf1\x94\xbf\xdf\x84\x0c&\xe8\x84\\\xb6\xf5\xb9	9O\xed\xfe\xf7>\x97\x90\xe9\xe6\xb9\x9b\xaa\xcb\xb9\xdd\xdfp\xd5\xd7\xfbMZj#2\x16\x92\x91\xd0{\x85z\xed\x0f\x9d\xc6\x80\xb6K7t\xfbP\xbb.\x8e\xe0\xe5r\xc3:\x81s\xb2\xef>i\xbd\xd8F\xdd \xac\xdc\"H\xad\xdc\x04\xd6Jh\xbd\xd8F\xdd\x10\x96CW\xbc0\xd5\xd7\x8c\x89Z\xe1O\xf6e\x89M\x8f\x9d\xc7\xb3\x86\xcd\xe3\x19\x11\xc7\xfep\x18\x8c.\xdf*\xac\xba\x143c\xd3\xb5\n\x8b\xeeJ#fC\xbb\xc1r\xc5 \xf2\x8c\xf8\xc9\x1d\xf7o?\xf4\xb3\xfa\xe4N\x7f\"\x08\xfc\xd2\x8f;\xe8\xf6\x05\x1f\xc3\xc4r-n\xce\xc8\xdb\x9c@\xfa\x02\xab\x12\x9aK\xac\x02\xf4<u\x02v\x9e:\x86\xa9\\\xe8\x14\xb0\xcaqShc=6z\xf4\xe3\xd4\xb5\xbfW\xaf\xe3t\xb8\xbc\xdf\xfe\xdf\x17\x81\xcd\xad\x88\x8a\xa3N0\x1c\xf3<\xbd\x9c\xf6\xed\xdc\xe9\xafA}w\x81H\x8d\xdd0\xf4\xe7K\x7f\xf9^tHU\xbf\xfbo-\xe3\xee\xf9\xb5jb+6\xaeb\xe3\x03\xb6\xaeCk\x0dm\x9b\xd8&\xb5nS3\xb6\xeeBk\x03m\xf5}l\x14\xd0:\xf6k\x85\x8dQ\xed\x1a\x07\x10\xbb\xb5\xc5\x01D\xfa\x0e\xfb\x1f\xed\xd7\xd8\x81m\x1a\x16*p\x19B\xbe=\xdf*_\xab\xf3\xf5]U\xae~\xad\xea\xff\xf8\xc7hm\x13\xbfb\xedZ\xa3\x17W\xab\xaf\xab\xdb\xff\x92\xac\xc9\xb2\xed\xf6\xebv\xbb\xdd> /v\xa8\xd9\xa4\xd6Un}\xd8 6\xfa\xbf\xb9G\xed\xb1u}\x8f\x1ab\xeb6\xb7\xaes\xebN\xf4=\x05P\xf7o\x93e;=.\xf7Qo\xfdA\xef\xd7\xf8\x9d\xf0$\xf8\x19\xca?\xff\xaa\xee\xdf\x7fU\xfd\xe9\xd0]\xe3\xe2\xf1\xeb6\x1fb[\x9d\x1a\x9b\xdc\xd8\xa4\xc6Un\\\xa5\xc6un\\\xa7\xc6Mn\xdc\xa4F\x17\xe1\xd8\xecb\xfc_\xff\xfd\xd8\x1d\xfa\xf6\xcb\x9f\xf2w\xc9\x7f\xfb\xad9_\xff\xfc\xfeux\xbam*.s5u\x97n~w?\xc6m\xd8\xa9\x8b\x1f*\xb9\x8d\xe2\x86l\xcf\xe7\xae\x9d\xda\xd3\xbe\x93\xcb[n\x87\xd5M7\xaa\xdf\xbd\xc6\xc7\xa7\x90\x91\xf6\xd8\xee\x7f\x7freq\xabv\x9e\xdb\xfd\xf3\xd1\xbd\xd0\xf5\xa9i\x019\x8c\xfbv( \x9d,!\xc3\xa7\xcd84~\xe5,`1\xcd\x0c\x08\xfe0B\xbcE\x0e\x04\x9fof\xa0\xe9\x0dr\x80\x89\xc43\x8b\x8eo\x8f\x0dz\"\xe0\xf8\xe6X\x83	4\xbe5\x06(A\xc57\xc6\xa8\xd0\x9d\xc6\x10l|[l\xb0\xb4\xab\xf1M\xb1ES7\xc4\xb7\xc4\x01\xee\xf3\xd6\x08\xecGv,&\xb0\x11\xbf\x86\xb7\xc3\x1aL\xdd\x1a\xde\x0c+,A\xc6\xb7\xc2\x19I@\xf1\x8d0\xa8+\xf94\xbe\x0d\xd6P\xee\xd2\xf0&\xd8\x80\xb9G\xc3[\xe0\x80V\xf9o\x16\x1f\xdf\x00\x13<\xf5Yx\xfbk\xe1l\xea\x847\xbf\x02\xcc\xfa\x1c\xde\xfaJ\xa5%\xe7\xc57\xbe\x04\xcd\xfd\x17\xde\xf62<wax\xd3\x1b\x08*}\x8e\xb80\xbc\xe5%x\xea\xc2\xf0\x86\xd7\xc2Y_\xc2\xdb]\x01\xa6}\xf6ov\xa5\xd2\xa2\x0b\xc3[]\x82\xe6.\x0cot\x19\xbe0\x0b\xfd\xdb\xdc|\x1b\x80\xec;\x0b\x8for-\x9c\xf5&\xbe\xc55h\x86\x0dop\x11\xcbn\x1b\xe1\xed\xadPY\xf2^|sk\xc1\xb4\xbb\xf1\xad-\x81Sg\xc47\xb6\x01/\x92\xf7\x88\xeb\xc2\xdbZ\x83f]\x89oj5\x98z\xc4\xbf\xa5\x05(\x9b\xfa\xe1\x0d-*,\xb9-\xbe\x9d5X\xee\xb5\xf0f\xd6\xa2\xb9\xd3\xc2[\xd9\x00\x17\xb9\x7fdV\x847\xb2\x06M\xa7[x\x1b\xab\xc1\xcc\xbf\xe1M,@\x99k\xc3[XTXtZx\x03k\xb0\xb4\xab\xf1\xed\xabEs\xa7\x857\xaf\x01\xaeR\x07\x89'\xc2[W\x82\xa7\xb3-\xbcq\xb5pvQ\x85\xb7\xad\x02\xcc\x1c\x12\xde\xb4J\xa5%\xf7\xc5\xb7\xac\x04\xcd\x1d\x18\xde\xb02<wax\xbb\x1a\x08*\xf3\x90\xf8$\xbcY%x\xd6\x9f\xf8V\xd5\xc2\xd9`\xc3\x1bU\x01&\xb8\xf86U*-\xb90\xbeI%h\xda\xe5\xf8\x16\x95\xe1\xf9Z\x11\xde\xa0F\x02&.\x92;Nx{j\xd0t\xa9\x0doN5\x98\x052\xbc5\x05(\xebkxc\x8a\n\xcb\xfb\x14\xff\xb6\xd4`\x0bK\xac\x7fSj\xd1\x85=\x8a\x7fKz\x83\x87\xb3}\xf90\x13N\xf5\x0d\xd3<\xd2d6&@\x92\x99\x0b\xcf7\x99C2!\xc9,\x86\x87\x1dF\x9d\x8aL|\xf2!\xcc\"\x0f\x1f\x83$\xafH\xc1g\"e\xca\xc5\xa1H\xc4\x07$F\\\x18\x1e>-Q\xea\x82S\xf1\xd1)s1\x91\x92\xcd\x1a\x13@\x9bQI\xe2\x07\x0fU\x84\xb9\x10>x\xc2\xb2\xc4\"\x0d\x1f\xb7\x04\xad\xc8\xc0g/ih9v\xf8 FxK\xa1\x83\xa72\xc6\\\x8a\x1c<\xa2e*M\xc8$\xf7?x^\xe3\xe4\x85p\xc0\xc3\x1b\xe5\x96\xe78<\xc9ify\x9c\xf0Xg\xcc-\xc7\x05\x9f\xf18u)4\xf0\xc0W /E\x07\x9e\xfe2\x9b\xe6s\xb2\xd5)?\nr\xf2Bt\xe0\xb9\x90r\xcb]\x86\x87D\xcd\\\x18g~b4\xe6>\x88\x0e<>r\xeaRt\xe0Y\xb2@^\xbcv\xf2\x83ef\xb3tP\xcb\xc5\xa7L\xca-w\x1a\x1f9\x19\xb5L\x84\xe7OE,r\xf0aT\x1b[\x0e\x0c>\x99R\xe6\xc2\x10\xf11\x95s\x17\\\x8b\xcf\xac\x99L\xb2IIT\xe0\x01\x96Q\xcb=\xc6\xa7Y\xc2\\\xf0o~\xb4\x95\xbc\"\x05\x9fs\x95\xa9\xe5\x88\xe0C/#.\x05\x04\x9e\x80)u)\x1e\xf08\x9c\xb9$\x19\x95\xccXx6f\xd4\x85\x8b\x04\x1e\x94	\xb3\x1cGxj\x96\xbcr\x08\xe1\x11Z\x99\xfa \x1e\xf0<\xcd\x88\x0b\xc3\xc3\x87kJ]\x8a\x07<ig.\xcde%~\x85\xc7nN^\xb8F\xe0\x19\x9cr\x8bL| \xd7\xcc\xb2{\xe1\xe9\xdc\x98[\x8e\x0c>\xaas\xeaRl\xe0\xb9\xbd@^\x8a\x0e<\xc4g6M\x85%\x1e\x86'zN.w\x1b\x1f\xef)\xb7\xec-x\xd6\xd7\xcc\"	\x1f\xfc\x8d\xb9\xe5\xe8\xe0)\x00\xa7.\x0c\x13\x8f\x04\n\xe4\xa5%\x1f\xce\x07\x80m3i\xc9m\x1b\x0e\x0b\x18ua3\x06'\x07\x84Y\x9eJp\x8c y\xe5\xf1\xc1\x99\x822\xf5\xd1&9\x1f00\xe2\xe2&,\x9f6P\xea\xe2\x069\x1f=\x84\xbf\xe2RG\x0f\xfe\xef\xb7\x0c\xd3\x1c=d6f\xe4\x92K\x0c\x8e\x1e2\x87\xa4\xe6\x92+\x0c\x8e\x1e\x18u*2\xf1\xe8\x810\x8b<<z\x90\xbc\"\x05\x8f\x1e\x94)?\x03JD<z`\xc4\x85\xe1\xe1\xd1\x03\xa5.8\x15\x8f\x1e2\x173{\xd9\xac1\x01\xb4)\xbe$~p\xf4@\x98\x0b\xe1\x83\xa3\x07K,\xd2\xf0\xe8A\xd0\x8a\x0c<z\x90\x86\x96c\x87G\x0f\x84\xb7\x14:8z`\xcc\xa5\xc8\xc1\xd1C\xa6\xd2\x0car7\x85\xa3\x07N^\x08\x07\x1c=Pny\x8e\xc3\xd1\x83f\x96\xc7	G\x0f\xc6\xdcr\\\xf0\xe8\x81S\x97B\x03G\x0f\x05\xf2Rt\xe0\xe8!\xb3i\x821[\xeb\xf2\xd1\x03'/D\x07\x8e\x1e(\xb7\xdce8z\xd0\xcc\x85q\xe6\xa3\x07c\xee\x83\xe8\xc0\xd1\x03\xa7.E\x07\x8e\x1e\n\xe4\xc5k'\x1f=d6\xcbO\xb6\\<z\xa0\xdcr\xa7\xf1\xe8\x81Q\xcbD8zP\xc4\"\x07\x8f\x1e\xb4\xb1\xe5\xc0\xe0\xd1\x03e.\x0c\x11\x8f\x1e8w\xc1\xb5x\xf4\x90\xc9$\xbd\x99D\x05\x8e\x1e\x18\xb5\xdcc<z \xcc\x05\xff\xe6\xa3\x07\xc9+R\xf0\xe8A\x99Z\x8e\x08\x1e=0\xe2R@\xe0\xe8\x81R\x97\xe2\x01G\x0f\x99K\xb2\xa3\xc9\x8c\x85\xa3\x07F]\xb8H\xe0\xe8\x810\xcbq\x84\xa3\x07\xc9+\x87\x10\x8e\x1e\x94\xa9\x0f\xe2\x01G\x0f\x8c\xb80<<z\xa0\xd4\xa5x\xc0\xd1C\xe6\xd2\xe4j\xe2W8z\xe0\xe4\x85k\x04\x8e\x1e(\xb7\xc8\xc4\xa3\x07\xcd,\xbb\x17\x8e\x1e\x8c\xb9\xe5\xc8\xe0\xd1\x03\xa7.\xc5\x06\x8e\x1e\n\xe4\xa5\xe8\xc0\xd1Cf\xd3\xdcl\xe2a8z\xe0\xe4r\xb7\xf1\xe8\x81r\xcb\xde\x82\xa3\x07\xcd,\x92\xf0\xe8\xc1\x98[\x8e\x0e\x1e=p\xea\xc20\xf1\xe8\xa1@^Z\xf2\xe1\xe8\x01\xd86\xb5\x9b\xdc\xb6\xe1\xe8\x81Q\x176cp\xf4@\x98\xe5\xa9\x04G\x0f\x92W\x1e\x1f\x1c=(S\x1fm\x92\xf3\xd1\x03#.n\xc2\xf2\xd1\x03\xa5.n\x90\xf3\xd1\xc3c\xac9\x86\xb8Tj+\xa6\xf2\x04d\xa8Z\xc2\x90\xb1|I@\xba\xf2'\x0c\xe7\xeb\xa0\x00j\xc9\xfcM\xfeE\xf5\xc1q\xe6\xf1\\&\xb8t\xa5\x80\xf6ET\x184TSA\xdcRW\x1c@\xf7\xc5\xb3J\x9d\xf1\x14\xecM	)z\xdc\x9d\xbbVt\xd9\xb7|\xf3\xff$\xdci,C\x93Li\xad\xaeE\xbd\xd5Uc\xdf\xca\xd8\xb7\x84uy\x12\x80s)\xf6>'&\xce\x19}\xfe\xe5 \xae\x150\xa7\xb9\xedO\x04\xe5\xda\x03n\x9c\x0e\xdd$3\x0d|\x93\x9f\xd66\xcb\xc0KC\xa6\x01Be\x96\x81\x97\xc8L\x03\x81\x16Y\x06\x1a=)\xb0\xc82P`\x05\x15Y\x06\x19\xaaP\"\xcb\x00\x14\x0e~j\x0b\xac\xc82\xd0X\xd3U\x91e`\xd0\xc6\x0d\"\xcb\xc0KB\xa6\x81\x84\xfd\x90\x8e\x15\x99\x06\xd2\xaf\x98e\xa0\xc0\xc6\xad\x98e \xb1\n)\xb2\x0c\x12R\x81D\x96AV\xc7|*\xb2\x0c\x14\xd4\xba\x14\xb3\x0c4\xd8z\x14\xb3\x0c\xbcDg\x1a\x08\xbc\xc82\xb0x\xe33\xcc20p=u0\xcb\x00\xc1\xba\xcf\x98e \x942\xe7\x89,\x03\x8b\xb6\xfe\xc3,\x03\x82\xb7.\xc4,\x03/\xd1\x99\x06\xd2\x85\x98e`\xf1\xc6\x85\x98e`\xe0\xba/\x98e\x80`\xd3g\xc82\x10J\xa9\x0b1\xcb\xc0\xa2\xad\x0b1\xcb\x80\xe0\xc9,\x84,\x03/Q\x99\x06\x02.\xb2\x0c\x0c\\\xf7Fd\x19h\xb4\xc6b\x96\x01`\xf5m\x03\xb3\x0cP%\xf3\x9e\xc820`\xd3]\x91e`\xe1\xc6\x19\"\xcb\xc0Kd\xa6\x81t\x1df\x19h\xb4\xee\x8a\xc82P`\xe3\x11\xc82\xc8P=\xf51\xcb\x00\x142\xb7\x89,\x03\x8d\xb5^\xc3,\x03\x83\xb6N\xc3,\x03/\x91\x99\x06rV`\x96\x81F\x9b\xe9\x86Y\x06\n\xac\xfd\x8bY\x06\x19\xaa]\x8bY\x06\xa0\x90:\x0d\xb3\x0c4\xd6tUd\x19\x18\xb4u\x1af\x19x\x89\xce4\x90\x9e\xc0,\x03\x8b7\xb3\x0d\xb3\x0c\x0c\\_T\x98e\x80`\xed\x10\xcc2\x10J\x99\xfbD\x96\x81E[\x07b\x96\x01\xc1[\x17b\x96\x81\x97\xe8L\x03\xe9\x13\xcc2\xb0x\xdd\x1f\x91e`\xe0z\xb0\x98e\x80`\x85\x13Y\x06B)s\xa1\xc82\xb0h\xd3e\x91e@\xf0v\xad\xc0,\x83@\x10\x99\x06\x02-\xb2\x0c4\xda,\xb5\x98e\xa0\xc0:\x90\x98e\x90\xa1\xba\xaf\x98e\x00\n\xf9>\x05\xb2\x0c4\x96,\xb1\x90e`\xd0d\x8fb\xb3\x0c<\x80d\x1a \xb3\x98e\xe0A\"\xd3@\xce\\\x92e\xe0\x01,\xd3@\xceb\x92e\xa0\xa9\x13e\xb2,\x03\xc5\xa4<\x96e\x90y\x94\xc2\xb2\x0c\xc0\x94\x8b\x03%\xb2,\x03M,\x0c\x8fe\x19\x18j\xc1\xa9,\xcb\xc0\x03D\xa6\x81\x9a54\x80$\xd3@\xc6\x8fd\x19(f!|$\xcb@\x12)\x8de\x19$\x1ae\xb0,\x83l\xa8\x1c;\x96e\xa0x\xa5\xd0\x91,\x03\xcd,E\x8ed\x19x\x00\xcf4\x10d\x96e`\xc9\x85p\x90,\x03\xc3\xe5s\x9cd\x19 \x93\x8f\x93d\x19\x08s\xe5\xb8\xb0,\x03K-\x85\x86d\x19\x10r):$\xcb\xc0\x03x\xa6\x81\x8c\x0e\xc92\xb0\xe4BtH\x96\x81\xe1\xf2.\x93,\x03d\x16\xc6i\xb3\x0c\x84\xb9\x85\xe8\x90,\x03K-E\x87d\x19\x10r\xf1\xda\xb1Y\x06\x1e@3\x0d\x04\x97e\x19\x18.\xef4\xcb2\xd0TN$Y\x06@\xa4\x1c\x96e\x80\xc6\xca\x81aY\x06\x86Y\x18\"\xcb2\xb0\xdc\x82kY\x96\x81\x07\xb0L\x03\x19\x15\x92e\xa0\xa9\xbc\xc7,\xcb@1\x0b\xfe\xb5Y\x06\x99G),\xcb\x00L\x95#\xc2\xb2\x0c4\xb1\x14\x10\x92e`\xa8\xa5x\x90,\x03\x0f`\x99\x06r\xc6\x92,\x03M-\\$$\xcb@1y\x1cI\x96A\xe6\xf1\x10\x92,\x030\xb5\x10\x0f\x92e\xa0\x89\x85\xe1\xb1,\x03C-\xc5\x83d\x19x\x00\xcf4\x90~%Y\x06\x96\\\xb8FH\x96\x81\xe1R&\xcb2@&w/\xc92\x10\xe6\xca\x91aY\x06\x96Z\x8a\x0d\xc92 \xe4RtH\x96\x81\x07\xf0L\x03\xe9a\x92e`\xc9\xbc\xdb,\xcb\xc0p\xb9\xb7H\x96\x012)\x89e\x19\x08s\xe5\xe8\xb0,\x03K-\x0c\x93e\x19\x10ri\xc9'Y\x06\x81M2\x0d\x04\x95e\x19hja3F\xb2\x0c\x14\x93O%\x92e\x90y||$\xcb\x00L-m\x92m\x96\x81&\x167a6\xcb\xc0P\x8b\x1bd\x9be\xe0\x01$\xd3\x00\x99\xc5,\x03\x0f\x12\x99\x06\xf2\x12#Y\x06\x1e\xc02\x0d\xe4\x15F\xb2\x0c4u\xa2L\x96e\xa0\x98\x94\xc7\xb2\x0c2\x8fRX\x96\x01\x98\xf23\x80\x11Y\x96\x81&\x16\x86\xc7\xb2\x0c\x0c\xb5\xe0T\x96e\xe0\x01\"\xd3@\xcd\x1a\x1a@\x92i \xe3G\xb2\x0c\x14\xb3\x10>\x92e \x89\x94\xc6\xb2\x0c\x12\x8d2X\x96A6T\x8e\x1d\xcb2P\xbcR\xe8H\x96\x81f\x96\"G\xb2\x0c<\x80g\x1a\x082\xcb2\xb0\xe4B8H\x96\x81\xe1\xf29N\xb2\x0c\x90\xc9\xc7I\xb2\x0c\x84\xb9r\\X\x96\x81\xa5\x96BC\xb2\x0c\x08\xb9\x14\x1d\x92e\xe0\x01<\xd3@F\x87d\x19Xr!:$\xcb\xc0py\x97I\x96\x012\x0b\xe3\xb4Y\x06\xc2\xdcBtH\x96\x81\xa5\x96\xa2C\xb2\x0c\x08\xb9x\xed\xd8,\x03\x0f\xa0\x99\x06\x82\xcb\xb2\x0c\x0c\x97w\x9ae\x19h*'\x92,\x03 R\x0e\xcb2@c\xe5\xc0\xb0,\x03\xc3,\x0c\x91e\x19Xn\xc1\xb5,\xcb\xc0\x03X\xa6\x81\x8c\n\xc92\xd0T\xdec\x96e\xa0\x98\x05\xff\xda,\x83\xcc\xa3\x14\x96e\x00\xa6\xca\x11aY\x06\x9aX\n\x08\xc920\xd4R<H\x96\x81\x07\xb0L\x039cI\x96\x81\xa6\x16.\x12\x92e\xa0\x98<\x8e$\xcb \xf3x\x08I\x96\x01\x98Z\x88\x07\xc92\xd0\xc4\xc2\xf0X\x96\x81\xa1\x96\xe2A\xb2\x0c<\x80g\x1aH\xbf\x92,\x03K.\\#$\xcb\xc0p)\x93e\x19 \x93\xbb\x97d\x19\x08s\xe5\xc8\xb0,\x03K-\xc5\x86d\x19\x10r):$\xcb\xc0\x03x\xa6\x81\xf40\xc92\xb0d\xdem\x96e`\xb8\xdc[$\xcb\x00\x99\x94\xc4\xb2\x0c\x84\xb9rtX\x96\x81\xa5\x16\x86\xc9\xb2\x0c\x08\xb9\xb4\xe4\x93,\x83\xc0&\x99\x06\x82\xca\xb2\x0c4\xb5\xb0\x19#Y\x06\x8a\xc9\xa7\x12\xc92\xc8<>>\x92e\x00\xa6\x966\xc96\xcb@\x13\x8b\x9b0\x9be`\xa8\xc5\x0dr>zp\x7f\xd3\xdd\x1d\xfc\x07\xef\x03lj\x0f\xfd\xcb%~\x15?\".G%O\xe5\xe4\x00\xa5!\x16Q\x0dO\x1ad1\xae\"\x81D\xfd\xf6\xdbo\xbf\x9d\xaf\x126\x8bn\xcf\xe3\xd9\xe7 \xc4\xfe\x7f\x07\x81O\x08\xe0#\x9b\x8c\x1a\x89\x8ez|\x8e\xc1\xa2\xaaG\xa1\x8a\x12\x946\xd1c\xe5\xa9\xcf\x0d\xefcE3\x04O\xab	Q,\xfa\x8aE\xb9\x9a\x94B\xc6X\xf2\x1aU\xfa\x08J\x17H\x0b\xc3\xa6j\x87\xcf\x0f\xfe\xb3*\xe7\xa2\xbe\x0f|\xc9\\Y\xf6\xe3\xc7nd^\\t\xe1\x87\xc3e\x0e\xfc\xdch?\xa9m\x86\x1b\x80Q\xb8\xec=6\x0f\xa52\x8b_t\x1f\x9b\x83Ya\x91\xb24b6\xff>9\xe0\xcf\xa9\x9b\xc5\xcdQ+\xf4w\xc9\xa2\x0b\xd9Mt2\n	e\xc9\x8dL\xe9\xa3PZf-\x8c\x9d\xa9\x1d\xfe?\x06\xffI\x95\xf3\x07wY\x85\xfeh\xadPn\x98>^\x0f\x14c`\x8c\xa5\x1e}\xe2\xfef\xc6\xf0\xf1\xdd\xdb\x8c\xe3S\xf7f3\x16\xc3\xfa\xb8w\x1f\xdcn\xf4X>\xb8}\xeaq||w\xd4cX\x1a\x00\xed\xffG\xd7\xbb\x89\xc6G\xf70\x13\x8bO\xdc\xa3L$\x0c\xe7\xa3~}\xe6Z3C\xf9\xc4\xad\xc4\x8c\xe6sw\n3 B+u0\xa0.\xe3\xd0\xa7\x1d\xaa/\x03\xe5\x9a\x04\xe8\xd0^\x9e;\x85\xf2m\x126\xce\xb3\x81\xb96\x01\xc3k9\x17\x9e\x12\x90\xfb(\xf7\xa5\xab\xee\x85\xb0\x91\xc2F\x8dh-\xc5k%\xdeI\xf1N\x8a\xa5\xb0V\xdc9w\xec\x16H\xd69\xe8\xbb\x0f\x18\x03=fP\x88\x12C\x0d\x19\xe5\x82\xc80s\xf6F\xee\x91\xf6\x08\xb8\x0c\xfb\xa4a\x8f\x19&z\xa5qC\xc6A\xbf4j\xce\x81\xc8=\xd3\xc1\x80ha\xcf4\xec1\xc3D\xcf4n\xc88\xe8\x99F\xcdy\x0e\xe4\x9e\xedL\xcfv\xacg\x1a\xf6\x98a\xa2g\x1a7d\x1c\xf4L\xa3f\xdb/=\x05'\xd6+\x0d\xd2\xfb]\x8e\x1aH\x8f\x12f\xff2]\xc6\xc9gL\xfb\x9f!Q:\x08\x0f\xdd\x8f\xf6e\x98\xa3<\xfc* \xe7\xb1wI\xf8\x01\x12~\x15\x90\xd7\xb6O*n?\x0b\xe1q\xfc\xd9E\xe1\xedg!<\x8ds\xd5\x0e\xc3\xf8\xda\x1d\"\x06\x9a\xc2X\x87q\xff\xfb\xfb\xa1\xbf\x9c\x87\xf6\xcd\xff\xe6\x05\xfd\xc9\x95\xcd\x95rlD\x98\x02x\xd1\xdc>\x0eY\xe2~\x03A5\x8d\xafRxkA\xc0\xbe\x1b\x06\x85\xb85y\x88\xaf\x8c\x99\xc4\xf9\x0e\xf9c\xe8\xae\xa9\xf9\xf6\x8b\x18\x8e\x90B[\xe6\xba~\xb9\x1f\x0e\xfd\xd4\xed}\xee\x7f\xecX\x04TS\xf7\xb3\x9b.\x1d\x01F\x11\x10\xf6\xe3\xa0\x81\xfbqx9\x9e$\xa6\xa4\xd4c\x89^W\x974\xfd\xf4\xcd\x17\x00\x15R\xa92\xa1\x88\xaeXd5\x03cEQ\xe7\xbd\xb9;^\xaa\xcb\xdcN\xf3\xbb/\x1b\xebZ<\xd55#\xae;\x1d,\xaa;\x1d\x10\x13>>\x810\xfc\xe8\x84\x07\xa5*\xbb\x08\xcb%v\xb1gS7\xef\x9f\x05.\xb4y\xd8\xa5\x1b~\xf8\x8b\xd5Cn\xbf\xc3\x05\xeb\xc48<'\xd7\xa3s\xa8<\xb8\x8cIcs\x0814\x07\xc2\x91\x05S\xd8_\x87\x11\xdd\xfd\xcf\x97\xcb\xdc\xffx\x0b]\x8a\xbf\xed\xc7\xd3\xec\xfe\xc0G\xf5+\xcao]\xa3\xd8\xd4\xbf$\xf5]\xd4`\xecg\x94=v\xf3k\xd7\x9d\x0c\xd6\x97\xc7\x0dRIi\xdd\x8e\xab\xc0\xf0\xc2p\x9f\xf2\x12\xe91\xd6\x99\x08\xc4\x10\x95\xbc\x11\xb19P\xdc\x17\x11\x17\x07(\xb1dx\x91\x10\x86\xc7\xf088g\xadv\x17\xd4\xb7\x1a\x9a\xdc,t\xady\xfe9A\x7f\xea\xe7\xbe\xf5\xb7\x8ao\xe1\x17q\x85\x9e\xfcu\xacnv\xd5S\xbac\xdd~\x12\xb6.\xcfS\x7f\xfa\xfd\x1d~\x16\xe2\xd3\xa8\xc9\xf7RJ\xf8	1\xb6\xb3_d\xdf\xdd\xcf\xf8	\x16/\xbc-\x9bA6\xa4\xef\xc4xQ\x18\xcb\xd8\xce0\x98\xfd\xd0\xb5\xd3\x8f\xfe\xfa\xad\xfd\xe1\x96\xc4\xe0\xd9\x7f\xf8\x87\xefb\x11\xf8\xeep\xdf\x1e\xc79\\,\xbe\xdcy{\n%\xf3\x7f\xb4\xc7~x\xfbvy\xbb\xcc\xdd\xb1z\xe9\xef\xfe2\xf4\xa7\xdf\xff\xda\xee\xff\xe6Z\xfee<\xcdwU{>\x0f]\xe51w\x7f\xeb\x9e\xc6\xee\xcb\xbf\xfd\xaf\xbb\xff;>\x8e\xf3x\xf7\x7f\xaeoO\xdd\xe9\xee\xdf\x1e_N\xf3\xcb\xdd?\xb5\xa7\xb9\x9d\xbaa\xb8\xfb\x97~j\xbf\xfc\xad=]\xee\xfey\x1a\xfb\x83\xff\xf1\x7fv\xc3\xcfn\xee\xf7\xed\x97\x7f\xed^\xba\xbb[?\xaaK7\xf5?\xb0s\xb7\xdfE\xef\xfei<]fW\xde\xfd\xee\x7f\xbf\xec\xfbC\xfb\xe5/\xce\x7f\xe1\xb7G\xfc\xe5\xcb\xdfn\xf4\xf0\xcb\xdd?w\xff\xd9\xfe\xfbKh\xfbK?\xdf\xee\x1b\xed\xf1\xcb\xbfwS\x02\xf6\x8f\x9d\xaf^\x1f\x1a\xfeG7NO}{\xa7\xbbu\x1cO\xa3\xe8\xd5_\xbb\xd30\xde\xfdu<\xb5\xfb\xf1\xee\xd6\xc5qh/\xa8\xef\xaf\xe3\xe9&x\x99\xfan\xfa\xf2\xaf\xdd\xeb\xddM\x85\x9b\xfa\xa0\xf6\xb9\xed]E}\xaf\xfa\xb5\xbb\x0d\xe5[}\x7f\x0f\x90\xf9\xb9?	q#\xc4\x83\x9fV _	y\xa8\xa2\x8d\x80\xb5\x00\x1c\xbbC\xffr\x14\x80\x8d\x00\\\xbac\xff8\x0e\x07\x01\xd9\n\x88\x11?\x08qw\x9d\xa7\xd6`vR\x85\xfbX\x0d\xca\x7f\x8b\xf2\xf0\xa7\x9f\xc2a\xe1o>\xa9\xdb\x10\x7f\xf3\x1e\xc16\x0c\xeb_$Y\xf0\x8a\x81\xbd_	z\xcd\xd0\xde\xc9\x04\xbda\xe8\xe8q\x82\xdf2|\x01\xfb\xc0\xb0)\x16\x84\xb0\xa3\xca!\xd7\x96\x86'\xbc\x1e\x93\xe1\xf1\xef\xc5hx\x10\xef\xc2c\xb1\x0d\xc3\xe2{>\x1a\x1e\x04\x87\xf0X\xf4\x9a\xa1Cx,z\xc3\xd0)<\x16\xbfe\xf8\x02\xf6\x81asx,aG\x95\xc3\xdf#\xd3\xf0<W\xf5\xfb\xb3o\xc3\xf3\xb4\xe7\xaaI\xcd\xd8\xbaJ\xad\x0f\xd8\xbc\x8e\xcd54nR\xa3\xd4\xbc\xcd\xed\xd8\xbc\x8b\xcd\x0d4\xd6\xf7\xa9U\x80\xeb\xd4\xbd\x15\xb6&\xd5k\x1cI\xea\xdd\x16G\x924\xecp \xa9\x175vc\x9b\x07\x88:\xdc\x06$\x08\xf2\x16\xe4\xb9:_\x13<>\xe5>\xfb\x13\xb3\xe74\xdb\xff[l\x0f\x85\xde\xb3\xe4gX\x8d\x87\xae=\xf4\xa7'\xbf\xc4\xbb\xe7\xab\x88\x91\xf2\xf9\xd6(\x01_\xe3[\xfb\xac\xc3\xdd\xe7%Ha\x86q\xbcHC\xe1o\x1d\x8e\xd5\xfd\xfb\xb1\x9d\x9e\xfaS\xdc\xb3\x1c\xab:\xb6`l\x8fU\x93\x9a\xb1u\x95Z\x1f\xb0y\x1d\x9bkh\xdc\xa4F\xa9y\x9b\xdb\xb1y\x17\x9b\x1bh\xacS\x7f\x1b\x01\xaeS\xf7V\xd8\x9aT\xafq$I\x87\x18_\xea\xf3\x16\xc7\x97\xf4\xee\xa0\xd5\xcd\x8e\xd0\x9eg\xc7\xf16;\xe2P\xe2\xec8\xbe%\x17W\xf3x\xfev\xff=\xfc\xe2\x8fU\x92\xdb\xaf\x19v\xdb\x08f\x9c\xdb\xe5$\xd8[\n\x8f\xd3\x16\xde\x14J\x95\xc2\xbb\xd7Lpz%c2\xb7\x87\xe3[\x8a\xb4\xb7\xc0\x0c\x08\xfd\x8d\xd4O\xd4\x0b\xed+\xa1\xfd\x81\xa9\x7f\x10\xfaWR\xff\x031\xf0 ,\xac\xd1Bm\xf5\xd7\xa8}-\xb4\xd7Fw\x8d\x9a7B3u~\xad\xbc\xbf\x91\xfa\x99\xfbk\xe5\xff\xad\xb4B\x8d\x08\x1b[e\x83\x99\x10\x16vh\xa1\xb1\xfa\x1b\xd4\xbe\x13\xda\x1b\xa3\xbbA\xcd\xb5\x98\xec\x0d\xeb|#;_\xcby\xdf\x90\xde7\xb2\xf7\xb5\x98\xa0+ka%\xf4\xcb\xf9\xb92\xdaWB\xb7p\xfe\xda\xea^\x0b\xdd\xd2\xf3k\xa3{-\xae+\xe1\x1b\xe2\x19yYI\xbfX\xaf\xc8kVL\xfa\xad\xd5\xbd\x15\xba\xe5\xac\xdf\x1a\xdd[q\xc5\n\x7f\xef\xac\xee\x9d\xb8\\\xa5\xbfwF\xf7\x0eu\xc3\x9d\xd4i\xbf\xfd\xae\xb4\xc3\x0d\xf6*\xf0N?\x12&\xb5\\\x1f\xdf\xf2\x1d\xd9_L\xe7\xab\xbe\x92\xd2}\xfa\nX\x7f\x1de\xf0$W\xfc\xe3\xac\xee\xe9\xa1y\xca\xcd\xf2\xae\xfd\x98\x05\xea\xb6?\xe8\xdb~\xb2@\xee\xf3\xc9L-\xcd\x08\xe9c\x96\xb2\xf5`\xa0\xebA\xb2j\xef\xfd\xc9h\xa3\x8c\n\x9b\x8d\xb6)L\x92%\"Y$\xebA2\xb9R&\x1f\x84\xcd\x95\xb6\xf9 \x8c\xb2u#Y5kD\xb2\xb9\x966k\xb4\xb8V\x16k\xb4gW\x92d\x8d\xad\x1b\xc9\xe0F\x19T\xd1\xdch\x9b*\x9ct\x81I\x96\xc9Z\x92\x0co\xb5aawk\xec\n\xb3l\xcdIV\xcd\xfa\x92l\xee\xa4\xcd\x06-\xee\x94\xc5\x06\xed\xd9U(_)d\xcd\xc9\x97\x8a\xba$\x1b9\xcaZ_\x98\x8d\x1c&]\x9d\xb2e\xb3\x12e\xbb\xearY	\xab\xfarY	\x9bv\xc5\xca\x16\xcd\xfa\x94-\xaax\xae\x85E\x1d\xce\xb5\xb0h\xd7\xb1d\xd1\xaeZ\xc9b\xa3|+\xef\x08\xda\xb3\xf2\x8e`W\xb7l\xd1\xace\xd9\xa2\xba:\xb7\xc2\xa2\xbe<\xb7\xc2\xa2]\xf3\x92E\xbb\xc2%\x8b+\x15\xc7\x1dZ\\\xe98\xee\xd0\"Y	\x93E\xb6\xee%\x9b(4k\xda\xa3\x10\xdb5r\xe0kd\xb2lV\xc4d6K\xf4z\xf7\x082\xb3p\x0ed\xe1\x0c\xa2\xf6Z\x85\xa7c\xf7\xa3~B\xf6\xf2\xf0\x94,\x11\xf1I\xf9\xd6\xfaZ]/N\x1c\xfeH\xe0>;\xd2I/G\x90\xae\xb4\xf4x\x00\xe9ZK\x87'\x90n\xb4\xf4:\x80t\xab\xa5\x8d\x10?h\xf1J\x88wZ\xbc\x16\xe2\xdf\xb4x#\xc4\xf5\xbd\x96'\xaf&@tj\x7f\xaa\x9eo\xbb\x8a\xdb\xbf\x9d\xd8\x868\x89'f\xa1f\xc6p\x08D\nG\x7f\xaa^\x83r\xf1\xa7$^\x90t\xebNU\xf6\xa0\xa1\x82\x93\x86\nW\xad\n\xce\x1a\xaa\xaf\xa2}\x95\xdb\x1f\x84 =\xbbW56or\xb3\xb2\xb0\x05\x89\x10\xa4S\x87\xaa\xc1\xe6|\xeeP5\x92\x90O\x1e\xaa\x95h\xcf&\xd6blY\x93\x1cs\x1e\xc3V\x8c9\xeb\xdfa{>k\xa8\xd2\x05W}\xf2\xb4\xa1\xfa\xe4qC\xa5\xcf\x1b*\xfa\xcc\xab\xc2\xa7\x8f\x1c*\xf6\xd0\xab8\xea\xd4\xa1b\x0f\x8er2\xe8\x83\x87\x8a<8J\x86:{\xa8\xe8\xe1\x83\x9aY\xfa\xf8\xa1b\xe7\x0f\x8a\xa3N *r\x04!\xa6\xa9>\x84\xa8\xec)\x84\xc0\xabs\x88\x8a\x1fD\xe8)\xaf\x8f\"4-\x1a\xd2q\xd9*[\xdc\x94\xb4\xb4\xd5\x96\xa8!iG\xec\x19+r(!.H},Q\xd9s	\x81\xd7'\x13\x15=\x9aP\xd7\xb69\x9c\xa8\xd8\xe9\x84\"\xe9\xf3\x89\x8a\x1cP\x88;\x859\xa2\xa8\xec\x19\x85 \xe8S\x8a\x8a\x1cS\x88{\x8e9\xa8\xa8\xecI\x85 \xe8\xb3\x8a\x8a\xf9J]\x8c\xcaS\xc4O\xeaz\x97\x17	9\xb2\x10\xf7AshQ\xd9S\x0bA\xd0\xe7\x16\x159\xb8\x10wTstQ\xd9\xb3\x0bA\xd0\xa7\x0b\x95=^\xc0[\xb39`\xa8\xcc	\x03\xc2\xf9\x19CU<d\xa8\xca\xa7\x0cU\xe9\x98\xa1\xd2\xe7\x0c\xea\xaelN\x1a\x94\xdc\x9e5(\x80>mP\xe2\x99\xdd\xf5\xb3\xf1F\x1b\x97\xb6\xf5\xe6[\xca\xf5\xa9\x83\x94\xcet-\xc8\xa6W\xda\xf4\x83\xb4\xad\xcf\x1e\x14@\x9f>(\xf1LV\x88l[=\xe3\x08\xa9=\x83\x10b}\n!\x843_7\xb2\xe1\x8d6\xac\xa3\xad\xcf\"4B\x9fFh\xf9LW\x93\xdc\x81\xad\xe9\x80\xb4\xaf\x1fb\x15@\x9fJ(\xf1L\xd6\x98l[\x9dL\x08\xa9=\x9b\x10b}:!\x84\xfa|B-\x17\xf6\x84B\x01\xc8\x19\x85B\x98S\n%\xd7\xe7\x14b1\xb1'\x15BL\xce*\x84\xdc\x9cV\x08\xa9>\xaf\x10\x8b\x8c=\xb1\x10brf!\xe4\xe6\xd4BH\xf5\xb9E\xa5\xee,\xda\xe7\xea\xceb<\xae\xee,d\xb1\x03\xcbve\x03\xcb\xfa\xea\xdeJ\xcb\xe6\xf2\xdeJ\xcbd\x11\xcc\x96\xc9\x8a\x97-\xeb\x93\x0c!&g\x19BnN3\x84T\x9f*\xe0:f\xcf\x15PJN\x16Pl\xce\x16\xb2p<\xb7\xfb~\xbe=\xef\x84\x9f\xe2\xa2\x16\x05\xcd&I\xd2K\xf3(\xdbd\xd6W%z\x00\xda\x83\x92\xd5\xf7\x99\x17^\xd5\x8f?\xbb\xe9\xc70\xbe\xfaS\x97\xf8\x1b\x1c\xb9$@\xf8k\xec\x04\xf1\xbf+\xd0\xcf\xfe\xd2?\x0e]F\x85\x06\x05\xbb\xec\xa7q\x182\xca\xff\xae@W\xd9\xa7\xea\xcaz\xf5\xa6@o\x0ct\xd5\x9d\xaf\xae\xbc\xfbo\x06\xf8\xc6\x81W3\xd2\xeaZ\x18\xeb\x9b\x85\xbe\x15\xa0W\xed\x98\xea\xca]\xf3f\x80o\x02\xe8\x7fv\x7f\x821\xbe\xec\x9f\xdf\xab\xd7\xee\xf1\xf7~\xaeT\x00\xfa\xd3\xd37\x07\xd0,\xe7\xd3\x05R\xf6\xf1\xb9\xba\x7f?\xb7\x87\xc3\xad\xf5>6\xd5\xa9	\xd7\xcfs\xd5\xe4vl^\xe5\xe6\x07l_\xa7\xf6\x1aZ7\xb9Uj\xdf\x82\x00\xdbw\xa9\xbd\x81\xd6:\xf7\xbb\x11\xf0:\xf7r\x85\xcdY\xfd\x1a\x87\x94\xd5\x88\x91\xe6\xbeoq\xa4Y\xf7\x0e\x9a\xcf\xd7\xdc\xf5x\x7f8\xbfe\xcf\x86\xb3\x90\xf8\x9b\xdc\x1d\x9f\xaf\x00\x0c\x87!\xf1W\xb1\xc3>\xbf\xe5\xc08\x8d\xe1\x01Z\xa9\x15N\xbd\x02\xc5\xe9V\x1co@P\xder\x98\xbd\x15jD\xd8h\x94\x0dfBXXI\x0b\x0f\xd4\xc4\x83\xb0\xb1R6\x1e\x98\x91\x07ae-\xac\xd4\xc4F\x8d\x16\xd6\xd2Bm\xf5\xd7\xa8}#\xb5\xf3`\xd4*\x1a\x1be\x83\x86\xa3V\xf1\xd8*K\xdc\x90\xb0\xb3\xd5v\xa8\x19ae'\xac4\xc4F\x83\x16v\xd2Bc\xf57\xa8\xbd\x96\x17CC\x07\xd1\xc8A\xd4\xea\xbah\xd8(\x1a9\x8aZN\xde\x15\xb1\xb2\x126\xd4\xdc]Y\x0b+\xa1_\x06cM\xf4\xaf\x85~\x15\x89\xb5\xd5\xbf\x16\xd7\x9e\xf4\x13\xf3\x92\xbc\xf4\x94\x8f\x88\x87\xe4\xb5-/\x8a-\xd1\xbf\x15\xfa\xd5U\xb1\xb5\xfa\xb7\xe2\xca\x96\xfe\xdf\x11\xfd;qY+\xff\xef\xac\xfe\x1d\xea\xcf\xb7Z\x7f1\x9c\xaf\xe6JHw\xe0+\xa2\xfdu\x00\xf0p\x11$\xf4\xac\xef\xd7\xa1}\x82vy?~\x04\x89\xba\xa7\x0f\xe6\x9e\x9e\xac\xb0{x2U+SB\xfc\x08bv\xb3\x1f\xf8\xcd>Y&\xf7\xf5d\xb8\xd1\x86\x85\xdd\xc6\xd8\x15f\xd9\xfd?Ye\xf7\xfadv\xa5\xcd>\x08\xbb+c\xf7A\x18\xa6\x8bB\xb2l\xef\xff\xc9\xeeZ\xd9\xad\xd1\xeaZ[\xad\xd1&Y&\x92E\xba&$\xa3\x1bmTEwc\xec\xaa\xf0\xf2\xd5#Yg\xebD2\xbe5\xc6\x85\xed\xad\xb5-L\xd3\x05%Y\xb6kG\xb2\xbbSv\x1b\xb4\xba\xd3V\x1b\xb4I\x96\x98|\x15\xb1\xf5$_F\xfa\x92m\xe4hks\xe16r\xb8|\xe9\xc9\xd6\xed*\x93m\xebKi%,\x9bKi%\xec\x92\xe5([\xb5kO\xb6\xaa\xe3\xbb\x16VMx\xd7\xc2*Y\xa4\x92U\xb2\"%\xab\x8d\xf6\xb3\xbck\x18/\xcb\xbb\x06Y\xba\xb2U\xbbNe\xab\xfa\xea\xdd\n\xab\xe6\xf2\xdd\n\xabdAKV\xc9\xea\x95\xac\xaet\\whue\xe2\xbaC\xabl\x99KV\xed\x9a\x96\x8c\x82H\xafW\x8f(4k\xdf\xc0\xd6\xbe \xf3	\xecU\xf7\xb3;\xcd\x17\x9f\x04 \xdb \xe1O\x81\xdd\x83\xa5\x02C\x8a\xee\xdc\xce\xfd\xfe=UY\xf7\xbf{\xd9\x8f\xfe\xda\x1d\xb2\xc8\xfd\xea%\xed\xe3e\x1c^\xe6.\x0bc\x8b\x97O\xdd\xd0\xce\xfdO\x90\xc7\x96h\xb5\xdf\xff\xfe\x96\xa5\xf1\xb9\xd7\xb7\x7f\x87\xde\xdc~\x0f\xc3\xeaO~\xe0s\xfc\xa3O\xef^\xf7cp\xa6\xfb\xd9\xb9\x0e\x1e\x97\xfb\xd3\xbb\x7f\x98\x0b[\x81\x08\xbe\xff.\x96\xf9\xfeT\xbd\x05\xa0\xda\x19\xf4\xa7\xea\xfa\x1e\xc9\x9a3\xbf\xe3\xce\xa3?U\xd3\xbb\xdcr\xf4'\xf7a\x04\xadqxGMSw\xe9\x7fu~|\xfeg\x08h\x10\xbeE\xc9\xcfn\x9a\xfb}\xccb\x0d\xd2k\x94>\x8fS\xffk<\xcdR\x1e\xa59\xbf\xf3\xf2\xdc\x1e\\\xd5\xe5k\xe5\x7f\xfcv\xff\xa59_\xbf\xac\xcf\xd7/\xf7_\xa6\xa7\xc7\xf6O\xf7w\xb7\xff\xbe\xd6\x7fFFu<H\xd2\x8d\xb0\xb3\xa4\xe6\xcfw%\x85\xf7;\xa9\xd1}\x10\x074\xd6\x9b\xf3\xf5\xcb\xea\xde\xea\xaco:oB\x87XV\xda\x9fN\xee\xc3*Io\x7f\xbat\xf3\x97b\x9f\xb6\x92>\xbe\xcc.\xdbRt\xec\xf6\xdf\xea|\xf5\xc4MsW\xaf\xd7wMs\x7f\xf7u#\xc9\xe1[3\x89\x99c\x19\xd2\xe5B{\xacF\xf1\xe9\x10\x08zu<0\x0d\x7f \x1eR\xfd\xf0\xc4\xd4\xff\xb1\xe0H\x0b.F\xd6\xc8'#%u\x85\x80\xb1.\x7f\x186\xa9\xe9\x16-\xab\x06\xd2\xc3}N]h\x8f\x9f\xf5\xfct\x0c\x05\xfd\x16C\xa2\xe1\x0f\xc4P\xaa\x1f\x9e\x98\xfa?\x16Ci\xc1\xc7\xd0\x18\xf9d\x0c\xa5\xae\x18C\xd2\xe5\x0fc(5\xb9\x18\x1a59\x86\xfe\xc3'nm\xf4?\x0e\xed\xdb\xf8\x82\xeb\x86o\xf6k\xa0\x80\xc0:8w\xd7\x90\x90\xef~r\xdf.\x80\xac|\xd7\x18\xbe\xc2\x00\x00\xfc\x04\x83kv\x0b\x06\"\\\x03\x00\xc27\x1f\x10\x12\x9a\x00\x04\xd5h\xde\xfd\xe7eM}\x1a\x87\xf3\xe9\xd3\xf1\x8b\xcbP\x89\xc6I\xb1\x00M\x04a\xcd\x19\x05\x9a\"\x06\xab\xcbHLD`\x1d\x99\x84\x88B\xac\x18\x93\xe9>c=~\xf9\x18j\xc3(H\xee\x06V\x81\xd1\xa0< \xac\xf7\xe2P\xae\xccK\x92\xfe@\x87@A\x97\xe4\x0f\xa8\xe1\"1\xd9\x1dP\xadE@\"\x00\xeb\xb2D@\x94a\x05\x96D\x16\xbe\xc0Z+\x12\x01\xae\x80\xaa*\n\x03\x9e\x80\xfa)\x0e$\xcb\xa6D\x18VJ1\xb0<h\xa8\x89\xa2Q)\x8aP\xfd\x040\xa9?P\xe7\x04U\x88\xd1cE\x13\x03\x02\x07@\xed\x12\x0b\x03\x1f@\x95\x12\x87\x93\xc5I\x92\x0f\xa0\x1e\x89\x81e\x1f@\xe5\x11\x8dJ\x06\xa1\xc6\x08`r\x7fr5\x11T!}\x00uC\x0c\x08|\x00\x15B,\x0c\xe7A\xae\x05\x12\xaf\x9b\\\x02$\xa2\xb0\xea\x87F%\x93X\xdfC\x81\x12\x04*ydH\xba\xbc\xa0f\x07(\x10\xc3\xc7\xea\x1c\x1a\x93\xbb\x82u8\x0c*\x0f\x0b+n8\x18\x16\xdaHc\x87\xda\x1a\n\x94\xeca\x15\x0d\x89\xc9c\xcb\xf52\x12\"\xcd0\xa8\x8c\x91\xe9b\xdcX\x03CA`\xd8P\xedB\x83`\xd4P\xd7\xc2\xa1\xb0\x9cE\x8a\x14T\xb0P\xa0\x1c\xf0}\xaeU!1\xc9/P\x95\"!\x92K\xa0\xfeD\xa6\xcbQC\xa5	\x05\xc9\xdd\xc0\x9a\x12\x1a\x04\xa3\x86\xea\x11\x0e%\x8bF\xa41A\x9d\x08\x03\xcb\xf1\x86\x8a\x10\x1a\x95f*\xd4~\x00L\x1a\x1aTy@\x15b\xfcX\xcf\xc1\x80\xc0\x03P\xb9\xc1\xc2\xc0\x07P\xa3\xc1\xe1di\x864:\xa8\xc6``S\x9e\xd1\xb9\xee\x82F\xa5\xfeC\x85\x05\xc0D1\xd6R@\x15\xc2\x07X5\xc1\x80rw\xb0>\x82\x85\xc1\x0d\x0f*!x\x1c\x14@H\x17$\xd4<P\xa0|\xc3\x87\xea\x06\x12\x93\xdc\x0du\x0c\x12\"\xf5\x03*\x16d\xbaZ\xeerm\x02\x05\xc1\x1b}\xaeB\xa0A\xb8\xd4\xe5z\x03\xe1\xa1\xc7\xdf \xa0\xd0\xa1\x7f\xf2\xf1\x04\xb3\x83D\x12~\xee%N\x19\xd8N\"T\x945\x14\x0c\xdc[r\xc6$	\xb8\xd1\xa4\x04	\xc7]\xa7\x86K$nA\x8db\xfc\xe0O\x9ci\xb0\x1f\xe5x\xddu\xdc\x9c\x16\x18\xda=\xb8SE\x8a\xafK(\xa1?\x88\xe3\xb1\x1c\xa1\xf4;\xeca)A\xbb\x1d6\xb4\x0c/\xd1\xb8\xbbUh	\xc4\xad\xaeVK|\x8e\xfb^\n7.\x87M0'\x18\x8f\xc3\x8e\x18\x19\xaa\x9e\xa0\xe0\xe0\xf6\xb8\xc4\xd1\xfe\x84\xbdr\x81\xa2\xa6\x1bl\x9c-A\x8d\x01v\xd1D9q,n\xa9K\x0c\xe3[\xd8_\x179\xc6\xbd\xb0\xd9F\x92*\x08(\xdd\x0b;\xef\x12G\xbb\x17\xb6\xe1\x05\x8a\xea\x17\xec\xc9-A\x8f!o\xd0\x89r\xe6^\xd8\xad\x97\x18\xc6\xbd\xb0u/r\xec\xec\xcd\xfbx$\xc9\x8a~\x82\x82\x9b\xfa\x02E\xf5\x0cw\xf8\x9c\xa1\xf0\xb0\xdd7x	\xc5\xbd\xbfUM<\x8b\x0f\x02\x05\x82\xee>>\x15\x94(\xdaI\xf8\x88\x80\x1cQ\x92O\xba\x15\x9e\x178Cu\x8bT\xff\x97\x04\xed)S\xf4?\xc1%\x92\xd4\xfa\xcf\x8a\x89KI\x89\x7f\x857\x1e\xb5\x95\xfd5\xc38\xd4\x16\xf4w\x14R\xce?\xce\"[\xc7_1\xf44\xdd\x9b\xf2\xfd\x92\xa0\xfco\xab\xf6'\xb8r\xbd-\xd6\x9f\x153\x87\xda\x1a\xfd\n\xaf\xbbNJ\xf3k\x86q\xa8\xad\xc8\xef(\xb4\x1e\x7f\xf4\x90-\xc4o8z\x96\xda\xfa\xfb\x9a\"	\xa4\xec>\x10\x94\xa3l\xb5}TN\\K\x8a\xec\x1b\x86q\xae\xad\xado9\xc6\xbd\xb6\xa4\xbe#\xd1\x82\xfa\xd1W\xb6\x92\xbe\xe1\xa8\xbe\x91\x02\xfa\x9a\xa2\x1c`\xeb\xe6\x03AbI\xb9|TN\xdcK\xaa\xe4\x1b\x86\x1e\x02)\x8eo9f\xdd\xb25\xf1=\xc9V\xc4\x8fw5[\n_1\xf4\x96\xc0V\xc0\x97\x04\x15p[\xf8>\xc1U\xdfm\xbd\xfb\xac\x98\xee\xb5L\x99{\x85\xb7[\x01S\xdd^3\xec>\xcb\x14\xb5\xf7\xb7dS\xd2\xde\x13J\xb5\xec\xc3m'\x7f92\xcem[\xc2\xdeAI\x01\xfb8\xb5m\xe5z\xc5\x98$\x81\x14\xac\x97\x04	'u\xea\x13\\\"Iy\xfa\xac\xd8\x07L\xe0IUz\x85\xd7]'\xc5\xe85C\xbb\x87\xd4\xa0w\x14\xac@\x9fbK\x1co\x0b\xcfG\xbf\xdb\x8a\xf3\x92\xa0\xddn\x0b\xcd\x0b\xbcD\x93\xfa\xf2\x11-\x81\xa4\xac|RK|N\xaa\xc9K\xb8q\xb9-\"\xaf\x08\xc6\xe3\xb6v\xbcc\xd0\xca\xf1\xf1\xaecK\xc6\x1b\x8e\xf6\xa7\xad\x14\xaf)j\xba\xd9\x02\xf1@Pc\xb0u\xe1Q9q,)\x07o\x18\xc6\xb7\xb6\n\xbc\xe5\x18\xf7\xda\xe2\xef\x8eDK\xbf\xa7\x9b\xba\xa9\xf9n8\xda\xbd\xb6\xd4\xbb\xa6\xa8~\xd9\n\xef@\xd0c0\x85\xddQ9s\xaf\xad\xe7n\x18\xc6\xbd\xb6\x8c\xbb\xe5\xd8\xd9k\xaa\xb7\xc7[\x8c\xa9\xdd\x1e(\xa4h\xbb\xa6\xa8\x9e\x91Z\xed\x8a\xa1\xf0\xb6D{\xc6K(\xa9\xcc\x0e\xaa\x89gIAvM\xd0\xdd'u\xd8\x0dE;\x89\x94_w\x1cR|=\xba\xd5V]W\x0c\xd5-Rl]\x12\xb4\xa7L\x8d\xf5\x04\x97HRZ=+&.%\x15\xd5\x15\xdex\xd4\x16R\xd7\x0c\xe3P[?\xddQH\xf5\xf48\x8bl\xd9t\xc5\xd0\xd3to\xaa\xa5K\x82\xf2\xbf-\x92\x9e\xe0\xca\xf5\xb66zV\xcc\x1cjK\xa2+\xbc\xee:\xa9\x84\xae\x19\xc6\xa1\xb6\x00\xba\xa3\xd0\xf2\xe7\xd1C\xb6\xee\xb9\xe1\xe8Yj\xcb\x9dk\x8a$\x90*\xe7@P\x8e\xb2\xc5\xcdQ9q-\xa9in\x18\xc6\xb9\xb6\x94\xb9\xe5\x18\xf7\xda\n\xe6\x8eD\xeb\x97G_\xd9\xc2\xe5\x86\xa3\xfaF\xea\x95k\x8ar\x80-S\x0e\x04\x89%\xd5\xc9Q9q/)Jn\x18z\x08\xa4\x16\xb9\xe5\x98u\xcb\x96 \xf7$[\x80<\xde\xd5l\xe5q\xc5\xd0[\x02[p\\\x12T\xc0m\x9d\xf1\x04W}\xb7\xe5\xc5\xb3b\xba\xd72U\xc5\x15\xden\x05L1q\xcd\xb0\xfb\xac\xfc\xa4\xe7\xb0\xd7\xf0y\x7f\xf7\x07\xbf\x98`\xe3\xa4\x97#JwZ\xfc\xd8^:\x00\xd4R:<\xa1LT\x0d\xf5\xa6\x07!\xd7\xe2F\xc9\x95x\xa5\xc4\xa6sk\x01h\x8c\xfe\x8d\x90\xe7L\x8c~n\x87~\x1fD\xae\xf6\xa3o\xf1\xd2i<\xb6'\x14\xfa\x0f\xe4{\xe1\xcb\xf9\xdcM\xfb\x9bS\x9c\x05\xf7\x98\xfcc\x9c\x8eY\xe0q\xc3\xf8\xcaqI\xe0q\xfb\xf6\xecl\xff2\xc0,\xf1H\xdf\x8b\x8a\xe9\xcc\x7f\xc7\xf8r:t\xbe,\x8c\x83\x1c\xba\xfd\xe8\x8b\xcadI\xe8_\x7f\xea\xaa\xf9y\x1a_\x9e\x9e\x0d\x16\x85\xd1xUV\x9d\xcd\xbbZ;C\xdf^\xbaCJ^\xf7\x8e<\x8e\xe3\xfc\xec\x12\xd73\xe4{u\x1c\x7fU\xe3\xe5\xaa1OS\xfbv\xd9\xb71;\xff\xf2\xf2x\xee\xaf\xddP}F\xfd\xcb<\x16\xf5\xe6\xbf\xe6\x0c\xa7&>\xec\xe1\xa4\xa40\x1d\x02\xd4\xcd\n\x8b\xc4\xb9\x11\x90i&\x04\xf4\xf2D	\xa44-8I\xcd\x9a@\xcaS\x84\xb3\xf4\x14\n4\x98I\x9cg\xfe>=\xc5\x1e\xf1\xe5\xc9\x15\xc7\x04\xd3\xa8@\xb43-\xf5\xb1\xfa\xc8\xa8\xe9%L\x8f@\xf9\xfb\xcd\xc1`\x82M\xc5\x0fl}nB\x86\x9b{\x98\x90\xfe\x86^\x98\x90\x01\xea'\xa4A\xe2\x84\x0c\xc8<!=zyB\x06R\x9e\x90\x94\xa4&d \xc1\x84\xa4,=!\x03\x0d'$\xe5\x99?\xb6\xcfs\x03\xf0\xe5	\x19\xc7\x84\x13\x92\x13\xed\x84L}\xac>2jz\x89\x93\xc4S\xfe~\x132\x98\xa0\x13r\xd9\xd6\xe7&\xe4<\xb5\xfb\xdf\xfb\\B\xa6\x9b\xe7n\xaa.\xe7v\x7f\xc3U_\xef7i\xa9\x8d\xc8XHFB\xef\x15\xea\xb5?t\x1a\x03\xda.\xdd\xd0\xedC\xed\xba8\x82\x97\xcb\x0d\xeb\x04\xce\xc9\xbe\xfb\xa4\xf5b\x1bu\x83\xb0r\x8b \xb5r\x13X+\xa1\xf5b\x1buCX\x0e]\xf1\xc2T_3&j\x85?\xd9\x97%6=v\x1e\xcf\x1a6\x8fgD\x1c\xfb\xc3a0\xba|\xab\xb0\xeaR\xcc\x8cM\xd7*,\xba+\x8d\x98\x0d\xed\x06\xcb\x15\x83\xc83\xe2'w\xdc\xbf\xfd\xd0\xcf\xea\x93;\xfd\x89 \xf0K?\xee\xa0\xdb\x17|\x0c\x13\xcb\xb5\xb89#os\x02\xe9\x0b\xacJh.\xb1\n\xd0\xf3\xd4	\xd8y\xea\x18\xa6r\xa1S\xc0*\xc7M\xa1\x8d\xf5\xd8\xe8\xd1\x8fS\xd7\xfe^\xbd\x8e\xd3\xe1\xf2~\xfb\x7f_\x046\xb7\"*\x8e:\xc1p\xcc\xf3\xf4r\xda\xb7s\xa7\xbf\x06\xf5\xdd\x05\"5v\xc3\xd0\x9f/\xfd\xe5{\xd1!U\xfd\xee\xbf\xb5\x8c\xbb\xe7\xd7\xaa\x89\xad\xd8\xb8\x8a\x8d\x0f\xd8\xba\x0e\xad5\xb4mb\x9b\xd4\xbaM\xcd\xd8\xba\x0b\xad\x0d\xb4\xd5\xf7\xb1Q@\xeb\xd8\xaf\x156F\xb5k\x1c@\xec\xd6\x16\x07\x10\xe9;\xec\x7f\xb4_c\x07\xb6iX\xa8\xc0e\x08\xf9\xf6|\xab|\xad\xce\xd7wU\xb9\xfa\xb5\xaa\xff\xe3\x1f\xa3\xb5M\xfc\x8a\xb5k\x8d^\\\xad\xbe\xaen\xffK\xb2&\xcb\xb6\xdb\xaf\xdb\xedv\xfb\x80\xbc\xd8\xa1f\x93ZW\xb9\xf5a\x83\xd8\xe8\xff\xe6\x1e\xb5\xc7\xd6\xf5=j\x88\xad\xdb\xdc\xba\xce\xad;\xd1\xf7\x14@\xdd\xbfM\x96\xed\xf4\xb8\xdcG\xbd\xf5\x07\xbd_\xe3w\xc2\x93\xe0g(\xff\xfc\xab\xba\x7f\xffU\xf5\xa7Cw\x8d\x8b\xc7\xaf\xdb|\x88mujlrc\x93\x1aW\xb9q\x95\x1a\xd7\xb9q\x9d\x1a7\xb9q\x93\x1a]\x84c\xb3\x8b\xf1\x7f\xfd\xf7cw\xe8\xdb/\x7f\x82\xef\x927\xf7\xf7\xe7\xeb\x9f\xdf\xbf^\x87\xdb\xae\xe22WSw\xe9\xe6w\xf7c\xdc\x87\x9d\xba\xf8\xa5\x92\xdb0n\xc8\xf6|\xee\xda\xa9=\xed;\xb9\xbe\xe5vX\xdet\xa3\xfa\xddk||\n)i\x8f\xed\xfe\xf7'W\x17\xb7j\xe7\xb9\xdd?\x1f\xdd\x1b]\x9f\x9b\x16\x90\xc3\xb8o\x87\x02\xd2\xc9\x122|\xdb\x8cC\xe3g\xce\x02\x16\xf3\xcc\x80\xe0O#\xc4k\xe4@\xf0	g\x06\x9a^!\x07\x98\xc8<\xb3\xe8\xf8\xfa\xd8\xa0'\x02\x8e\xaf\x8e5\x98@\xe3kc\x80\x12T|e\x8c\n\xddq\x0c\xc1\xc6\xd7\xc5\x06K\xbb\x1a_\x15[4uC|M\x1c\xe0>q\x8d\xc0~d\xc7b\x06\x1b\xf1kx=\xac\xc1\xd4\xad\xe1\xd5\xb0\xc2\x12d|-\x9c\x91\x04\x14_	\x83\xba\x92O\xe3\xeb`\x0d\xe5.\x0d\xaf\x82\x0d\x98{4\xbc\x06\x0eh\x95\x00g\xf1\xf1\x150\xc1S\x9f\x85\xd7\xbf\x16\xce\xa6Nx\xf5+\xc0\xac\xcf\xe1\xb5\xafTZr^|\xe5K\xd0\xdc\x7f\xe1u/\xc3s\x17\x86W\xbd\x81\xa0\xf2\xe7\x88\x0b\xc3k^\x82\xa7.\x0c\xafx-\x9c\xf5%\xbc\xde\x15`\xdag\xffjW*-\xba0\xbc\xd6%h\xee\xc2\xf0J\x97\xe1\x0b\xb3\xd0\xbf\xce\xcd\xb7\x01H\xbf\xb3\xf0\xf8*\xd7\xc2Yo\xe2k\\\x83f\xd8\xf0\n\x17\xb1\xec\xb6\x11^\xdf\n\x95%\xef\xc5W\xb7\x16L\xbb\x1b_\xdb\x128uF|e\x1b\xf0\"{\x8f\xb8.\xbc\xae5h\xd6\x95\xf8\xaaV\x83\xa9G\xfckZ\x80\xb2\xa9\x1f^\xd1\xa2\xc2\x92\xdb\xe2\xebY\x83\xe5^\x0b\xaff-\x9a;-\xbc\x96\x0dp\x91\xfcGfEx%k\xd0t\xba\x85\xd7\xb1\x1a\xcc\xfc\x1b^\xc5\x02\x94\xb96\xbc\x86E\x85E\xa7\x85W\xb0\x06K\xbb\x1a_\xbfZ4wZx\xf5\x1a\xe0*w\x90x\"\xbcv%x:\xdb\xc2+W\x0bg\x17Ux\xdd*\xc0\xcc!\xe1U\xabTZr_|\xcdJ\xd0\xdc\x81\xe1\x15+\xc3s\x17\x86\xd7\xab\x81\xa0R\x0f\x89O\xc2\xabU\x82g\xfd\x89\xafU-\x9c\x0d6\xbcR\x15`\x82\x8b\xafS\xa5\xd2\x92\x0b\xe3\xabT\x82\xa6]\x8e\xafQ\x19\x9e\xaf\x15\xe1\x15j$`\xe6\"\xb9\xe3\x84\xd7\xa7\x06M\x97\xda\xf0\xeaT\x83Y \xc3kS\x80\xb2\xbe\x86W\xa6\xa8\xb0\xbcO\xf1\xafK\x0d\xb6\xb0\xc4\xfaW\xa5\x16]\xd8\xa3\xf8\xd7\xa47x8\xdc\x97\x0f3\xe1X\xdf0\xcd#Mfc\x06$\x99\xb9\xf0|\x939$\x15\x92\xccbx\xd8a\xd4\xa9\xc8\xc4'\x1f\xc2,\xf2\xf01H\xf2\x8a\x14|&R\xa6\\\x1c\x8aD|@b\xc4\x85\xe1\xe1\xd3\x12\xa5.8\x15\x1f\x9d2\x173)\xd9\xac1\x01\xb4)\x95$~\xf0PE\x98\x0b\xe1\x83',K,\xd2\xf0qK\xd0\x8a\x0c|\xf6\x92\x86\x96c\x87\x0fb\x84\xb7\x14:x*c\xcc\xa5\xc8\xc1#Z\xa6\xd2\x8cLr\xff\x83\xe75N^\x08\x07<\xbcQny\x8e\xc3\x93\x9cf\x96\xc7	\x8fu\xc6\xdcr\\\xf0\x19\x8fS\x97B\x03\x0f|\x05\xf2Rt\xe0\xe9/\xb3iB'[\x9d\xf2\xa3 '/D\x07\x9e\x0b)\xb7\xdcexH\xd4\xcc\x85q\xe6'Fc\xee\x83\xe8\xc0\xe3#\xa7.E\x07\x9e%\x0b\xe4\xc5k'?Xf6\xcb\x07\xb5\\|\xca\xa4\xdcr\xa7\xf1\x91\x93Q\xcbDx\xfeT\xc4\"\x07\x1fF\xb5\xb1\xe5\xc0\xe0\x93)e.\x0c\x11\x1fS9w\xc1\xb5\xf8\xcc\x9a\xc9$\x9d\x94D\x05\x1e`\x19\xb5\xdcc|\x9a%\xcc\x05\xff\xe6G[\xc9+R\xf09W\x99Z\x8e\x08>\xf42\xe2R@\xe0	\x98R\x97\xe2\x01\x8f\xc3\x99K\xb2Q\xc9\x8c\x85gcF]\xb8H\xe0A\x990\xcbq\x84\xa7f\xc9+\x87\x10\x1e\xa1\x95\xa9\x0f\xe2\x01\xcf\xd3\x8c\xb80<|\xb8\xa6\xd4\xa5x\xc0\x93v\xe6\xd2dV\xe2Wx\xec\xe6\xe4\x85k\x04\x9e\xc1)\xb7\xc8\xc4\x07r\xcd,\xbb\x17\x9e\xce\x8d\xb9\xe5\xc8\xe0\xa3:\xa7.\xc5\x06\x9e\xdb\x0b\xe4\xa5\xe8\xc0C|f\xd3\\X\xe2ax\xa2\xe7\xe4r\xb7\xf1\xf1\x9er\xcb\xde\x82g}\xcd,\x92\xf0\xc1\xdf\x98[\x8e\x0e\x9e\x02p\xea\xc20\xf1H\xa0@^Z\xf2\xe1|\x00\xd86\x95\x96\xdc\xb6\xe1\xb0\x80Q\x176cpr@\x98\xe5\xa9\x04\xc7\x08\x92W\x1e\x1f\x9c)(S\x1fm\x92\xf3\x01\x03#.n\xc2\xf2i\x03\xa5.n\x90\xf3\xd1C\xf83.u\xf4\xe0\xff\x80\xcb0\xcd\xd1CfcJ.\xb9\xc4\xe0\xe8!sHn.\xb9\xc2\xe0\xe8\x81Q\xa7\"\x13\x8f\x1e\x08\xb3\xc8\xc3\xa3\x07\xc9+R\xf0\xe8A\x99\xf23\xa0D\xc4\xa3\x07F\\\x18\x1e\x1e=P\xea\x82S\xf1\xe8!s1\xb5\x97\xcd\x1a\x13@\x9b\xe3K\xe2\x07G\x0f\x84\xb9\x10>8z\xb0\xc4\"\x0d\x8f\x1e\x04\xad\xc8\xc0\xa3\x07ih9vx\xf4@xK\xa1\x83\xa3\x07\xc6\\\x8a\x1c\x1c=d*M\x11&wS8z\xe0\xe4\x85p\xc0\xd1\x03\xe5\x96\xe78\x1c=hfy\x9cp\xf4`\xcc-\xc7\x05\x8f\x1e8u)4p\xf4P /E\x07\x8e\x1e2\x9bf\x18\xb3\xb5.\x1f=p\xf2Bt\xe0\xe8\x81r\xcb]\x86\xa3\x07\xcd\\\x18g>z0\xe6>\x88\x0e\x1c=p\xeaRt\xe0\xe8\xa1@^\xbcv\xf2\xd1Cf\xb3\x04e\xcb\xc5\xa3\x07\xca-w\x1a\x8f\x1e\x18\xb5L\x84\xa3\x07E,r\xf0\xe8A\x1b[\x0e\x0c\x1e=P\xe6\xc2\x10\xf1\xe8\x81s\x17\\\x8bG\x0f\x99L\xf2\x9bIT\xe0\xe8\x81Q\xcb=\xc6\xa3\x07\xc2\\\xf0o>z\x90\xbc\"\x05\x8f\x1e\x94\xa9\xe5\x88\xe0\xd1\x03#.\x05\x04\x8e\x1e(u)\x1ep\xf4\x90\xb9$=\x9a\xccX8z`\xd4\x85\x8b\x04\x8e\x1e\x08\xb3\x1cG8z\x90\xbcr\x08\xe1\xe8A\x99\xfa \x1ep\xf4\xc0\x88\x0b\xc3\xc3\xa3\x07J]\x8a\x07\x1c=d.\xcd\xae&~\x85\xa3\x07N^\xb8F\xe0\xe8\x81r\x8bL<z\xd0\xcc\xb2{\xe1\xe8\xc1\x98[\x8e\x0c\x1e=p\xeaRl\xe0\xe8\xa1@^\x8a\x0e\x1c=d6M\xce&\x1e\x86\xa3\x07N.w\x1b\x8f\x1e(\xb7\xec-8z\xd0\xcc\"	\x8f\x1e\x8c\xb9\xe5\xe8\xe0\xd1\x03\xa7.\x0c\x13\x8f\x1e\n\xe4\xa5%\x1f\x8e\x1e\x80ms\xbb\xc9m\x1b\x8e\x1e\x18ua3\x06G\x0f\x84Y\x9eJp\xf4 y\xe5\xf1\xc1\xd1\x832\xf5\xd1&9\x1f=0\xe2\xe2&,\x1f=P\xea\xe2\x069\x1f=<\xc6\xa2c\x88K\xb5\xb6b.O@\x86\xb2%\x0c\x19\xeb\x97\x04\xa4\xab\x7f\xc2p\xbe\x10\n\xa0\x96\xcc\xdf\xe4_T\x1f\x1cg\x1e\xcfe\x82\xcbW\nh_E\x85AC9\x15\xc4-u\xc5\x01t_<\xab\xd4\x19O\xc1\xde\x94\x90\xa2\xc7\xdd\xb9kE\x97}\xcb7\xffO\xc2\x9d\xc624\xc9\x94\xd6\xeaZ\xd4[]5\xf6\xad\x8c}KX\x97(\x018\x97c\xef\x93b\xe2\x9c\xd1\xe7_\x0e\xe2Z\x01s\x9a\xdb\xfeDP\xae=\xe0\xc6\xe9\xd0M2\xd3\xc07\xf9im\xb3\x0c\xbc4d\x1a Tf\x19x\x89\xcc4\x10h\x91e\xa0\xd1\x93\x02\x8b,\x03\x05VP\x91e\x90\xa1\n%\xb2\x0c@\xe1\xe0\xa7\xb6\xc0\x8a,\x03\x8d5]\x15Y\x06\x06m\xdc \xb2\x0c\xbc$d\x1aH\xd8\x0f\xe9X\x91i \xfd\x8aY\x06\nl\xdc\x8aY\x06\x12\xab\x90\"\xcb !\x15Hd\x19du\xcc\xa7\"\xcb@A\xadK1\xcb@\x83\xadG1\xcb\xc0Kt\xa6\x81\xc0\x8b,\x03\x8b7>\xc3,\x03\x03\xd7S\x07\xb3\x0c\x10\xac\xfb\x8cY\x06B)s\x9e\xc82\xb0h\xeb?\xcc2 x\xebB\xcc2\xf0\x12\x9di ]\x88Y\x06\x16o\\\x88Y\x06\x06\xae\xfb\x82Y\x06\x086}\x86,\x03\xa1\x94\xba\x10\xb3\x0c,\xda\xba\x10\xb3\x0c\x08\x9e\xccB\xc82\xf0\x12\x95i \xe0\"\xcb\xc0\xc0uoD\x96\x81Fk,f\x19\x00V\xdf60\xcb\x00U2\xef\x89,\x03\x036\xdd\x15Y\x06\x16n\x9c!\xb2\x0c\xbcDf\x1aH\xd7a\x96\x81F\xeb\xae\x88,\x03\x056\x1e\x81,\x83\x0c\xd5S\x1f\xb3\x0c@!s\x9b\xc82\xd0X\xeb5\xcc20h\xeb4\xcc2\xf0\x12\x99i g\x05f\x19h\xb4\x99n\x98e\xa0\xc0\xda\xbf\x98e\x90\xa1\xda\xb5\x98e\x00\n\xa9\xd30\xcb@cMWE\x96\x81A[\xa7a\x96\x81\x97\xe8L\x03\xe9	\xcc2\xb0x3\xdb0\xcb\xc0\xc0\xf5E\x85Y\x06\x08\xd6\x0e\xc1,\x03\xa1\x94\xb9Od\x19X\xb4u f\x19\x10\xbcu!f\x19x\x89\xce4\x90>\xc1,\x03\x8b\xd7\xfd\x11Y\x06\x06\xae\x07\x8bY\x06\x08V8\x91e \x942\x17\x8a,\x03\x8b6]\x16Y\x06\x04o\xd7\n\xcc2\x08\x04\x91i \xd0\"\xcb@\xa3\xcdR\x8bY\x06\n\xac\x03\x89Y\x06\x19\xaa\xfb\x8aY\x06\xa0\x90\xefS \xcb@c\xc9\x12\x0bY\x06\x06M\xf6(6\xcb\xc0\x03H\xa6\x012\x8bY\x06\x1e$2\x0d\xe4\xcc%Y\x06\x1e\xc02\x0d\xe4,&Y\x06\x9a:Q&\xcb2PL\xcacY\x06\x99G),\xcb\x00L\xb98P\"\xcb2\xd0\xc4\xc2\xf0X\x96\x81\xa1\x16\x9c\xca\xb2\x0c<@d\x1a\xa8YC\x03H2\x0dd\xfcH\x96\x81b\x16\xc2G\xb2\x0c$\x91\xd2X\x96A\xa2Q\x06\xcb2\xc8\x86\xca\xb1cY\x06\x8aW\n\x1d\xc92\xd0\xccR\xe4H\x96\x81\x07\xf0L\x03AfY\x06\x96\\\x08\x07\xc920\\>\xc7I\x96\x012\xf98I\x96\x810W\x8e\x0b\xcb2\xb0\xd4RhH\x96\x01!\x97\xa2C\xb2\x0c<\x80g\x1a\xc8\xe8\x90,\x03K.D\x87d\x19\x18.\xef2\xc92@fa\x9c6\xcb@\x98[\x88\x0e\xc92\xb0\xd4RtH\x96\x01!\x17\xaf\x1d\x9be\xe0\x014\xd3@pY\x96\x81\xe1\xf2N\xb3,\x03M\xe5D\x92e\x00D\xcaaY\x06h\xac\x1c\x18\x96e`\x98\x85!\xb2,\x03\xcb-\xb8\x96e\x19x\x00\xcb4\x90Q!Y\x06\x9a\xca{\xcc\xb2\x0c\x14\xb3\xe0_\x9be\x90y\x94\xc2\xb2\x0c\xc0T9\",\xcb@\x13K\x01!Y\x06\x86Z\x8a\x07\xc92\xf0\x00\x96i g,\xc92\xd0\xd4\xc2EB\xb2\x0c\x14\x93\xc7\x91d\x19d\x1e\x0f!\xc92\x00S\x0b\xf1 Y\x06\x9aX\x18\x1e\xcb20\xd4R<H\x96\x81\x07\xf0L\x03\xe9W\x92e`\xc9\x85k\x84d\x19\x18.e\xb2,\x03dr\xf7\x92,\x03a\xae\x1c\x19\x96e`\xa9\xa5\xd8\x90,\x03B.E\x87d\x19x\x00\xcf4\x90\x1e&Y\x06\x96\xcc\xbb\xcd\xb2\x0c\x0c\x97{\x8bd\x19 \x93\x92X\x96\x810W\x8e\x0e\xcb2\xb0\xd4\xc20Y\x96\x01!\x97\x96|\x92e\x10\xd8$\xd3@PY\x96\x81\xa6\x166c$\xcb@1\xf9T\"Y\x06\x99\xc7\xc7G\xb2\x0c\xc0\xd4\xd2&\xd9f\x19hbq\x13f\xb3\x0c\x0c\xb5\xb8A\xb6Y\x06\x1e@2\x0d\x90Y\xcc2\xf0 \x91i /1\x92e\xe0\x01,\xd3@^a$\xcb@S'\xcadY\x06\x8aIy,\xcb \xf3(\x85e\x19\x80)?\x03\x18\x91e\x19hbax,\xcb\xc0P\x0bNeY\x06\x1e 2\x0d\xd4\xac\xa1\x01$\x99\x062~$\xcb@1\x0b\xe1#Y\x06\x92Hi,\xcb \xd1(\x83e\x19dC\xe5\xd8\xb1,\x03\xc5+\x85\x8ed\x19hf)r$\xcb\xc0\x03x\xa6\x81 \xb3,\x03K.\x84\x83d\x19\x18.\x9f\xe3$\xcb\x00\x99|\x9c$\xcb@\x98+\xc7\x85e\x19Xj)4$\xcb\x80\x90K\xd1!Y\x06\x1e\xc03\x0ddtH\x96\x81%\x17\xa2C\xb2\x0c\x0c\x97w\x99d\x19 \xb30N\x9be \xcc-D\x87d\x19Xj):$\xcb\x80\x90\x8b\xd7\x8e\xcd2\xf0\x00\x9ai \xb8,\xcb\xc0py\xa7Y\x96\x81\xa6r\"\xc92\x00\"\xe5\xb0,\x034V\x0e\x0c\xcb20\xcc\xc2\x10Y\x96\x81\xe5\x16\\\xcb\xb2\x0c<\x80e\x1a\xc8\xa8\x90,\x03M\xe5=fY\x06\x8aY\xf0\xaf\xcd2\xc8<JaY\x06`\xaa\x1c\x11\x96e\xa0\x89\xa5\x80\x90,\x03C-\xc5\x83d\x19x\x00\xcb4\x903\x96d\x19hj\xe1\"!Y\x06\x8a\xc9\xe3H\xb2\x0c2\x8f\x87\x90d\x19\x80\xa9\x85x\x90,\x03M,\x0c\x8fe\x19\x18j)\x1e$\xcb\xc0\x03x\xa6\x81\xf4+\xc92\xb0\xe4\xc25B\xb2\x0c\x0c\x972Y\x96\x012\xb9{I\x96\x810W\x8e\x0c\xcb2\xb0\xd4RlH\x96\x01!\x97\xa2C\xb2\x0c<\x80g\x1aH\x0f\x93,\x03K\xe6\xddfY\x06\x86\xcb\xbdE\xb2\x0c\x90II,\xcb@\x98+G\x87e\x19Xja\x98,\xcb\x80\x90KK>\xc92\x08l\x92i \xa8,\xcb@S\x0b\x9b1\x92e\xa0\x98|*\x91,\x83\xcc\xe3\xe3#Y\x06`ji\x93l\xb3\x0c4\xb1\xb8	\xb3Y\x06\x86Z\xdc \xe7\xa3\x07\xf77\xdd\xdd\xc1\x7f\xf0>\xc0\xa6\xf6\xd0\xbf\\\xe2W\xf1#\xe2rT\xf2TO\x0eP\x1ab\x11\xd5\xf0\xa4A\x16\xe3J\x12H\xd4o\xbf\xfd\xf6\xdb\xf9*a\xb3\xe8\xf6<\x9e}\x0eB\xec\xffw\x10\xf8\x84\x00>\xb2\xc9\xa8\x91\xe8\xa8\xc7\xe7\x18,\xaaz\x14\xaa(Ai\x13=V\x9e\xfa\xdc\xf0>V4C\xf0\xb4\x9a\x10\xc5\xa2\xafX\x94\xabI)d\x8c%\xafQ\xa5\x8f\xa0t\x81\xb40l\xaav\xf8\xfc\xe0?\xabr.\xea\xfb\xc0\x97\xcc\x95e?~\xecF\xe6\xc5E\x17~8\\\xe6\xc0\xcf\x8d\xf6\x93\xdaf\xb8\x01\x18\x85\xcb\xdec\xf3P*\xb3\xf8E\xf7\xb19\x98\x15\x16)K#f\xf3\xef\x93\x03\xfe\x9c\xbaY\xdc\x1c\xb5B\x7f\x97,\xba\x90\xddD'\xa3\x90P\x96\xdc\xc8\x94>\n\xa5e\xd6\xc2\xd8\x99\xda\xe1\xffc\xf0\x9fT9\x7fp\x97U\xe8\x8f\xd6\n\xe5\x86\xe9\xe3\xf5@1\x06\xc6X\xea\xd1'\xeeof\x0c\x1f\xdf\xbd\xcd8>uo6c1\xac\x8f{\xf7\xc1\xedF\x8f\xe5\x83\xdb\xa7\x1e\xc7\xc7wG=\x86\xa5\x01\xd0\xfe\x7ft\xbd\x9bh|t\x0f3\xb1\xf8\xc4=\xcaD\xc2p>\xea\xd7g\xae53\x94O\xdcJ\xcch>w\xa70\x03\"\xb4R\x07\x03\xea2\x0e}\xda\xa1\xfa2P\xaeI\x80\x0e\xed\xe5\xb9S(\xdf&a\xe3<\x1b\x98k\x130\xbc\x96s\xe1)\x01\xb9\x8fr_\xbb\xea^\x08\x1b)l\xd4\x88\xd6R\xbcV\xe2\x9d\x14\xef\xa4X\nk\xc5\x9ds\xc7n\x81d\x9d\x83\xbe\xfb\x801\xd0c\x06\x85(1\xd4\x90Q.\x88\x0c3go\xe4\x1ei\x8f\x80\xcb\xb0O\x1a\xf6\x98a\xa2W\x1a7d\x1c\xf4K\xa3\xe6\x1c\x88\xdc3\x1d\x0c\x88\x16\xf6L\xc3\x1e3L\xf4L\xe3\x86\x8c\x83\x9ei\xd4\x9c\xe7@\xee\xd9\xce\xf4l\xc7z\xa6a\x8f\x19&z\xa6qC\xc6A\xcf4j\xb6\xfd\xd2Spb\xbd\xd2 \xbd\xdf\xe5\xa8\x81\xf4(a\xf6/\xd3e\x9c|\xc6\xb4\xff\x19\x12\xa5\x83\xf0\xd0\xfdh_\x869\xca\xc3\xaf\x02r\x1e{\x97\x84\x1f \xe1W\x01ym\xfb\xa4\xe2\xf6\xb3\x10\x1e\xc7\x9f]\x14\xde~\x16\xc2\xd38W\xed0\x8c\xaf\xdd!b\xa0)\x8cu\x18\xf7\xbf\xbf\x1f\xfa\xcbyh\xdf\xfco^\xd0\x9f\\\xdd\\)\xc7F\x84)\x80\x17\xcd\xed\xe3\x90%\xee7\x10T\xd3\xf8*\x85\xb7\x16\x04\xec\xbbaP\x88[\x93\x87\xf8\xd2\x98I\x9c\xef\x90?\x86\xee\x9a\x9ao\xbf\x88\xe1\x08)\xb4e\xae\xeb\x97\xfb\xe1\xd0O\xdd\xde\xe7\xfe\xc7\x8eE@5u?\xbb\xe9\xd2\x11`\x14\x01a?\x0e\x1a\xb8\x1f\x87\x97\xe3IbJJ=\x96\xe8u\x85I\xd3O\xdf|\x05P!\x95*\x13\x8a\xe8\x8aUV30\x96\x14u\xde\x9b\xbb\xe3\xa5\xba\xcc\xed4\xbf\xfb\xba\xb1\xae\xc5S]3\xe2\xba\xd3\xc1\xa2\xba\xd3\x011\xe1\xe3\x13\x08\xc3\x8fNxP*\xb3\x8b\xb0\\c\x17{6u\xf3\xfeY\xe0B\x9b\x87]\xba\xe1\x87\xbfX=\xe4\xf6;\\\xb0N\x8c\xc3sr=:\x87\xca\x83\xcb\x9846\x87\x10Cs \x1cY0\x85\xfdu\x18\xd1\xdd\xff|\xb9\xcc\xfd\x8f\xb7\xd0\xa5\xf8\xdb~<\xcd\xee\x0f|T\xbf\xa2\xfc\xd65\x8aM\xfdKR\xdfE\x0d\xc6~F\xd9c7\xbfv\xdd\xc9`}}\xdc \x95\x94\xd6\xed\xb8\n\x0c/\x0c\xf7)/\x91\x1ec\x9d\x89@\x0cQ\xc9\x1b\x11\x9b\x03\xc5}\x11qq\x80\x12K\x86\x17	ax\x0c\x8f\x83s\xd6jwA}\xab\xa1\xc9\xcdB\xd7\x9a\xe7\x9f\x13\xf4\xa7~\xee[\x7f\xab\xf8\x16~\x11W\xe8\xc9_\xc7\xeafW=\xa5;\xd6\xed'a\xeb\xf2<\xf5\xa7\xdf\xdf\xe1g!>\x8d\x9a|/\xa5\x84\x9f\x10c;\xfbE\xf6\xdd\xfd\x8c\x9f`\xf1\xc2\xdb\xb2\x19dC\xfaN\x8c\x17\x85\xb1\x8c\xed\x0c\x83\xd9\x0f];\xfd\xe8\xaf\xdf\xda\x1fnI\x0c\x9e\xfd\x87\x7f\xf8.\x16\x81\xef\x0e\xf7\xedq\x9c\xc3\xc5\xe2\xeb\x9d\xb7\xa7P3\xffG{\xec\x87\xb7o\x97\xb7\xcb\xdc\x1d\xab\x97\xfe\xee/C\x7f\xfa\xfd\xaf\xed\xfeo\xae\xe5_\xc6\xd3|W\xb5\xe7\xf3\xd0U\x1es\xf7\xb7\xeei\xec\xbe\xfc\xdb\xff\xba\xfb\xbf\xe3\xe38\x8fw\xff\xe7\xfa\xf6\xd4\x9d\xee\xfe\xed\xf1\xe54\xbf\xdc\xfdS{\x9a\xdb\xa9\x1b\x86\xbb\x7f\xe9\xa7\xf6\xcb\xdf\xda\xd3\xe5\xee\x9f\xa7\xb1?\xf8\x1f\xffg7\xfc\xec\xe6~\xdf~\xf9\xd7\xee\xa5\xbb\xbb\xf5\xa3\xbatS\xff\x03;w\xfb]\xf4\xee\x9f\xc6\xd3ev\xf5\xdd\xef\xfe\xf7\xcb\xbe?\xb4_\xfe\xe2\xfc\x17~{\xc4_\xbe\xfc\xedF\x0f\xbf\xdc\xfds\xf7\x9f\xed\xbf\xbf\x84\xb6\xbf\xf4\xf3\xed\xbe\xd1\x1e\xbf\xfc{7%`\xff\xd8\xf9\xf2\xf5\xa1\xe1\x7ft\xe3\xf4\xd4\xb7w\xba[\xc7\xf14\x8a^\xfd\xb5;\x0d\xe3\xdd_\xc7S\xbb\x1f\xefn]\x1c\x87\xf6\x82\xfa\xfe:\x9en\x82\x97\xa9\xef\xa6/\xff\xda\xbd\xde\xddT\xb8\xa9\x0fj\x9f\xdb\xde\x95\xd4\xf7\xaa_\xbb\xdbP\xbe\xd5\xf7\xf7\x00\x99\x9f\xfb\x93\x107B<\xf8i\x05\xf2\x95\x90\x872\xda\x08X\x0b\xc0\xb1;\xf4/G\x01\xd8\x08\xc0\xa5;\xf6\x8f\xe3p\x10\x90\xad\x80\x18\xf1\x83\x10w\xd7yj\x0df'U\xb8\x8f\xd5\xa0\xfc\xb7(\x0f\x7f\xfa)\x1c\x16\xfe\xe6\x93\xba\x0d\xf17\xef\x11l\xc3\xb0\xfeE\x92\x05\xaf\x18\xd8\xfb\x95\xa0\xd7\x0c\xed\x9dL\xd0\x1b\x86\x8e\x1e'\xf8-\xc3\x17\xb0\x0f\x0c\x9bbA\x08;\xaa\x1crmix\xc2\xeb1\x19\x1e\xff^\x8c\x86\x07\xf1.<\x16\xdb0,\xbe\xe7\xa3\xe1Ap\x08\x8fE\xaf\x19:\x84\xc7\xa27\x0c\x9d\xc2c\xf1[\x86/`\x1f\x186\x87\xc7\x12vT9\xfc=2\x0d\xcfsU\xbf?\xfb6<O{\xae\x9a\xd4\x8c\xad\xab\xd4\xfa\x80\xcd\xeb\xd8\\C\xe3&5J\xcd\xdb\xdc\x8e\xcd\xbb\xd8\xdc@c}\x9fZ\x05\xb8N\xdd[akR\xbd\xc6\x91\xa4\xdemq$I\xc3\x0e\x07\x92zQc7\xb6y\x80\xa8\xc3m@\x82 oA\x9e\xab\xf35\xc1\xe3S\xee\xb3?1{N\xb3\xfd\xbf\xc5\xf6P\xe9=K~\x86\xd5x\xe8\xdaC\x7fz\xf2K\xbc{\xbe\x8a\x18)\x9fo\x8d\x12\xf05\xbe\xb5\xcf:\xdc}^\x82\x14f\x18\xc7\x8b4\x14\xfe\xd6\xe1X\xdd\xbf\x1f\xdb\xe9\xa9?\xc5=\xcb\xb1\xaac\x0b\xc6\xf6X5\xa9\x19[W\xa9\xf5\x01\x9b\xd7\xb1\xb9\x86\xc6Mj\x94\x9a\xb7\xb9\x1d\x9bw\xb1\xb9\x81\xc6:\xf5\xb7\x11\xe0:uo\x85\xadI\xf5\x1aG\x92t\x88\xf1\xa5>oq|I\xef\x0eZ\xdd\xec\x08\xedyv\x1co\xb3#\x0e%\xce\x8e\xe3[rq5\x8f\xe7o\xf7\xdf\xc3/\xfeX%\xb9\xfd\x9aa\xb7\x8d`\xc6\xb9]N\x82\xbd\xa5\xf08m\xe1M\xa1T)\xbc{\xcd\x04\xa7W2&s{8\xbe\xa5H{\x0b\xcc\x80\xd0\xdfH\xfdD\xbd\xd0\xbe\x12\xda\x1f\x98\xfa\x07\xa1\x7f%\xf5?\x10\x03\x0f\xc2\xc2\x1a-\xd4V\x7f\x8d\xda\xd7B{mt\xd7\xa8y#4S\xe7\xd7\xca\xfb\x1b\xa9\x9f\xb9\xbfV\xfe\xdfJ+\xd4\x88\xb0\xb1U6\x98	aa\x87\x16\x1a\xab\xbfA\xed;\xa1\xbd1\xba\x1b\xd4\\\x8b\xc9\xde\xb0\xce7\xb2\xf3\xb5\x9c\xf7\x0d\xe9}#{_\x8b	\xba\xb2\x16VB\xbf\x9c\x9f+\xa3}%t\x0b\xe7\xaf\xad\xee\xb5\xd0-=\xbf6\xba\xd7\xe2\xba\x12\xbe!\x9e\x91\x97\x95\xf4\x8b\xf5\x8a\xbcf\xc5\xa4\xdfZ\xdd[\xa1[\xce\xfa\xad\xd1\xbd\x15W\xac\xf0\xf7\xce\xea\xde\x89\xcbU\xfa{gt\xefP7\xdcI\x9d\xf6\xdb\xefJ;\xdc`\xaf\x02\xef\xf4#aR\xcb\xf5\xf1-\xdf\x91\xfd\xc5t\xbe\xea+)\xdd\xa7\xaf\x80\xf5\xd7Q\x06Or\xc5?\xce\xea\x9e\x1e\x9a\xa7\xdc,\xef\xda\x8fY\xa0n\xfb\x83\xbe\xed'\x0b\xe4>\x9f\xcc\xd4\xd2\x8c\x90>f)[\x0f\x06\xba\x1e$\xab\xf6\xde\x9f\x8c6\xca\xa8\xb0\xd9h\x9b\xc2$Y\"\x92E\xb2\x1e$\x93+e\xf2A\xd8\\i\x9b\x0f\xc2([7\x92U\xb3F$\x9bki\xb3F\x8bke\xb1F{v%I\xd6\xd8\xba\x91\x0cn\x94A\x15\xcd\x8d\xb6\xa9\xc2I\x17\x98d\x99\xac%\xc9\xf0V\x1b\x16v\xb7\xc6\xae0\xcb\xd6\x9cd\xd5\xac/\xc9\xe6N\xdal\xd0\xe2NYl\xd0\x9e]\x85\xf2\x95B\xd6\x9c|\xa9\xa8K\xb2\x91\xa3\xac\xf5\x85\xd9\xc8a\xd2\xd5)[6+Q\xb6\xab.\x97\x95\xb0\xaa/\x97\x95\xb0iW\xacl\xd1\xacO\xd9\xa2\x8a\xe7ZX\xd4\xe1\\\x0b\x8bv\x1dK\x16\xed\xaa\x95,6\xca\xb7\xf2\x8e\xa0=+\xef\x08vu\xcb\x16\xcdZ\x96-\xaa\xabs+,\xea\xcbs+,\xda5/Y\xb4+\\\xb2\xb8Rq\xdc\xa1\xc5\x95\x8e\xe3\x0e-\x92\x950Yd\xeb^\xb2\x89B\xb3\xa6=\n\xb1]#\x07\xbeF&\xcbfELf\xb3D\xafw\x8f 3\x0b\xe7@\x16\xce j\xafUx:v?\xea'd/\x0fO\xc9\x12\x11\x9f\x94o\xad\xaf\xd5\xf5\xe2\xc4\xe1\x8f\x04\xee\xb3#\x9d\xf4r\x04\xe9JK\x8f\x07\x90\xae\xb5tx\x02\xe9FK\xaf\x03H\xb7Z\xda\x08\xf1\x83\x16\xaf\x84x\xa7\xc5k!\xfeM\x8b7B\\\xdfky\xf2j\x02D\xa7\xf6\xa7\xea\xf9\xb6\xab\xb8\xfd\xdb\x89m\x88\x93xb\x16jf\x0c\x87@\xa4p\xf4\xa7\xea5(\x17\x7fJ\xe2\x05I\xb7\xeeTe\x0f\x1a*8i\xa8p\xd5\xaa\xe0\xac\xa1\xfa*\xdaW\xb9\xfdA\x08\xd2\xb3{Uc\xf3&7+\x0b[\x90\x08A:u\xa8\x1al\xce\xe7\x0eU#	\xf9\xe4\xa1Z\x89\xf6lb-\xc6\x965\xc91\xe71l\xc5\x98\xb3\xfe\x1d\xb6\xe7\xb3\x86*]p\xd5'O\x1b\xaaO\x1e7T\xfa\xbc\xa1\xa2\xcf\xbc*|\xfa\xc8\xa1b\x0f\xbd\x8a\xa3N\x1d*\xf6\xe0('\x83>x\xa8\xc8\x83\xa3d\xa8\xb3\x87\x8a\x1e>\xa8\x99\xa5\x8f\x1f*v\xfe\xa08\xea\x04\xa2\"G\x10b\x9a\xeaC\x88\xca\x9eB\x08\xbc:\x87\xa8\xf8A\x84\x9e\xf2\xfa(B\xd3\xa2!\x1d\x97\xad\xb2\xc5MIK[m\x89\x1a\x92v\xc4\x9e\xb1\"\x87\x12\xe2\x82\xd4\xc7\x12\x95=\x97\x10x}2Q\xd1\xa3	um\x9b\xc3\x89\x8a\x9dN(\x92>\x9f\xa8\xc8\x01\x85\xb8S\x98#\x8a\xca\x9eQ\x08\x82>\xa5\xa8\xc81\x85\xb8\xe7\x98\x83\x8a\xca\x9eT\x08\x82>\xab\xa8\x98\xaf\xd4\xc5\xa8<E\xfc\xa4\xaewy\x91\x90#\x0bq\x1f4\x87\x16\x95=\xb5\x10\x04}nQ\x91\x83\x0bqG5G\x17\x95=\xbb\x10\x04}\xbaP\xd9\xe3\x05\xbc5\x9b\x03\x86\xca\x9c0 \x9c\x9f1T\xc5C\x86\xaa|\xcaP\x95\x8e\x19*}\xce\xa0\xee\xca\xe6\xa4A\xc9\xedY\x83\x02\xe8\xd3\x06%\x9e\xd9]?\x1bo\xb4qi[o\xbe\xa5\\\x9f:H\xe9L\xd7\x82lz\xa5M?H\xdb\xfa\xecA\x01\xf4\xe9\x83\x12\xcfd\x85\xc8\xb6\xd53\x8e\x90\xda3\x08!\xd6\xa7\x10B8\xf3u#\x1b\xdeh\xc3:\xda\xfa,B#\xf4i\x84\x96\xcft5\xc9\x1d\xd8\x9a\x0eH\xfb\xfa!V\x01\xf4\xa9\x84\x12\xcfd\x8d\xc9\xb6\xd5\xc9\x84\x90\xda\xb3	!\xd6\xa7\x13B\xa8\xcf'\xd4raO(\x14\x80\x9cQ(\x849\xa5Pr}N!\x16\x13{R!\xc4\xe4\xacB\xc8\xcdi\x85\x90\xea\xf3\n\xb1\xc8\xd8\x13\x0b!&g\x16BnN-\x84T\x9f[T\xea\xce\xa2}\xae\xee,\xc6\xe3\xea\xceB\x16;\xb0lW6\xb0\xac\xaf\xee\xad\xb4l.\xef\xad\xb4L\x16\xc1l\x99\xacx\xd9\xb2>\xc9\x10br\x96!\xe4\xe64CH\xf5\xa9\x02\xaec\xf6\\\x01\xa5\xe4d\x01\xc5\xe6l!\x0b\xc7s\xbb\xef\xe7\xdb\xf3N\xf8).jQ\xd0l\x92$\xbd4\x8f\xb2Mf}U\xa2\x07\xa0=(Y}\x9fy\xe1U\xfd\xf8\xb3\x9b~\x0c\xe3\xab?u\x89\xbf\xc1\x91K\x02\x84\xbf\xc6N\x10\xff\xbb\x02\xfd\xec/\xfd\xe3\xd0eThP\xb0\xcb~\x1a\x87!\xa3\xfc\xef\nt\x95}\xaa\xae\xacWo\n\xf4\xc6@W\xdd\xf9\xea\xca\xbb\xfff\x80o\x1cx5#\xad\xae\x85\xb1\xbeY\xe8[\x01z\xd5\x8e\xa9\xae\xdc5o\x06\xf8&\x80\xfeg\xf7'\x18\xe3\xcb\xfe\xf9\xbdz\xed\x1e\x7f\xef\xe7J\x05\xa0?=}s\x00\xcdr>] e\x1f\x9f\xab\xfb\xf7s{8\xdcZ\xefcS\x9d\x9ap\xfd<WMn\xc7\xe6Un~\xc0\xf6uj\xaf\xa1u\x93[\xa5\xf6-\x08\xb0}\x97\xda\x1bh\xads\xbf\x1b\x01\xafs/W\xd8\x9c\xd5\xafqHY\x8d\x18i\xee\xfb\x16G\x9au\xef\xa0\xf9|\xcd]\x8f\xf7\x87\xf3[\xf6l8\x0b\x89\xbf\xc9\xdd\xf1\xf9\n\xc0p\x18\x12\x7f\x15;\xec\xf3[\x0e\x8c\xd3\x18\x1e\xa0\x95Z\xe1\xd4+P\x9cn\xc5\xf1\x06\x04\xe5-\x87\xd9[\xa1F\x84\x8dF\xd9`&\x84\x85\x95\xb4\xf0@M<\x08\x1b+e\xe3\x81\x19y\x10V\xd6\xc2JMl\xd4ha--\xd4V\x7f\x8d\xda7R;\x0fF\xad\xa2\xb1Q6h8j\x15\x8f\xad\xb2\xc4\x0d	;[m\x87\x9a\x11Vv\xc2JCl4ha'-4V\x7f\x83\xdaky14t\x10\x8d\x1cD\xad\xae\x8b\x86\x8d\xa2\x91\xa3\xa8\xe5\xe4]\x11++aC\xcd\xdd\x95\xb5\xb0\x12\xfae0\xd6D\xffZ\xe8W\x91X[\xfdkq\xedI?1/\xc9KO\xf9\x88xH^\xdb\xf2\xa2\xd8\x12\xfd[\xa1_]\x15[\xab\x7f+\xael\xe9\xff\x1d\xd1\xbf\x13\x97\xb5\xf2\xff\xce\xea\xdf\xa1\xfe|\xab\xf5\x17\xc3\xf9j\xae\x84t\x07\xbe\"\xda_\x07\x00\x0f\x17AB\xcf\xfa~\x1d\xda'h\x97\xf7\xe3G\x90\xa8{\xfa`\xee\xe9\xc9\n\xbb\x87'S\xb52%\xc4\x8f f7\xfb\x81\xdf\xec\x93er_O\x86\x1bmX\xd8m\x8c]a\x96\xdd\xff\x93Uv\xafOfW\xda\xec\x83\xb0\xbb2v\x1f\x84a\xba($\xcb\xf6\xfe\x9f\xec\xae\x95\xdd\x1a\xad\xae\xb5\xd5\x1am\x92e\"Y\xa4kB2\xba\xd1FUt7\xc6\xae\n/_=\x92u\xb6N$\xe3[c\\\xd8\xdeZ\xdb\xc24]P\x92e\xbbv$\xbb;e\xb7A\xab;m\xb5A\x9bd\x89\xc9W\x11[O\xf2e\xa4/\xd9F\x8e\xb66\x17n#\x87\xcb\x97\x9el\xdd\xae2\xd9\xb6\xbe\x94V\xc2\xb2\xb9\x94V\xc2.Y\x8e\xb2U\xbb\xf6d\xab:\xbeka\xd5\x84w-\xac\x92E*Y%+R\xb2\xdah?\xcb\xbb\x86\xf1\xb2\xbck\x90\xa5+[\xb5\xebT\xb6\xaa\xaf\xde\xad\xb0j.\xdf\xad\xb0J\x16\xb4d\x95\xac^\xc9\xeaJ\xc7u\x87VW&\xae;\xb4\xca\x96\xb9d\xd5\xaei\xc9(\x88\xf4z\xf5\x88B\xb3\xf6\x0dl\xed\x0b2\x9f\xc0^u?\xbb\xd3|\xf1I\x00\xb2\x0d\x12\xfe\x14\xd8=X*0\xa4\xe8\xce\xed\xdc\xef\xdfS\x95u\xff\xbb\x97\xfd\xe8\xaf\xdd!\x8b\xdc\xaf^\xd2>^\xc6\xe1e\xee\xb20\xb6x\xf9\xd4\x0d\xed\xdc\xff\x04yl\x89V\xfb\xfd\xefoY\x1a\x9f{}\xfbw\xe8\xcd\xed\xf70\xac\xfe\xe4\x07>\xc7?\xfa\xf4\xeeu?\x06g\xba\x9f\x9d\xeb\xe0q\xb9?\xbd\xfb\x87\xb9\xb0\x15\x88\xe0\xfb\xefb\x99\xefO\xd5[\x00\xaa\x9dA\x7f\xaa\xae\xef\x91\xac9\xf3;\xee<\xfaS5\xbd\xcb-G\x7fr\x1fF\xd0\x1a\x87w\xd44u\x97\xfeW\xe7\xc7\xe7\x7f\x86\x80\x06\xe1[\x94\xfc\xec\xa6\xb9\xdf\xc7,\xd6 \xbdF\xe9\xf38\xf5\xbf\xc6\xd3,\xe5Q\x9a\xf3;/\xcf\xed\xc1U]\xbeV\xfe\xc7o\xf7_\x9a\xf3\xf5\xcb\xfa|\xfdr\xffezzl\xfft\x7fw\xfb\xefk\xfdgdT\xc7\x83$\xdd\x08;Kj\xfe|WRx\xbf\x93\x1a\xdd\x07q@c\xbd9_\xbf\xac\xee\xad\xce\xfa\xa6\xf3&t\x88e\xa5\xfd\xe9\xe4>\xac\x92\xf4\xf6\xa7K7\x7f)\xf6i+\xe9\xe3\xcb\xec\xb2-E\xc7n\xff\xad\xceWO\xdc4w\xf5z}\xd74\xf7w_7\x92\x1c\xbe5\x93\x989\x96!].\xb4\xc7j\x14\x9f\x0e\x81\xa0W\xc7\x03\xd3\xf0\x07\xe2!\xd5\x0fOL\xfd\x1f\x0b\x8e\xb4\xe0bd\x8d|2RRW\x08\x18\xeb\xf2\x87a\x93\x9an\xd1\xb2j =\xdc\xe7\xd4\x85\xf6\xf8Y\xcfO\xc7P\xd0o1$\x1a\xfe@\x0c\xa5\xfa\xe1\x89\xa9\xffc1\x94\x16|\x0c\x8d\x91O\xc6P\xea\x8a1$]\xfe0\x86R\x93\x8b\xa1Q\x93c\xe8?|\xe2\xd6F\xff\xe3\xd0\xbe\x8d/\xb8n\xf8f\xbf\x06\n\x08\xac\x83sw\x0d	\xf9\xee'\xf7\xed\x02\xc8\xcaw\x8d\xe1+\x0c\x00\xc0O0\xb8f\xb7` \xc25\x00 |\xf3\x01!\xa1	@P\x8d\xe6\xdd\x7f^\xd6\xd4\xa7q8\x9f>\x1d\xbf\xb8\x0c\x95h\x9c\x14\x0b\xd0D\x10\xd6\x9cQ\xa0)b\xb0\xba\x8c\xc4D\x04\xd6\x91I\x88(\xc4\x8a1\x99\xee3\xd6\xe3\x97\x8f\xa16\x8c\x82\xe4n`\x15\x18\x0d\xca\x03\xc2z/\x0e\xe5\xca\xbc$\xe9\x0ft\x08\x14tI\xfe\x80\x1a.\x12\x93\xdd\x01\xd5Z\x04$\x02\xb0.K\x04D\x19V`Id\xe1\x0b\xac\xb5\"\x11\xe0\n\xa8\xaa\xa20\xe0	\xa8\x9f\xe2@\xb2lJ\x84a\xa5\x14\x03\xcb\x83\x86\x9a(\x1a\x95\xa2\x08\xd5O\x00\x93\xfa\x03uNP\x85\x18=V41 p\x00\xd4.\xb10\xf0\x01T)q8Y\x9c$\xf9\x00\xea\x91\x18X\xf6\x01T\x1e\xd1\xa8d\x10j\x8c\x00&\xf7'W\x13A\x15\xd2\x07P7\xc4\x80\xc0\x07P!\xc4\xc2p\x1e\xe4Z \xf1\xba\xc9%@\"\n\xab~hT2\x89\xf5=\x14(A\xa0\x92G\x86\xa4\xcb\x0bjv\x80\x021|\xac\xce\xa11\xb9+X\x87\xc3\xa0\xf2\xb0\xb0\xe2\x86\x83a\xa1\x8d4v\xa8\xad\xa1@\xc9\x1eV\xd1\x90\x98<\xb6\\/#!\xd2\x0c\x83\xca\x18\x99.\xc6\x8d50\x14\x04\x86\x0d\xd5.4\x08F\x0du-\x1c\n\xcbY\xa4HA\x05\x0b\x05\xca\x01\xdf\xe7Z\x15\x12\x93\xfc\x02U)\x12\"\xb9\x04\xeaOd\xba\x1c5T\x9aP\x90\xdc\x0d\xac)\xa1A0j\xa8\x1e\xe1P\xb2hD\x1a\x13\xd4\x890\xb0\x1co\xa8\x08\xa1Qi\xa6B\xed\x07\xc0\xa4\xa1A\x95\x07T!\xc6\x8f\xf5\x1c\x0c\x08<\x00\x95\x1b,\x0c|\x005\x1a\x1cN\x96fH\xa3\x83j\x0c\x066\xe5\x19\x9d\xeb.hT\xea?TX\x00L\x14c-\x05T!|\x80U\x13\x0c(w\x07\xeb#X\x18\xdc\xf0\xa0\x12\x82\xc7A\x01\x84tAB\xcd\x03\x05\xca7|\xa8n 1\xc9\xddP\xc7 !R?\xa0bA\xa6\xab\xe5.\xd7&P\x10\xbc\xd1\xe7*\x04\x1a\x84K]\xae7\x10\x1ez\xfc\x0d\x02\n\x1d\xfa'\x1fO0;H$\xe1\xe7^\xe2\x94\x81\xed$BEYC\xc1\xc0\xbd%gL\x92\x80\x1bMJ\x90p\xdcuj\xb8D\xe2\x16\xd4(\xc6\x0f\xfe\xc4\x99\x06\xfbQ\x8e\xd7]\xc7\xcdi\x81\xa1\xdd\x83;U\xa4\xf8\xba\x84\x12\xfa\x838\x1e\xcb\x11J\xbf\xc3\x1e\x96\x12\xb4\xdbaC\xcb\xf0\x12\x8d\xbb[\x85\x96@\xdc\xeaj\xb5\xc4\xe7\xb8\xef\xa5p\xe3r\xd8\x04s\x82\xf18\xec\x88\x91\xa1\xea	\n\x0en\x8fK\x1c\xedO\xd8+\x17(j\xba\xc1\xc6\xd9\x12\xd4\x18`\x17M\x94\x13\xc7\xe2\x96\xba\xc40\xbe\x85\xfdu\x91c\xdc\x0b\x9bm$\xa9\x82\x80\xd2\xbd\xb0\xf3.q\xb4{a\x1b^\xa0\xa8~\xc1\x9e\xdc\x12\xf4\x18\xf2\x06\x9d(g\xee\x85\xddz\x89a\xdc\x0b[\xf7\"\xc7\xce\xde\xbc\x8fG\x92\xac\xe8'(\xb8\xa9/PT\xcfp\x87\xcf\x19\n\x0f\xdb}\x83\x97P\xdc\xfb[\xd5\xc4\xb3\xf8 P \xe8\xee\xe3SA\x89\xa2\x9d\x84\x8f\x08\xc8\x11%\xf9\xa4[\xe1y\x813T\xb7H\xf5\x7fI\xd0\x9e2E\xff\x13\\\"I\xad\xff\xac\x98\xb8\x94\x94\xf8Wx\xe3Q[\xd9_3\x8cCmA\x7fG!\xe5\xfc\xe3,\xb2u\xfc\x15CO\xd3\xbd)\xdf/	\xca\xff\xb6j\x7f\x82+\xd7\xdbb\xfdY1s\xa8\xad\xd1\xaf\xf0\xba\xeb\xa44\xbff\x18\x87\xda\x8a\xfc\x8eB\xeb\xf1G\x0f\xd9B\xfc\x86\xa3g\xa9\xad\xbf\xaf)\x92@\xca\xee\x03A9\xcaV\xdbG\xe5\xc4\xb5\xa4\xc8\xbea\x18\xe7\xda\xda\xfa\x96c\xdckK\xea;\x12-\xa8\x1f}e+\xe9\x1b\x8e\xea\x1b)\xa0\xaf)\xca\x01\xb6n>\x10$\x96\x94\xcbG\xe5\xc4\xbd\xa4J\xbea\xe8!\x90\xe2\xf8\x96c\xd6-[\x13\xdf\x93lE\xfcxW\xb3\xa5\xf0\x15Co	l\x05|IP\x01\xb7\x85\xef\x13\\\xf5\xdd\xd6\xbb\xcf\x8a\xe9^\xcb\x94\xb9Wx\xbb\x150\xd5\xed5\xc3\xee\xb3LQ{\x7fK6%\xed=\xa1T\xcb>\xdcv\xf2\x97#\xe3\xdc\xb6%\xec\x1d\x94\x14\xb0\x8fS\xdbV\xaeW\x8cI\x12H\xc1zI\x90pR\xa7>\xc1%\x92\x94\xa7\xcf\x8a}\xc0\x04\x9eT\xa5Wx\xdduR\x8c^3\xb4{H\x0dzG\xc1\n\xf4)\xb6\xc4\xf1\xb6\xf0|\xf4\xbb\xad8/	\xda\xed\xb6\xd0\xbc\xc0K4\xa9/\x1f\xd1\x12H\xca\xca'\xb5\xc4\xe7\xa4\x9a\xbc\x84\x1b\x97\xdb\"\xf2\x8a`<nk\xc7;\x06\xad\x1c\x1f\xef:\xb6d\xbc\xe1h\x7f\xdaJ\xf1\x9a\xa2\xa6\x9b-\x10\x0f\x045\x06[\x17\x1e\x95\x13\xc7\x92r\xf0\x86a|k\xab\xc0[\x8eq\xaf-\xfe\xeeH\xb4\xf4{\xba\xa9\x9b\x9a\xef\x86\xa3\xddkK\xbdk\x8a\xea\x97\xad\xf0\x0e\x04=\x06S\xd8\x1d\x953\xf7\xdaz\xee\x86a\xdck\xcb\xb8[\x8e\x9d\xbd\xa6z{\xbc\xc5\x98\xda\xed\x81B\x8a\xb6k\x8a\xea\x19\xa9\xd5\xae\x18\noK\xb4g\xbc\x84\x92\xca\xec\xa0\x9ax\x96\x14d\xd7\x04\xdd}R\x87\xddP\xb4\x93H\xf9u\xc7!\xc5\xd7\xa3[m\xd5u\xc5P\xdd\"\xc5\xd6%A{\xca\xd4XOp\x89$\xa5\xd5\xb3b\xe2RRQ]\xe1\x8dGm!u\xcd0\x0e\xb5\xf5\xd3\x1d\x85TO\x8f\xb3\xc8\x96MW\x0c=M\xf7\xa6Z\xba$(\xff\xdb\"\xe9	\xae\\ok\xa3g\xc5\xcc\xa1\xb6$\xba\xc2\xeb\xae\x93J\xe8\x9aa\x1cj\x0b\xa0;\n-\x7f\x1e=d\xeb\x9e\x1b\x8e\x9e\xa5\xb6\xdc\xb9\xa6H\x02\xa9r\x0e\x04\xe5([\xdc\x1c\x95\x13\xd7\x92\x9a\xe6\x86a\x9ckK\x99[\x8eq\xaf\xad`\xeeH\xb4~y\xf4\x95-\\n8\xaao\xa4^\xb9\xa6(\x07\xd82\xe5@\x90XR\x9d\x1c\x95\x13\xf7\x92\xa2\xe4\x86\xa1\x87@j\x91[\x8eY\xb7l	rO\xb2\x05\xc8\xe3]\xcdV\x1eW\x0c\xbd%\xb0\x05\xc7%A\x05\xdc\xd6\x19Op\xd5w[^<+\xa6{-SU\\\xe1\xedV\xc0\x14\x13\xd7\x0c\xbb\xcf\xcaOz\x0e{\x0d\x9f\xf7w\x7f\xf0\x8b	6Nz9\xa2t\xa7\xc5\x8f\xed\xa5\x03@-\xa5\xc3\x13\xcaD\xd5Poz\x10r-n\x94\\\x89WJl:\xb7\x16\x80\xc6\xe8\xdf\x08y\xce\xc4\xe8\xe7v\xe8\xf7A\xe4j?\xfa\x16/\x9d\xc6c{B\xa1\xff@\xbe\x17\xbe\x9c\xcf\xdd\xb4\xbf9\xc5Yp\x8f\xc9?\xc6\xe9\x98\x05\x1e7\x8c\xaf\x1c\x97\x04\x1e\xb7o\xcf\xce\xf6/\x03\xcc\x12\x8f\xf4\xbd\xa8\x98\xce\xfcw\x8c/\xa7C\xe7\xcb\xc28\xc8\xa1\xdb\x8f\xbe\xa8L\x96\x84\xfe\xf5\xa7\xae\x9a\x9f\xa7\xf1\xe5\xe9\xd9`Q\x18\x8dWe\xd5\xd9\xbc\xab\xb53\xf4\xed\xa5;\xa4\xe4u\xef\xc8\xe38\xce\xcf.q=C\xbeW\xc7\xf1W5^\xae\x1a\xf34\xb5o\x97}\x1b\xb3\xf3//\x8f\xe7\xfe\xda\x0d\xd5g\xd4\xbf\xcccQo\xfek\xcepj\xe2\xc3\x1eNJ\n\xd3!@\xdd\xac\xb0H\x9c\x1b\x01\x99fB@/O\x94@J\xd3\x82\x93\xd4\xac	\xa4<E8KO\xa1@\x83\x99\xc4y\xe6\xef\xd3S\xec\x11_\x9e\\qL0\x8d\nD;\xd3R\x1f\xab\x8f\x8c\x9a^\xc2\xf4\x08\x94\xbf\xdf\x1c\x0c&\xd8T\xfc\xc0\xd6\xe7&d\xb8\xb9\x87	\xe9o\xe8\x85	\x19\xa0~B\x1a$N\xc8\x80\xcc\x13\xd2\xa3\x97'd \xe5	IIjB\x06\x12LH\xca\xd2\x132\xd0pBR\x9e\xf9c\xfb<7\x00_\x9e\x90qL8!9\xd1N\xc8\xd4\xc7\xea#\xa3\xa6\x978I<\xe5\xef7!\x83	:!\x97m}nB\xceS\xbb\xff\xbd\xcf%d\xbay\xee\xa6\xearn\xf77\\\xf5\xf5~\x93\x96\xda\x88\x8c\x85d$\xf4^\xa1^\xfbC\xa71\xa0\xed\xd2\x0d\xdd>\xd4\xae\x8b#x\xb9\xdc\xb0N\xe0\x9c\xec\xbbOZ/\xb6Q7\x08+\xb7\x08R+7\x81\xb5\x12Z/\xb6Q7\x84\xe5\xd0\x15/L\xf55c\xa2V\xf8\x93}Yb\xd3c\xe7\xf1\xaca\xf3xF\xc4\xb1?\x1c\x06\xa3\xcb\xb7\n\xab.\xc5\xcc\xd8t\xad\xc2\xa2\xbb\xd2\x88\xd9\xd0n\xb0\\1\x88<#~r\xc7\xfd\xdb\x0f\xfd\xac>\xb9\xd3\x9f\x08\x02\xbf\xf4\xe3\x0e\xba}\xc1\xc70\xb1\\\x8b\x9b3\xf26'\x90\xbe\xc0\xaa\x84\xe6\x12\xab\x00=O\x9d\x80\x9d\xa7\x8ea*\x17:\x05\xacr\xdc\x14\xdaX\x8f\x8d\x1e\xfd8u\xed\xef\xd5\xeb8\x1d.\xef\xb7\xff\xf7E`s+\xa2\xe2\xa8\x13\x0c\xc7<O/\xa7};w\xfakP\xdf] Rc7\x0c\xfd\xf9\xd2_\xbe\x17\x1dR\xd5\xef\xfe[\xcb\xb8{~\xad\x9a\xd8\x8a\x8d\xab\xd8\xf8\x80\xad\xeb\xd0ZC\xdb&\xb6I\xad\xdb\xd4\x8c\xad\xbb\xd0\xda@[}\x1f\x1b\x05\xb4\x8e\xfdZacT\xbb\xc6\x01\xc4nmq\x00\x91\xbe\xc3\xfeG\xfb5v`\x9b\x86\x85\n\\\x86\x90o\xcf\xb7\xca\xd7\xea|}W\x95\xab_\xab\xfa?\xfe1Z\xdb\xc4\xafX\xbb\xd6\xe8\xc5\xd5\xea\xeb\xea\xf6\xbf$k\xb2l\xbb\xfd\xba\xddn\xb7\x0f\xc8\x8b\x1dj6\xa9u\x95[\x1f6\x88\x8d\xfeo\xeeQ{l]\xdf\xa3\x86\xd8\xba\xcd\xad\xeb\xdc\xba\x13}O\x01\xd4\xfd\xdbd\xd9N\x8f\xcb}\xd4[\x7f\xd0\xfb5~'<	~\x86\xf2\xcf\xbf\xaa\xfb\xf7_U\x7f:t\xd7\xb8x\xfc\xba\xcd\x87\xd8V\xa7\xc6&76\xa9q\x95\x1bW\xa9q\x9d\x1b\xd7\xa9q\x93\x1b7\xa9\xd1E86\xbb\x18\xff\xd7\xff\x0b\x00\x00\xff\xffPK\x07\x08r\x89\x1f\x89\xab\xc2\x00\x00!\x8f\x04\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00i\x02\xfdL\xbf\xea\xfa\xb8&\x01\x00\x00\xcf\x01\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x00\x00\x00\x00index.htmlUT\x05\x00\x01\x06\x08][PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe7\x00\xfdL\x00\x00\x00\x00\x05\x00\x00\x00\x00\x00\x00\x00\x08\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81g\x01\x00\x00main.cssUT\x05\x00\x012\x05][PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xc6\"\xfeL\x97\x86#$\xe4\x01\x00\x00I\x04\x00\x00\x07\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xab\x01\x00\x00main.jsUT\x05\x00\x01t\x92^[PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xc6\"\xfeL1\xf1\x06u\xca\x01\x00\x003\x05\x00\x00\x0b\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xcd\x03\x00\x00main.js.mapUT\x05\x00\x01t\x92^[PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xc6\"\xfeL\x1as\xd7)\xcd	\x00\x00y \x00\x00\x0c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xd9\x05\x00\x00tailwind.cssUT\x05\x00\x01u\x92^[PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\x00\xfdLr\x89\x1f\x89\xab\xc2\x00\x00!\x8f\x04\x00\x10\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xe9\x0f\x00\x00tailwind.min.cssUT\x05\x00\x01(\x05][PK\x05\x06\x00\x00\x00\x00\x06\x00\x06\x00\x8a\x01\x00\x00\xdb\xd2\x00\x00\x00\x00"
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00i\x02\xfdL\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00index.htmlUT\x05\x00\x01\x06\x08][\x84P;\x8f\x1b!\x10\xae\xbd\xbf\x82L\x1d\xbcq\x97\x02\x90,\xe7\xd5\xc5Rl\xc5Wb\x18/s\x07\xec\x1e\x8c\xed\xf3\xbf?\xed\xee\xbd\xba\xab\xe0{\xea\xd3\xa8/?\xfenvw\xdb\x9f\"p\x8a\xa6Q\xe3#\xa2\xcd\x9d\x06\xcc0\x12h\xbdi\x16*![\xe1\x82-\x15Y\xc3~\xf7K~\x877>\xdb\x84\x1a.\x84\xd7\xa1/\x0c\xc2\xf5\x991\xb3\x86+y\x0e\xda\xe3\x85\x1c\xca	|\x15\x94\x89\xc9FY\x9d\x8d\xa8W\xcbo\xef=\x81y\x90\xf8x\xa6\x8b\x86\x83\xdc\xaf\xe5\xa6O\x83e:F\xfcPJ\xa8\xd1w8\xc5\x988\xa2\xf9\xddo\xff\x1c\xc4\xff\x7f\xaa\x9dq\xb3P\x91\xf2\x83(\x185T\xbeE\xac\x01\x91A\x84\x82'\x0d-[\x8aW\xca~\xe9j\x85O\xdd\xc9R~q\xaav\xbe\x87:\xf6\xfe6\x06\xc3J\xb8hk\xd5p\xea3\xcbjs\x15\xd3/\xa1\xa7s\x12\x8cO,c\x07f\xed\x1cF,}B\xc6\xa2\xda\xb02M\xb3PCAA^\x83\xb7l%\xdb\xd2!\x83Q\xedPp\x92\xab+4\xb0\xa8\xc5\xbd\xce\xb8\xaf\xa3>\xf3\xa6Q\xed\xbcC\xb5\x81S4\xcd\xf3\x00PK\x07\x08\xbf\xea\xfa\xb8%\x01\x00\x00\xcf\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe7\x00\xfdL\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x08\x00	\x00main.cssUT\x05\x00\x012\x05][\x03\x00PK\x07\x08\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00U\x86R]\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x07\x00	\x00main.jsUT\x05\x00\x01\xe2\xf8\xd4j\x84RMk\xdbL\x10\xbe\xfbW\xcc\xbb\xc9a\x0dze\xe8\xd1F\x14Z\x02i\xc9\x07$)\xbdz#M\x94M\xe5Y\xb13\x8al\x8c\xfe{\xd9\xd5\xdaq\x9c\x98\xce\xc5\xb2v\x9e\x8f}\xf4\xa8\x8e\x11X\xbc-E-&\x0d\n\xf4\x0c\x05\x10\xf6\xf0\x1b\x1f\xef]\xf9\x07E/\xcf\xb7\x8d+\x8dXGy\xeb\x9d\xb8\xd25P\x14\x05\xa8g\x91\x96\xe7\n\xbe\x82\xea\x99\x15\xcc\xc3\xaf\x1a\xe6\xb3\xd9\x01\xe4\xd9\xb1\x0c\xb3\x9e\x97\xd3Q\x81\x91\xd8y(\xe0>>\xe8\xf4\xba2bn\\\x85P@\x8dr\x87T\xa1\x7f0\xbeF\xd1*\x9c\xfd/\xf1\x8fJ\xeb=_\x1a\xaa\x1a\x0cD\xdb	\x00\x80\xa3kd65j|\x95iz\x19f\x14\xcc\xbb\xb62\x12\x0f\xf3\xc07]\xec\x17v\xd2\xb9%B\x7f\xf9p}\x05\x05\xfc\xbc\xbf\xbd\xc9C4T\xdb\xa7\x8d\x1eI2\xa0\xaei2\xf8\x92\xd0C\x96\xa4\xbf7\x8e?\x08\x9b\x06\xbdh\xb5O\x12JG\x84eH\x05\xca\x00\xa8T\xe2	S:b\xd7`\xde\x1bOZ\xf5<\x8f+*\x83p\x9d#\xb9\x0b\xef\x9d\xff\xa7\\\xdc\xfaL\x02\xf7\xf0=\xef\xb0\x98\xf4\x9c\x9b\xaa\xbaxE\x92+\xcb\x82\x84^\xab\xd5\x98\xa9\xca\xde\x12\xcf\xf7IOO\x80v\xce\x0f!1\xa1S\x80h\xe8\x08\x10\xddO\x17\x93\xa7\x8e\xc6\xc8\x8eka\xab]\xd8\xa1\x104v\xa7re\xb7B\x92\xbcF\xb9h0<~\xdb\xfc\xa8\xc2\xf2xW\xfb\x04\xfa\xbf\xb0|\xf8\xa5\xe4\xd9\xbb>\xd6>\xaa\xea\xe5\xca2[\xaa!\x94!\xd1\xc0\xd9\xf9\xd6V\xc32\xf1\x0c\x11\xecQ:OQ|1\x19\xde\xbc\xa6n\xbfs\xb8\x86\x02n\x1f_\xb0\x94\xdc0\xdb\x9a\xf4v\xc8`\xe7+1\xbdyJ}\xdd\xec(v\xf3\x9eb\x9d\x8dMm\x8dg\xd4\x9bib;hK\x18qaI\x1fS%\xcd\xf5\xa7\x98\xd2\xb5\x9bS\x88t\xbd\xf5G\xb5!\xc40\x9b\x9d\x01\xbb\xce\x97xm\xda\xd6R\xfd\xeb\xee\xaaX\x19K\xf9\x0b\xe7+\xd3\xfe\x1d\x00PK\x07\x08\x88\xac\xeb\xd7\xfe\x01\x00\x00w\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xc6\"\xfeL\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0b\x00	\x00main.js.mapUT\x05\x00\x01t\x92^[\xa4S\xc1n\xdb0\x0c\xfd\x17\x9d\x85\xe40\xac\xc3\xec\xd3\x13\xed\x04i\xd6\x15^\x17`\xc5\xd0\x83\xe1z\x89\x8b\xc6\xce\xec,];\xec\xdf\x07R\xb6\xa9\x18\xeb\xa9\x17\x81\x92\xf8\x1e\x1f\x9f\xa8?\xe6T\xb6]\xd5\xd4&zg\xcd\x8f\xea\xb14\x91\xd9\xe7U={\xe8\x8c5]\xf3\xab-\xca/Ms4\x91\x19\xf7\x9d\x89\xbe\x9b\xd9l\xde\xb5\xc5\\r\x8f\x9d\xb9\xb3\xa6\xce\xf7rug\xcd>?\x1c\xaaz\xdb\x99\xc8\xc4\x00`W\xc0\xca\xa6@j\x97\xc0\xd2oo\x80\x1bK\x00\xd9\x0c\xc8\xfc\"[\xb9]\x03k\xbf\xe5%\x06\xa8N\xfc\xcd\x15p\xe5i$\x12\xd6!\xe7\xe8|\x8e\x90IN	\x94rms ?cL?R\xa0\x84\xb3\xe3\x15\xe8>\x10\xb6\x04*\x17g\xa0\x17\xe7\x8b\xc9\xa9F\x8c	4+y\x06\xfa\xed\x82\x96\x84R[\xd73%\x94>\xd4\xa7\x91K\"\x16vJ\xc60\xb1\xd7\xc0\xf5\xa8\xb1\xe4z\xad\x0b<\xbbp\xc0\x85;\x17\xd4R\x80R\x19\x1b`\x13<\x8dBV\xa0\x1d\xbdVr\xc3%wa\xc9\xca\xb1Y\xe7%_\\PRS\xd5\xb6\x81\xbd\xf5\xc0\x98@\x8b\xf1y\x16\xfa\xb6v\xeb\x80m\xcf.f\xca\x95Z\xa8Q\x8f\xa6C\xf2\n\\\x14M\xe0\xaar\x80\xef\xde\x04Ow\x89}v\xc0\xb3c\x96\x0d{\xf9\xd0\x0f\x9b8/\x0e\xe8x\x14@\xe1\xf1Zs\xb0\xe6\xd4\xe3t\xcc8'\xce@_\xfd$N\xbe\xcb\x93\x03\x9e\xa4l:e[\x83\xe6\xcc\x99\xf6\xf33\xf2\xf2\xf7\xba\x1f\xb4\xdf&A\xbf\x05K\x7f\xdf\xff*N\xb0?\x1d\xd0\xf4^\xd0\xc0\xcf\xa4\x1f\xfcc\xb3\xb2O\xc1\x1f\xe1%\xbe\x05}\x0e\xce4\xd2\xa6\xb5\x82\xf6\xf2\x9f\x85\xe9\x1f{w2P\"\\\xcc\x7f9}\xc4\x0c\xf4-\xc8c~\xce[\xf8\xbc\x89\x841bX\xddO\xe3:\x98F\xba\xb4\x04\x90\xf9\xfbo\x00PK\x07\x081\xf1\x06u\xd8\x01\x00\x003\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xc6\"\xfeL\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0c\x00	\x00tailwind.cssUT\x05\x00\x01u\x92^[\xccY_o\x1b7\x12\x7f\xd7\xa7\x98kq\xb8\xd6\xd5J\x96\xd3$=\x15yhR\xf7j\xb4i\x8a8Ap(\n\x88\xbb\x1ciy\xe6r\x16$\xd7\xb2\x92\xf6\xbb\x1f\x86\xe4J\xd4JrrE\x83\x9e\x9fdiv\xfe\xcfo\xfe\xec\xf4\xeco`\xc86B\xab\xb78\xa9\x9c\x83\xdb\xc7\x93\xf3\xc99\xfc\x06\xcf\xaf^\xc1\x8f\xaaB\xe3\x10~\x83\x95\xf2uWN*j\xa6\x06+\xd2\xc2M\xf7\x9f;\x9b\x8eF\xd33\xf8\x96\xaa\xaeA\xe3G\x00\xf0\xe4O\xfbK\xdc\xcfFp\x06\xb3	<#k\xb1\xf2\xe0k\x04\xad\x0cB\x8djU{P\x06\x84\xd6PZZ;\xb4n\xc2\xe4\x17\x13\xf8\xd9\xe2-\x1a\x0fB\xfe\xa7s\x9e\xb5s@KX\x92\xf1\xe0\xd4[\x04\xb1\xf4h\x81\xacB\xe3\x85Wd\xa0\xaa\x85Y\xa1\x03e\x98\x07\x00\\]\x02\x19x\xa3\x8c\xa4\xb5\x83\x9fk2\x08\xc2H\x16\xa9^\\\xb3\xa4\xe9hT\xfbF\xc3\xbb\x11\x04\xa5\x8a\xa8\xd4\x1cf\x93\xd9\xc3\xafaz\x0636\x83\x99\x01\x14\x8d+<\xde\xf9\x82\xe5\x17Q\xb19\xcc\xce\xcf\xff\x1e(/\"e\xb1\xc6\xf2F\xf9\xf7R\xfe\xce\xce\x81k\xacXw\xf7\xf1\\\xff\x12\x1b\xba\xc5\xe0\xf6F\xd8\x952C\x8f\xc3g\xd4*\xa3\xc8\x08\x8f\xf2\xf3\xe4\x95\x92\xe4&x%>3\x87\xf3\xaf\xa3\xc6!\x9c\xdfH\x19\x18V)\xa6R\xb9V\x8b\x0d;\xf6\xea\x12\xfeYLF\xb9\x06y\xe4\xb3\xf8\x19\xd9+D\x06\x16\xf5l\x01\xa81\x06z\xad|\xad\x0c,\\\xf4\xce\x82\x89Y\xeeBX\xaf*\x8d\x0b\xa8\xc8\xb0\x839\xd8\xf0\xac\xb6\xd4\xe0\x18\xbeS\x16\x97t7fj\xb8\x16KaUR\xa4\x9e\x05[Xx\x08\xde\x1c.\xb0\xf9:\xb3n\xf2\xe816[\x1b\xe1_\x96\xbaV\x99U\x14\xf31\x0b\xe3C<\xc9\xd5s/]\xb2r\xd4\x08e\x82\xa1\xbb\xbcM\x91\x99C\xa9\xa9\xba\xb9/\x86)\x16\x81!|\x95X&\xda#\n\x94t\xc7u\xc8NR\xa6w}_\xbc\xd75\xad\x035\xdd\xa2]jZ3\xcd\xa5\\\xc5\xf2\xbb\xba<\xe0\x9e\xa7\x8825Z\xe5\x85\xa9\"\xb9\xab\x84f1{\xd5\x7f\x025r>$%,\xb0Yl\x9fJ\xba\x0e\xd1f:\x1a\xb5\x16w	\xb2\x14\x8d\xd2\x9b94d\xc8\xb5\xa2\xc2\xf1\xeec\x0e	Y6\xcd\xb0\x19\x16\xf6+\x06\n\x8d\xb7\xa8\xc1a#\x8cW\xd5G,\xf2\xd9$\xaf\xf3\x95\x15\x1b(Eu\xb3\xb2\xd4\x19\xc9\xf5%*\xafn\x03\xf0\xde\xb8\x14\xe3\xd9y\x1f\xae\xf4\xe8J\xb4\xe1\xb7H\xd4\x19\x89\x9611\xc1%|\xf5EVX\xf0\xd5\x17\x07)\x92iP\x92\xf7\xd4@IV\xa2\xdd\xd5(<|\\\x04&)_\xe0A\xca\xf0\x8b\xc3\x04\xe3\xf2\x06\x89\x15\xd9\x88\xee[&\xe3\x90Ic\xb8\xba\x1c\xc3\x8b\x16\xad8R\xf0)o\xfb&\xc2|e\xd7jU	\x8f \xda\xf8\x89{\x06-aQ\x92\x96h\x17Pn\x02\xa1a\xc1\xb6\xd3\xc1\xf0d\xed\xa3}c\x87\xbar*\xc0z\xdb\xcfz@\x8az\x9eR\xee\xafO\xfb\xfb\xacq~\x13=\xf0\x8d\x91\x96\x94\x84/'\x0f\x8a\xfb\xbd\x90e\x1c\x1bP\x91&{\xa2%\x1cu\xe0)\x0b\x0f\xe3\xb9p]\x19\x9a\x02\x7fj\xb3\xc6\xb1\xb4\xd4\x80X.\xb9o\x98\xd5\x91Y\x83\xb9\x1c\xe5\x0f\x97M\x89R\xa2\xfc\xff\xc0\xfc\x0f\xa2V/\xae\xe1\xcb\xe2\xf1\xbe\x8f\xf6\xaa0\x94\x1f\x17O#\xe2d\xe4\x94\x1c\xa2\xc0 4\xdf3\xc5\x10\xbb3\xc8\x86\xef\xc86\x1f\x17\xca\x9e\x85A.\x9b\x178\x17\xdd0\x89\x0f\xe7\x16\xb8\x98\x1c\x1fwz\xb89U\x8aG\xfbU\xb4\x99\xf59\xd9\xce\xf6=\x97\x89\xce{\x18-#\x96y+\x8c[\x92m\xfa\xa7\x07\x13\xcbN\xde\xff\xc2(\xb1\xd8\xd7d\x96\xcd\xcf\xf0\x06\xcb\x1f\x94\x87\xb2[\xc1\xbaF\x8b\xf0\xd9\xc5\xe7 \xd1yK\x1b\x07F\x84\xc6\xb0\x10\x9dT\x94j\xeaVI\xa4E\x1a\xa2\xb9\x1c,i\xb7\x8f\x05G\x80G\x19Q*\xad\xfc\x06<%\xf8\xa8\xb4\xaanD\xa9\x11\xfc\xa6\x8d\x11\xe4\x9c=\x08C\x1c\xc2\x7fa\xa2'\x9f\x94\x9d\xf7d>\xf9u<\x1a\xa5o,:\xf4\xfcE\xfa\xdfue\xa3\xfc'\xbf\xc2\xbbl\xde\x16m\x8b\xc2\xb2\xa3\xe6\x10Y\x0cZ\xf2p\x1aV\xc6\xa0\xed[\x14\xab\xd4\n)\x13X\xee{u4\xd0l>/\x1az[,\xa9\xea\\\x11\xd8\x8c\x07\xaa\xdeC\xd1+\x7fH\x12\xcc\x89\xfa\x14\xc1\x7fs0d\x90\xe7\xd4\xa4\xda`\x0c\x7f\x89\xce\x93\xed\x0b\xa5\xea\\_)\x9dq\xe8\xfb~\xd6Z\xbcU\xd4\xb9\xd0\xd3R\x9e\x1cX\xb4\xd3\xc5*\xb3:0\xe7\xc4\xcf[[\xf6\x7f\x0f\x86P\xe7yz\x98\xc3\xac\xbd\x03I\xde\xa3\x84\xa7A\x1c\x8fF\xf9$\x9a\x0fm'cp\xbci\x86\xc2Z[\xd1\xb6)p\x83!s\xd8\x1c\xfb\x9e\xb4\xab\xce\xd01\x16K\x85Z:\xf4Y+\xd9!\xc0\x83\xbd\x8a\xec5t\x04\x92\xc7;j\xd1:\x10\x16\xc1\x90\x87Jt\xbc\xd4R\xe7a]\xa3\xe1\x00l\xe0-Z\xe2\xafRE\x9d\x90v\xba\xed\xbdo\xfe\x87\xd3S\xd4-\xf2\xc6$4\x08\xadV\x86\xb7\xab|@I.\x8e\xf8\x13f\xa9}\x1c\xc9\xcc\x96\xb8\x14\x9d\xce\x18\xba\xca\x92\xd6\xa5H-\xfe\xbd\x1a\xef/\x0c\xbb\xce\x03\x17G\xdd;\xecN)!\xab\x1a\xab\x9b\x92\xee24\xb0B*J`P\xd2\x1d/x\xca\xac\xe6}%\x95t\x97O\xed)|\\IG\xe0!O\xc5\xaa\xb3\x8elB2Z\x822\x95\x0d\xfbi\xf0\x96\xc4\xfe\xbf\x086\xd9&\xba\xaf\xb1\xe9\x9a\x12m\xa8\xf7t\x19\x08\x88Q\xb8V\x99\">;>MK\x9d\xdf\xa7\x0d\xb5\xd5_)D\xe7)\xaf\xa4\xc1H\xc9\xa3\xe0\x0e\x16w\n\x0e\x00\xf8`\x82\x8c\x95\x9b,\xdf\x0e\xc1\xfbf9\x14\xb6\xaa\xef\xc1`.\xcd\x90\xe8\xb9\xf7\x13\xeb\x82\x96K\x87~\x0e\xc5E{w,\x0eYB\x04om\xab\x8e5\xafX\x80>\xf4{f\x16\xcf<\x8d\xa8\xb6G\x9e\x81\xd2\xbbXD3\x8a\xc8r\x18\x8e\x93\xe4\xd9Zr\xaa\x05E\xe4>\x19\x9a?\xda,C\xac\xe2x\x14\xe6\xfb\xd62\xfex\x85\x8e\x19-\xd2\xb8\xb08\x88\xda\xce\x84\xa5\xd2Xt\xad&!\x93\xbd\x1f\xd2FS\xfcX\xe6\xbc\xc7\xcfA\xe0\xe0\xcax\xb4q\xd1\xfc(\xe3\xe1\x87^\x9e\xde\x83\x97\xbb\xed1\xdbD'\xa3\x0f\x13r|w\xb8\xae\xacj\xbd2\xab\x8f7\x16\xffiKC>\xca\x7f\xaf\xa4D\xf3W)\xbd[=~\xa9\x83\"\x11L\x92\xae\x87%\xf4\\\x98Nh\xbd\x81%\xd9\x1b\x94\xb1u_\xbf\xbez\x05\xcf\xae\xaf\xe1\xa9p8\x87\xda\xfb\xd6\xcd\xa7\xd3\xec\xf4\xed:\xe5+\xe7\xa6\xa5p\x18|\x03\xe1\xb8\xa8\xc5\x86\xef\xc7\x06<\xb5\x0c\xef\xfb\x97q_\x8bP\\<\n;\x10\xe0<\x9f\x1e\xcd\nZR\xc6CC6\xf0b\xde\xa1d\x97da\x8de~[p'g\xf2\x1c\xcc\xd2\xfc9X\\\xd3\\\x00k%}\xcd\x82\xb6v\x11MVz\xda\xfe\xdb\x97?<\xde\xc7\x83\xbcKs\x9dB<d\xdd\xbf6\x0dn\xe0\xef\xef\x9f{'2'\x8c+\x1cZ\xb5\xdc\xc3\x82\xb3\xf1\xe8l>/qI\x16\xc3\xc7x\xab\x1f\n\xe8a$C\xc9\x88\xfbn\xcf\x16\xbe\xc1\x0d\xbdE\x96=m\xa9\xb5\x8a/:\xfd\x18\x95<>\xaag\xe3\xedM\xef\xe8\x01\xfb\x0d\xd9\x1b\x10\xe9V\xd1\x8fA\xd3\xab\xcblKb\x15\xc2\xca\xd6\n\xcbA[D\xb0\\dg\x0e\xf6\xbfE\xd7\xe94\xc0\x81&\x17\xdeR\xe4\xeao\x9f\xcb'\xf4\xfd\xd4x%\x94^+\xeel\x9d\xe3\xb3Y\x98\xba\x13\xe5\x1e\xe17\x9a\xf7\xf1>{\xfa\xdc\xf1\x04\xc2lS\xa6\xdc\x00\xbf1\x19R\x15!\x95X.3z\xba\xe9\x07\xbaqPv-\xe2\xed+%	\xb8\x16+\xb5\x0c]\x85\x0b!c\xefj\xea\xb4\x84Z\xdc\xf2\xc0\xcb\xbc\x92\x16\xca\xb1h\x87\x9eq\x10\x94\xff\x87K\xbf\xc4]\x86\xb5\\pS\x0c\xbd\x89ev\x0em!V\xe1\xbc~\x96\xac\xad\x11}\xaf\xe3\x95\x81\xad}(\x1c\xa7\xb2\x90}\n\x84v\x97Lv[\x9b{\xe9\xcc~\x91\xa4\x07\xbb\xc32\x9bz\xe5f\x0ckL/\x8b\xf6\"\xb5\xa7nH1\xadw2X\x7fGZ\xc9\xc5\xb8\x7f\x1d\xd1\xb9\xfe\xc6\x12\xa50M\x9d\xce'\xbc\x9c;\x8fBN\xe0U\xad\\\xf00u\xb6Wk\x01\x9d\xe7e\x99]LFoX?\x83(\x99\x05\xefn\x87\x16l\xd5\xef\x19\xf7\x89\x86\xc6+\xbb\xa5\x0e\x96\xba\x9a\xac\xaf\x85\x91ch\xc4\x0d\xc7\x83E\xa7@\xed\x047]U\x07(\x03\xe7\xad\xe0\x0b\xd9\x92\xecZ\xd8\xa0EEMK\x0e\xfbh\x1c\x01V\x9f\xd2\x96\xc15\xff\xdcvZOg\xb3G1u\xef\x05\x83]\x84B\x81\x0e\xd7\xdf\xe0\xef\xec\xeb\xb0\xbe\xcd\xe1S)p\x86\x8fs\xe0xm$\xed9-[\xa0\x17\xa9\xa0B*\xff\xd4\xc3<\x03\x88NsS:\x8f9f!<{0\x0bU\xf1.\x04\xe0\xf7<d!\xfdY\x1c\xde\xb5X\xf1j\x8b\x01\xbb{o\xbd\xaa1\x93\x14\xc5+\xde\xcb\x0dV\xe8\x9c\xb0\xa1\x89\x01\xe3\x81\xe2-t\xbd\xc3\x8b\xdd\xe1\xae\xd8\xa2\xbf'8\xe7\x916O\xc8?\x10\x18\xe5\\\x87n\xfa\xe0\xd1\xc5>\xfc`\xd3\x92e\x9d\xa2\xa2!\xf5\xfb\x02Q\xc6[\x92]\x852\x1b\xb4\x1f]\xf0l\x08\x86\xd6\xc0-\xcdz\x94\xbd:o\x90\xe7s\xb0\xfd\x00\xaf\xdcv\x11\x8e\xb4\x8e\xcf\xee*\x01fl\xba\xfd\xfcnQ\xa3p\xfd\xfe4\xbcQ\x8c?\xe8\x1c\x94b\xc6Ka\xe7\xfa{\xc9\xe8\x17K\x1a\x9fD$\x8eSF\xdc\xee\xe6\xb1\x9f\xa3\x0dd\x93\xd0\xdc\xb8\xa9\x1d\xbe\x0dr\x1b\xe7\xb1):5\x86\xa7\xfc\x82\xe4\xb9\xa8\xae\xc3W\xdf\x91\xf1c\xe0\xb9Yc\x11\xa9\xc6p\x8d+Bx}5\x86\x97T\x92\xa71\xbc\xb8\xdb\xac\xd0\x8c\xe1u\xd9\x19\xdf\x8d\xe1\x990^X\xd4:\\\x01\x05\\\x0b\xe3\xc6\xf0m8\xb5\xc7\xcf\xdf\xa3\xbeE^\xde\xe1'\xecp\x9c7\xdb\x9d\xb2\x0dJ\xd55;u\xd7i3|x\x1e_fNx\x0d+\xf4\n\xde\x0d\xdeXMf\x17\x0f-\xbf\x04\x0d\xcd\xf7Sp\xd4\xd9\n\x9f\xc7K\xca\xeb\x97?>\xe9+zR97iD\x0bg\xd3\xff\x0e\x00PK\x07\x08\x1as\xd7)\x02\n\x00\x00y \x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xe2\x00\xfdL\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00	\x00tailwind.min.cssUT\x05\x00\x01(\x05][\xac}\x7f\x8f\xeb\xba\x91\xe5W\xe9\x9dA\x80\xbc\xc0r\xfc\xbb\xddn,0H\x06\xb3;\xc0d\x16\xd8\xcc\xcc?\xc9\xfbC\x92\xe9\xb6re\xcb+\xc9\xb7\xed\xe7\xf1w_\x90\xaa\"\x8b\xc5*\xf5M\x90\x04x\xb7\xcd:\xa7X,R\xb4\x8e(\x93\xbf\xfd\xcd\xffx97\xed)\xaf\xab_\xcc\xb4\xec\xba\x97\xef\xaf\xd3\xd9t\xf6\xf2\xdf/\x7f\xf8\xd7\xffx\xf9\xb7\xaa4\xe7\xce\xbc\xfc\xf7\xcbG\xd5\x1f\xaf\xc5\xb4lN\xbf=\x9b\xb2\xa9\xf3\xee\xb71\xef7\xbf=\xf6\xa7\xfaQWg\x93\x1dM\xf5q\xecw\xf3\xe9|\xfd\x9e\x9d\xba\xac7\xb7>\xeb\xaa_L\x96\xef\xffr\xed\xfa\xdd|6\xfb\xd5{\xf6i\x8aoU/[\x9fE\xb3\xbf?Ny\xfbQ\x9dw\xb3g\xde\xf6UY\x9bI\xdeU{394Mo\xda\xc9\xd1\xe4{\xd3N\xce\xf9\xf7Ig\xca\xbej\xce\x8f}\xd5]\xea\xfc\xbe+\xea\xa6\xfc\xf6<\xce\x1f\x87\xe6<T\xbd[\x98\xd3;\xf8\x9bn^\xcd\xe9e\xf6<T\x1fe~\xb1\xc4\xc9\xa1\xfa\xb8\xb6fr\xca+\xeed\xb0`(sszY\xcd.\xb7\xe7\xb1}\x14\xcd\xcd\xba\xae\xce\x1f\xbb\xb29\xf7\xe6\xdcgEs{\x87\xd6\xcf\xde\x9b\xef\xa6=\xd4\xcd\xe7\xee{\xd5UEm\x9e\x97\xd6\x0c\x01\x1d\xf2SU\xdfw\xa7\xe6\xdct\x97\xbc4\x13\xff\xd7{\x08xnN\xcf\xfcQ\xe4\xe5\xb7\x8f\xb6\xb9\x9e\xf7Y\xd9\xd4M\xbb\xeb\xdb\xfc\xdc]\xf2\xd6\x9c\xfb8\x85{S6mn[\x93u\xdf\xaa\xcb\xae)\xfeb\xca\xbe{\xe6E\xd1\xfe\xa9\xaf\xfa\xda\xfc\xfc(\x9avo\xda\xach\xfa\xbe9\xed\xce\xcd\xd9\xbc3\xee\xeez\xde\x9b\xd6v\xa3\xe6= ^\xf6M\xdf\x9b\xfd\xfbW\x80g1\xe9\xfa\xb69\x7f\x0c\xad\xff\x1c\x12T\x9d\x8f\xa6\xadz\xd9X4\xf5\xde\xb4\xcf\xb2\xd9\x9b\xc9\xb7b?\xe9\xf2\xd3\xe5\xaf\xcc\xdd\xfep\x1e\x18]\x7f\xaf\xcd\xae\xea\xf3\xba*\x9f\xa7\xbc\xfd\x96&\xf5\x1f\x0f\x87\xd9;\xfc9\x9b\xcd\x9e\xdd)\xafk`[\x7f\xdb\xd9\xaf\x9e\xdd\xb5\x98tW\x88\xc2\x95\xbe\xae\x7f\xf5N\x07\xfc\xec\xfd\xd2t\x95\xed\x81]k\xea\xbc\xaf\xbe\x9b\xf7\xef\xc6\x0e\xdd\xbc\xce\xf2\xba\xfa8\xef\x8a\xbc3\x96b\xbd=\xa0\x1b\xb2\xe9bmNO\xeb\xbbo.\xbblj?\xe5\xd7}\xd5L\xbeW{\xd3\xf8\x01Y\x9d-5\x1b\x06\xb7\x03\xec\xceM\xff\xeb?\xd9\xd1\xd76u\xf7\xf3O\x1e\xea\xba\x16\xc3zV\xa7\x0f\xec\xfa!\x19\xd6\xfc\xec\xbe\x7f8\xfe\xaem\x9a\xfe\xa7\x87\x1f\xae\xc7j\xbf7\xe7gq\xed\xfb\xe6<\xa9\xce\x97k?i.\xbd\x1d\x85\x97IgjS\xf6\x13\xdb\xe5yk\xf2\xa8O\xba\xfc\xdce\x9di\xab\x03\xed\x8a\xd9,\xce\x92\x9b\x16\xe0r\x9aE\xb5<\x92+\x06\xacC\xa5\x0f[i\xe6\xc6\xff\xa1i\x87\xe1\xfb\xfcS\x7f\xbf\x98\xff\xd9\x9a\xce\xf4?O\x86\x0f\xdd\xb58U\xfd\xcf\x13 \xdby\xe9e\xb0\x0c%??pd\xe7\x97\x8b\xc9\xdb\xfc\\\x9a\xdd`zF\xb8\xdd.;5\xbfd\x87\xa6\xbcvYu>\x9b\x16j\x18\xaaS\xcd\x10\x80`\x1f\x1c\xa7\x86\xb4w\xde/\xf9~o\xa7\x96\x19\x8b)P\xdb\xea\xfc\x11\x07$\xda0\x1af\x84P\xe2\xd2Gs\xed\xed\x18\xdb\xcd/7\xb8\xba_~\xe7\x80\xffan\xfd\xf3P\x99z\xdf\x99\xfe\x81\xc1M\x97ksz\x99\xbe\xba\xffn\xdc0\xae\xcd\x879\xef\xe9\xe4\x08m\xb3s\xe3p\x89\xc1\x95\xff\x8e\x83\xb5\xcf\x8b\xda\xbc\x9f\xf2[\xf6Y\xed\xfb\xe3\xf0\xf5\xe0\xdb\xff\xfey\xacz\x93\xb9K|7|\xe9</m\xf3\xd1\x9a\xae\x13/\x0d\xf5\x9a\xf3\x83\xd6\x8f\xb3\xfc\xda7\x90\xdf\xf2h\xcaoEs\xc3Q\xd4\xe6\xfb\xaa\xf9Yi\x87\x0f\x0e\xc8\xe7\xeb\xa90\xad\xedq\x18Yn\xb4d\xdd\xa5:g0\x0c\x15`s\xedc\xe0\x03\xaeZ\x12Zg\xf2\xb6<\x8a\xc3\xd66\xc9\xf5\xca;\xf4\\\xd6\x1c\x0e\x9d\xe9w\xd9\xe2r\x8b\xe9!\xb8\xc1_V\xda\x81_\xc7\xf1i\xd80\xbbKQ\xb8\xe9$\xf8?T\xb5\xc9\xae\x97\xba\xc9\xf7\xe0]\"\x0d\x167S\xf8\xef\x82\xbd\xe9\xf3\xaa\xee&'s\xbe\xfa\xbe\x1d\xe6\xbb\xeez:\xe5\xed\xdd\x97\xd6U\xd7gUoN\xcf2?\x7f\xcf\xe5\xa1\xf0\xec\xcd\xe9R\xe7\xbd\xf1V\x17\xea\x9f\x86)\xee\xe7\xb8\xd4N\x14J\x7f\xcb\xb3\xdc\xf37\x93\xdd.?\xd8{\x91\xdd\xae0\x87\xa65\x94\x8f\x8dr\xf1\xff\xbfk\xd3\x9b\xc9~?\xd9\xd7x\xb3q\x9cO\x8e\x8b\xc9q99\xae&\xc7\xf5\xe4\xb8\x99\\&\xf6\x16\x81\xcd\x8d\xe4\xdbj7{\x99\x91\xb1\x07\x97\xb0\x9b\x9f\x84+\x17\x87\xc4n}\xb9\xbd\xd8\xe1\xf4\x82\xbd\xe0\x18\x99\x9d?\x86\x9b\x8apac\xdd\xa4\x96\xa6\x9e\\\xeb\x10\x94\xd8f7y\x0d\x17\xef\xec=\x9a\xcb\xba\xa6\xae\xf6X\x04\xdf\xb0\xfb\xdc\xcc\xcdk\xfa\xad\xe4\xb0\xcfh\xaa\x9e|9\xbf\xe3\xd4i\xaf\xd7k\xb7\x9b\x85\xab\xbc5\xee\x0b\x08g\x03W\x1f\x9bd\xe8\xb5\xf6\xd7\x7f\xdfa\x07\xbb\xaf\xc80\xfc\xdd\xc7\xecR\xe7\xa59\xba\xdb\x18\xffm9\x82y\xc4Scs\xc9\xcb\xaa\xbf\xef\xa6k\xf0n\xef\xa3G\x1c\x8b\xe6\xaf|~\xe5\xf4o\xf4*\xfb\xfa!\x0f\x7fj\x9b\xda\x7f\xd5a\x07\x97\xd7\xb6k\xda\xdd\xa5\xa9\xce\xbdi\x9f\xee\xdb\x02{\xbdl\xea:\xbftf\x87\x7f<\xa7\xf6n(\xaf\xec\x97j\xf86y\xfe\xd3\xc9\xec\xab\xfc\xe5\xd7\xa7\xea\x0c\xe3t\xfd\xba\xb9\xdc~z\x10x\x18\x1b\xce\xf6\x14H\xaf\x9b\xadJr6\x89\xf4\xf6\xb6PI\xce&\x91\xe6\x8b\xd9Le\x0d\xc6\xe7s\xea&Awq<\xdc\x9f\xe2\xfd\xc34\xdc\xe6d\xd6\xa4\xcd\xe0\xef\xee^\x80\xdc\x13Y\xf0;\xfb\xfc\x9c\x16\x1f\xd9\xa1\xba\x99=\xbd\x8b\xce\xfb>/\x8f's\xeew\xce\xe6PuS\xe6\xb5\x82r6\x87\xea\xca\xb6\xa95\xd8`t8\xa2{FE\x91\x03\x17u^J\xb7\xf9\x8b\xc5\xe2mqp\x90\x8f\xd6\xdc\xb3}\xde~3\x9d\xe0\xf0\x1f\x97\xfb\xd5v\xbd`\xc8V\x00nf\x9b\xc3k\x11\x03\x05\xd8\xf6\xf5m\x9d\xcf=L@\x14\xdbrQ\x96\xc1Qm\xef\x96\x05\x1c\xcc\x9e1N\x0c\xed0?\xac\x0f[\x8e\x14\x9b{\xd8\x1e\xf2\xc3P\xb9\xbb\xdd\x92 \x87!q\xad\xd9\x8f\xe5\xad\x98\xedge\x0c\x94b\xdb,\xe6\xc5|\x1b\xe1\x04TY\xce\x0f\xf3\x1cQ\x02\xc0,\x97+\xe8P\x1b\x97\x963sX\xbf\xae\x971LN\xd9[^\xe69\x03\xca\x19+Ma\x06d\xd3\xe6\xe7\x0f3\x92\x94\xd5f\x91\xcf7	V\n`3_\x16\xf3\x03\x87\n\xc0\xbdy]G@\x01s\xd8\xbc\xbd-)FM\xd0!\xcf\xf7\x9be\x82\x94sT\xee\xdf\x8a\xa85c\x03\xebpX\x9b\xe1\xf2\xb8\x9b\xban>\xc7\xd2\xb4^\xae\xe6\xf3\x04+\xc5\xb0\xd9\xae\x0e\xf3=\x87\n\xc0\xc3b?[\xac\x08P\xc2\x1c\xcc~\x95\x13\x8c\x9e\xa6\xc3a\xb9]$H9M\x87\xc3[)`\x95\xd1t( M\x1f\xad1\xe7\x91,\xcd\x0e\x8b\xc3b\xce\xa1R\x04\xf3|\xf5\xba\xe4H	wx\xdb\xaf\xd7\x01'@\x96\xdbr\xfe\xea\xa7CsV3\xb4\x9e\xef\xb7\xdb\x9c\x03\xc5\xf0\xf2\xc5a]\x1c\x12\xa8\x98\x1f\xb3<\x94f\x98Vz\x93\xd7c\xe9\xd9/\x97\xcb9CJ\xd5/f\xeb\xd9\xea\x10\xbb\x14`\xcbm\xbe}\xdb{\x98\x80X\xed\xcbY\xb1\xf6\x0855\x9b\xd5~]\xe6\x0c'gfv\x98\x99P\xe7\xd8\xf5e\xb6\x87\xc3\xc1\xc0\x97\xdeul\x12\x9a/\x16\xdbe\xce\x90R\xed\xf3r\xb9_3\xa0\x00[\xbc\xbe\xbe\x15{\x0f\x13\x10\xcb\xd5\xdbl_z\x84\x9e\x98\xb2X\x98\x82\xe1\xc4\xd0\x8aro\x0e$\xb4\xb1\xc4\x1c\x0e[\xf8\xc6\xaa\xce\xfb\xea\xa3\x19K\xcd\xdb\xdc,\xb7	V\x8a`qXn\xd6\x89[\x01\xb8\xdel\xe6\xc5\x92\x00\x05\xccf\xfd\xba*\xf7\x04\xa3\xa6\xe8u\xbb\xdd\xec_\x13\xa4\x9c\xa4E\xf1\x1a\xb7|,M\x1b\x83i\xba\\\xdbK=6\x82\x16\xf3\xf9vY&X)\x86\xe5vQ\xac\x0f\x1c*\x00_\xdfVyI\x81\x02\xe6m\xbd\x99\x9b\x05\xc1\xa8i\xca__\xdf\xcc[\x82\x14C\xdco\x8a\xe2P\xa6Xy~^\x9a\x02\xd3T\x9d\xbf\x8d$i\xb5\x9e/\x16k\x86\x94\xea\xdf\x1c\x16\xf3\xe5!\x06\n0S\xac\x17\xdb\x8d\xf7' \x0e\x9b\xcd\xfe\xad\xf0\x0859\x87\xfc\xd5\xe4[\x86\x13C;\x1c\x8a\xa2\xcc9RN\xcc\xc1\x14\xe6\xf0\x9c\x1e\xed3\xb5?\xef\xe2\x1b\xf5\x9d+MY\xe4^\x9e2\xdd]\xbb\xc6\xf1\xf7\xee\x01\x1fn\xcc;\xb5*\x7f#/\xd1Z\x95\x85w\xf5\x02K\xe5\xe0-~\xccQ\xe1x\xbf\xcf\xaap\xbd\xa7\x92\xf0\xe6_\"\x8d4\x07\x95\x80H\x1bI\x1e\xca\x82\xc0s\xea@\xc7\x1f\xa2\xa1\xe0\x15\xc0X\xff\x80`\x10Xz{P=\xa4$\x95\x82R\"\xa2\xa8h\xd4\x15q\x05\xe3}\x83\"C\xe0\x8cu\x0d(\x0e\x895\xd63 ?\x02\x8d\xc8\x851\"j\x11\x99\xa8\xc7\x89\xc2D\xe4\xa9,T)\x9c\xa5\x12P\xb2$\xd5\x8c\xe7\x1e\xf5\x8bL\x1bK?\x88\x19\x858\x92HT6\x81	\x92\xe0\xab\x19	e\x8eL\xd4CE\xcd#\xf2T\x16\n \xce\xd2	\xa0\x86\x92j\xbe\xe8\x01\x90F2m\xac\x07@')\xc4\xb1\x1e\x00\xd1\x14\x98\x91vRy\xa8\xa0D\x9e\x1e(\xca)\x89\xa6\x93@[1\x92\x8aG\xa1\xc5+\x19O>\xaa.\x915\xd2$\x94`2o$\x85\xa8\xc7\x021\x88\xad\xb1\xcc\x838\x93hz\x94\xa8\xd4\x04\x96\xcaA\xd9\x16sT8j8V\xc5x\xd6Q\xd0I\xa4\xb1\xa4\x83\xba\x13ic9\x07\xa9\x17xT\xf1\xa94\xd4}\x12M\x0f\x12E\xa0\xc0R9\xa8\x08c\x8e\nGy\xc8\xaa\xf8\"\xe7\xa0\x15%\xd2HsP8\x8a\xb4\xb1\x9c\x83\x8a\x0c<\x90__f\x1d$\xa5L\xd4\x03E})\xf2T\x16\x8aM\xceR	\xa8<\x93j\xc6\xb3\x8f2T\xa6\x8d\xe5\x1f4\xa9B\x1c\xeb\x01\x10\xa8\x81	j\xed\xab\x1e@\xb5*\x13\xf5PQ\xba\x8a<\x95\x85:\x96\xb3T\x02\x8a\xda\xa4\x9a\xf1\x1e@\x85+\xd3F\x9a\x85rW!\x8e$\x12\xb5/a\x12	\xac\xd2P\x08K4=LT\xc5\x02K\xe5\xa0D\x8e9*\x1c\xf52\xabb<\xef(\x9e%\xd2HsPI\x8b\xb4\xb1\x9c\x83\xacv+\xf5\x89\xacv\xa5)+\x92\xd5\x819\xc8j\x85\xe3eu\xc0G\xb2Z\xa3\xa1\xac\x96h\xad\xcaBY-\xb0T\x0e\xca\xea\x98\xa3\xc2QV\xb3*\\\xc6U\x12\xcaj\x894\xd2\x1c\x94\xd5\"\xad\xd3\xabCY\x1dx\x83\xacV\xf1\x87\x03\x05SY\xadQPV\x0b,\xbd=(\xabS\x92JAY\x1dQT4\xca\xea\xb8\x82\xf1\xbeAY-p\xc6\xba\x06d\xb5\xc4\x1a\xeb\x19\x90\xd5\x81\xc6d\xb5\xd62\x94\xd52Q\x8f\x13e\xb5\xc8SY(\xab9K%\xa0\xacN\xaa\x19\xcf=\xcaj\x996\x96~\x90\xd5\nq\xac\x07@V\x07&\x93\xd5Z\x13QV\xcbD=T\x94\xd5\"Oe\xa1\xac\xe6,\x9d\x00\xb2:\xa9\xe6\x8b\x1e\x00Y-\xd3\xc6z\x00d\xb5B\x1c\xbd\x06\x06Y\x1d\x98\xb1\xac\xd6Z\x88\xb2Z\xe4\xe9\x81\xa2\xac\x96h:	d5#\xa9x\x94\xd5\xbc\x92\xf1\xe4\xa3\xac\x16Y#MBY-\xf3FR\x88\xb2:\x10#Y\xad\xb5\x0ee\xb5D\xd3\xa3DY-\xb0T\x0e\xca\xea\x98\xa3\xc2QV\xb3*\xc6\xb3\x8e\xb2Z\"\x8d%\x1dd\xb5H\x1b\xcb9\xc8\xea\xc0\x8bd\xb5\xd64\x94\xd5\x12M\x0f\x12e\xb5\xc0R9(\xabc\x8e\nGY\xcd\xaa\xf8\"\xe7 \xab%\xd2HsPV\x8b\xb4\xb1\x9c\x83\xac\x0e<&\xab\xb5\xc6\xa1\xac\x96\x89z\xa0(\xabE\x9e\xcaBY\xcdY*\x01euR\xcdx\xf6QV\xcb\xb4\xb1\xfc\x83\xacV\x88c=\x00\xb2:0\x99\xac\xd6\x9a\x88\xb2Z&\xea\xa1\xa2\xac\x16y*\x0be5g\xa9\x04\x94\xd5I5\xe3=\x80\xb2Z\xa6\x8d4\x0be\xb5B\x1c\xe9\x01\x94\xd5\x84\xe9\xf5\xf1\x08\x0de\xb5D\xd3\xc3DY-\xb0T\x0e\xca\xea\x98\xa3\xc2QV\xb3*\xc6\xf3\x8e\xb2Z\"\x8d4\x07e\xb5H\x1b\xcb9\xc8j\xbb\xd8\xec~|E1\xfe\xc7[\x83\xc9-\x94\x97\xe6\xcc\x96\xc8=j09Tm\x0e\xbd\x88\xb1\x06\x8f\x18\xab\xd2\x02_H\xbd\xf6s\xd67\x17\xd5\xebK\xdf\\\x1c\xb2\xe5\xab\xfd>@g	\x98\xb1\xea\x1d\x94\xd6\xef\n\xd4\x00\x9c\xd5G\xa0\xa1\xd0\xde\x9a\x8b\xc9\xa3\xfc\x0c%\xbb\xe1\x1f\x8797:\xcc\xdb\xe0\xb5L[\x98\xddT\x7f\xd9\x8d\xe2\xee:\xee\xeep\xf6\x87\x11\x14\xe3~3`\x0b\x9d\xb5\xe4\xcf\x90\x9c\xd9\x95\x82\xdd\xbd=\x9e8\x80\xf2\xe7\x94\xbd\xa7>\xf2\xde:X:s\xc9[\xfb\x8b\x15\x8eD\x83\xf7I\x1e\xf7\x10p\xfc\x13Q\x0f\x867\xa2)\xcc?\xfa\x81R\xfa\xdc\x87!\xf1iO\x82l\x19\x10\x1f\xf0p \x83\xe13\x1d(\xb553\x04>\xc6\x81R\xfaFt\x84\xc3'7	.	\x0d\x1f\xd6\xa4\xc8\xa4\xb9\xf8|\x06\xa0\xf0FtT\xaf{$\x03E\xe4y\x0cC\xe1S\x18\x0e\xe4\xb1\xe1\x83\x17\x86c(|\xd6\x02\xa5\xad\xd93\x00>^	\x00|\x19(\x86\xc1\x13\x15\x0eKS\x06\x0fQ\x12`\x9a1xn\x02\xc8\xf8\xa1	\xc3\xe2\xa3\x12\x01\xcb\x03\xc0\xa7#)\x94\x01\xf1\x81\x08\x94\xe2\x1b\xd1\x11\x06\x9f\x81D\x181A\xf8\xd8C@\xa69\x82'\x1d\x126M\x13<\xdc\x00p\xfcd\x83a\xf1y\x86\x80\xe51\xe0#\x8c\x14\xca\x80\xf8\xd4\x02J\xf1\x8d\xe8\x18\x03\x0f*\"\x8c\x9c&x6! \xd34\xc1\xe3\x08	+\x8c&x\x1b\xda_\xaa\xf4\x8d\xe8(\x06|\xe8\x90By\x04\xf8\x9c!Ar\x1c<Z\x80Rx#:\x82\xe0\xd3\x04\n\x113\x84\x0f\x10R`\x12\x1e>3\x10\xa0I\xa3\xf11\x01`\xe93\x02\x86\xc4'\x03	\x92W\x8f\x0f\x038\x90\xc1P\xffC\xa9\xad\x99!P\xf2C)}#:\xc2\xa1\xcaOpif@\xd8\xa7\xc841\xa0\xe5\x01J\x85<C\xa2|O\x90\xbcvT\xec\x1c\xc8`(\xd2\xa1\xd4\xd6\xcc\x10\xa8\xcb\xa1\x94\xbe\x11\x1d\xe1P\x8a'\xb8$4T\xdf)2M\x0c\x08n\x80\xc6j\x9baQc\x0bX\x1e\x01\xca\xea\x14\xca\x80\xa8\xa4\xa1\x14\xdf\x88\x8e0(\x9e#\x8cxY\xa1^\x16\x90i\x92@\"K\xd84M\xa0\x8a\x01\x1cKb\x86E!,`y\x0c\xa8}S(\x03\xa2\xdc\x85\xd2\xa1~\x86A\x85\x1b\xc7(\xa5	E\xad\x80LBD\x1d+a\x934\xa1tE\xb0\x17\xa0	\x12\xd5j\x82\xe4\xf5\xa3@\xe5@\x06CM\n\xa5\xb6f\x86@\x19\n\xa5\xe1\xfde\x8e\x03\xe5\x99\xe0\x92\xd0Pl\xa6\xc841\xa0/q\xb97\xb9Q\xc7\x05_\x1aJ\xb4l\x1b3\xa37\xa2\xc5{\xf7\x18\x1f-\xdd\nU\xf9\x1by\x8d\xd6\x8a\x95\xe1]\xbd\xc2\x129x\x8b\x9frD8\xde\xef\x0bUD\x0b\xf3\xe2\xcd\xbfFR\x9a\x83J@\xa5uru(\x0bb^\xf4Ft\x14^\xf46t\xb8\x85\x1e\xed\x1f\x10\x0c\nKn\x0f\xaa\x07\x99$RPJ$\x14\x11\x8d\xba\"\xad@\xef\x1b\x14\x19\nG\xeb\x1aP\x1c\x1aK\xeb\x19\x90\x1f1\x8d-\xddJ-C-\xa2\x13\xe58Q\x98\xa8<\x91\x85*Eb\x89\x04\x94,b5z\xeeQ\xbf\xe84-\xfd fF\x88Z\x0f\x80\xb2\x89\x99l\xe9Vj\"\xca\x1c\x9d(\x87\x8a\x9aG\xe5\x89,\x14@\x12K&\x80\x1a\x12\xab\x19\xe9\x01\x90F:M\xeb\x01\xd0I#D\xf5\x1a\x88\xdf\x86\x16\xb4\x93\xc8C\x05\x15\xd7H\x96|\xe5@QNi4\x99\x04\xdaJ \x89x\x14ZR%z\xf2Qu\xa9,\xa5I(\xc1t\x9e\x92B\xd4c11Z\xba\x95Z\x87\xe2L\xa3\xc9Q\xa2RSX\"\x07e[\xca\x11\xe1\xa8\xe1\x84*\xf4\xac\xa3\xa0\xd3HZ\xd2A\xdd\xa94-\xe7 \xf5b^\xb4t+5\x0du\x9fF\x93\x83D\x11\xa8\xb0D\x0e*\xc2\x94#\xc2Q\x1e\nU\x8c\xe4\x1c\xb4\xa2FR\x9a\x83\xc2Q\xa5i9\x07\x15\x19\xf3\xd8\xd2\xad\xd48\x94\x94:Q\x0e\x14\xf5\xa5\xca\x13Y(6%\x96H@\xe5)V\xa3g\x1fe\xa8N\xd3\xf2\x0f\x9at\x84\xa8\xf5\x00\x08\xd4\x98\xc9\x96n\xa5&\xa2Z\xd5\x89r\xa8(]U\x9e\xc8B\x1d+\xb1D\x02\x8aZ\xb1\x1a\xbd\x07P\xe1\xea4\xa5Y(wG\x88J\x0f\xa0\xf6eL/l\x15\x1a\na\x8d&\x87\x89\xaaXa\x89\x1c\x94\xc8)G\x84\xa3^\x16\xaa\xd0\xf3\x8e\xe2Y#)\xcdA%\xad\xd2\xb4\x9c\x83\xac\xc6\xe5^\xf8\x8e\x08\xba\x19\x17|i\xcb\"Y\x1d3\xa37\xa2\xc5%\xb1\x18\x1f\xf4q\xd7\x8b4\x94\xd5\x1a\xad\x15Y(\xab\x15\x96\xc8AY\x9drD8\xcaj\xa1\x8aha^\\S\xd3HJsPV\xab4%y(\xabc^\xf4Ft\x14^\xf46t\x10\x88\xa3\xfd\x03\xb2Za\xc9\xedAY-\x93D\n\xca\xea\x84\"\xa2QV\xa7\x15\xe8}\x83\xb2Z\xe1h]\x03\xb2Zci=\x03\xb2:\xa6\x81\x1c\x1c\xcb7\xcaj\x9d(\xc7\x89\xb2Z\xe5\x89,\x94\xd5\x12K$\xa0\xac\x16\xab\xd1s\x8f\xb2Z\xa7i\xe9\x07Y=B\xd4z\x00du\xcc\x0498\xda\x03 \xabu\xa2\x1c*\xcaj\x95'\xb2PVK,\x99\x00\xb2Z\xacf\xa4\x07@V\xeb4\xad\x07@V\x8f\x10\xb5\x1e\x00Y\x1d3\x89<Vx(\xabU\x9e\x1c(\xcaj\x8d&\x93@V\x0b$\x11\x8f\xb2Z\xaaDO>\xcaj\x95\xa54	e\xb5\xceSR\x88\xb2:&\x06}\xace\x1ed\xb5F\x93\xa3DY\xad\xb0D\x0e\xca\xea\x94#\xc2QV\x0bU\xe8YGY\xad\x91\xb4\xa4\x83\xacViZ\xceAV\xc7\xbc\xa0\x8f\x15\x1a\xcaj\x8d&\x07\x89\xb2Za\x89\x1c\x94\xd5)G\x84\xa3\xac\x16\xaa\x18\xc99\xc8j\x8d\xa44\x07e\xb5J\xd3r\x0e\xb2:\xe6\x81\x1c\x1c\xcd:\xc8j\x9d(\x07\x8a\xb2Z\xe5\x89,\x94\xd5\x12K$\xa0\xac\x16\xab\xd1\xb3\x8f\xb2Z\xa7i\xf9\x07Y=B\xd4z\x00du\xcc\x0498\xd6\x03(\xabu\xa2\x1c*\xcaj\x95'\xb2PVK,\x91\x80\xb2Z\xacF\xef\x01\x94\xd5:Mi\x16\xca\xea\x11\xa2\x92H\x94\xd5\x8cIe\xb5\xd4@\x94\xd5\x1aM\x0e\x13e\xb5\xc2\x129(\xabS\x8e\x08GY-T\xa1\xe7\x1de\xb5FR\x9a\x83\xb2Z\xa5i9\x07Y\xed\xde[5\xfbas`\xbe\x9d\xb6\xb7v'f\x9b\xce\x17\xeb\xd6\x9c<\x82\x9bckV\x7fp@l?\\\xeb\x9a!\xde\xde\xde\xde.\xb7\xe0\xa2\x8fB\xec\x9b\xcb\xf0\x9e4\xa0\xfd\xce\xe3\xd6\xe0^Q\x0e\x9b\x82{\x17m\xe2\"F\xa2\x8f\xe1]h\xd5M\x11\xb9\x11\xc1\xccS\x14i\x88\xa7N\xe2\x11\x9b4\xee\xa4'\x9d\xc3\xb3\x02\xbd\xa4\xe6\x86\xf7b\xd62g\x12\x9a\xb5M\x82\x84\xe8\n\xe2p\x84\xc0|\xd24$1\xd6,F\x01\xfc\xd7\xb8\xebU__\xe4\x8e\xf9i\xf5\xbcI1\x8d\xfa*\x1eq\x03\xbev\x17%\x81y\xab\x7f\xac\x85?\xe0\xa9'\x17r\xe2\x8c\xfaJs\x10\x87\xd42Gq\x03\x85\xa8R@h_A\x9c\xa9\xf0\xb1|\xc5\xeej\x16[\x94Z!\xb4\xc4\x1e\"\xeb\xa3\x89\x8d;\x1bf\xb8w-\x0d|\x02l\x13g\x02\x9c5s\xd4a\x119\xd4\x19#\xed\xe51\xd6I\x8c4;\x7f\xb5\xbb\xfe\x8b\x19\x92d\xfa\xab\xb9=@\x8b\x18*e\x8a\xa2k	M[E\xc0\xfd\x0f\xccM\x04\xfd#\xb3m\x80\x17\x14.E\x9d2\xea\x94A#O\x08\xfd\x17S\x05\x8d\x9d\"\xa3a\xc6\xa7\x9f\xa2e!\x8c\xa3k\x86\x8e\x02f\xe0\xfe\xcbk\x95F\xcc\xb0q\x181\xb8\xa0`1l\x86\xafS|\x14x\x0c\xef\x7f\xe4:\x89B\xe7\xf0(x~\x15\x161^\x8a?\xa1\xd4\x12\x856\x01\x19\x10\x84;\xaf\x05\xe1\xe4\xb8\x17\xffR\xe5>\xef\x8e\xe1G*\x03b(\x0b\x10wB\x1c\x83\xb82\x0f\xa1\xd7^8\xe0\xc2\x9bg\xec\xfc\x19oX\xc4\x06{ \x12\x9aV\xb1iEL\xdb\xd8\xb4\x0d\xa6\xd80'\x9c>\x04a;\x86\x07\xd2\x06\xf3\xd0g\x1cPd3\xd6Q\x1cQ\x07\x84\xeb\x10n\xef\xb3E\x1a\x01mq\x9b-\xa4\x18(\xa4\xc8\x16b\x14\x14Sg\x0b!\x0e\x8a\xe8\xb3U\x1a	M0\xc9>\x8d\x84B\x8al%FB1u\xb6\x12\"\xa1\x88>\xdb\xa6\x91\x90\xfe\xccHg\xd3H(\xa4\xc8\xb6b$\x14Sg[!\x12\x8a\x88\xee/\xd3\xe1\xd3JQ\xd0\xf1\xc5\xef\x05SD-D\xe0\xec\xc3\xe19\xc3\xaf\x1c\xe1 \x1d\xfb\xb77\xd8\xfd\xd4\xafu\x8f6\xf8\xe8\xcdp\xe8\x0e\x9a\xf1\x0c\x1e4\x7f\xe6\x95\xa7\xda\xbf\xbd\xe1\xd4|7h\xb0\x7f{\xc3\xb9\xe9\xb3\xdc\xfe\xf6\xc7\xec\xd1N\x8a\x9eSwX\x96?\x97\xcb}zN\xe9\xc1\x87\xde\x16\x9d\x86\x08\x10f|N\x87\xc3\x82\xb0\xd4}\x82\xc2\xacm>c\x83-Aci\xea\x9aYm\xd1s:\x9c\x1c\xe6M\xc3Lt\xa8\xcd\xcd\x17\xd9\x0f>\xe4\xc8B\xca\x06\x8e\xad\xf1\xe1\xfe\xd8W\xedp\x84\xe9\xce\x05\x81\xc6\xac5\xdfM\xdb\x19\x01\x84&\xf0T65\x07\x95M}=\x9d\x83]s6\xe0\x98\xbf\xcf6\xbf\x0c\x0e\xed_;\xfb\x1fb\x89]y\x04\xf3qn\xb8\x9bsc\x0b\x9eS{n[\x97u}\xde\xf6\x0fw0\xa6;\xc9\xads\xb9\x1b\x8a\x11c\xcf\x12L\x10\xe6\xbcG;\xfc@\x9bB\xf0\x87\xd9\xeeS\x86'nF\x10\x7f\x0c'\x80\xba\xbe5}y\x8c0P\xf6\x9cv\xa6>\x0c\x17\xd0`\xb6\x9f\xe1\"\xb2\x7fF\xcd\xb0\x05Q+l\x01iD\xb0\xbb6\xd8\x8fq\x13l\x89o\x01\xb8\xa7\xb1\xd9\xa2\x10\x9a=M\xb7:\xdc!\x04\xfc\x04\xa7\xd1Fq\xa0\xcd\x86\"\xe2\\<\xde\xe2R\x98\x001.,/L\xffi\xcc9\xc1\xb9\xb3\x1a\xd1\x1a\xea\xce\xdd\xbd\x8f\x82\x1e\x8c\xc3\xf1]\xf6(\xdd(+<\x00\x04\xd1\xd4K\xadF\\\xe8\x80\x08\xe5\xda\x8c\x18\x08\x97\xe1XS\x10\x0cM\x91\xb0\xd8\x10\x97\xfd\xb9\xbb\x86vs\xb8\"\xec\xa8\x19J\xec_PX\x9d\xab\xbe\xca\x87\xcbw\x07\x1f\xc0d\xa7\x97\xa1<L4\xd9\x87\x9f5\xec_\xdewwl\xed\xafa\xc8\xdf\xdetn8i\x16,\x02\xcfY\x9b\xbc\x87\xcd\x05\xdc\xdf\xb8\x9d\x80\xfb\xe0nB\xa0\xdc\xfe\x89x\x88\xd7\x16\xdb?\x9f\xd3\xb26y{\xa8n\xc3\x91\x81\x0fH\xdf\xee\x1f\xfe\x81\x1d\xfe\xe9pv\xe3\x85\xa3}\x8ci\xcf\x8e\xcd\xcf]|\xb8\xec\xbd\xeb\xcd)\xbbV\x93\xdf\xd5\xd5\xf9\xdb\x1f\xf2\xf2\x8f\xae\xe4_\x9as?\xb1\xa7\x96\xd5&\x1b0\x93?\x9a\x8f\xc6\xbc\xfc\xe7\xbfN\xfeoS4}3\xf9?\xb7\xfb\x879O\xfe\xb3\xb8\x9e\xfb\xeb\xe4\xf7\xf9\xd9\x1eIW\xd7\x93\x7f\xa9\xda\xfc\xe5\x8f\xf9\xb9\x9b\xfcs\xdbT\xfb\xe1\xcf\xffm\xea\xef\xc6\x1e\x18\xf8\xf2\xef\xe6j&\xe1L[\x0c\xcc\xfe\x1dE\xf6\xfb\xe6\xdc\xf5\xf9\xb9\xaf\xf2\xc9\xbf]\xcbj\x9f\xbf\xfc\xce\xe5\n>\x15\xf4\xc3\xcb\x1f-\x1d>L\xfe\xd9\xfc%\xff\xaf+\x94\xfd\xae\xea\xbb\xbe5\xf9\xe9\xe5\xbfL\xeb\x81Ua\x86S\x95\xa1\xe0\x7f\x99\xa6\xfd\xa8\xf2	\x0d\xc9\x9e\x1e\x1dE\xf4\x07s\xae\x9b\xc9\x1f\x9as^6\x13\x1b\x9e=\xb0\x9b\xfa\xfaCs\xb6\x86k[\x99\xf6\xe5\xdf\xcdg8\x80\x1a\\\x1e\xf3\xca\x1d\x02\x1d\x9d\xd8<\x9f\xcd\xc0\xdc\x1f\xabst\x98\xf3\xc2\x9b\xdc\xd3\xed\xc8\xb6\xf4\xb6\xe1\xf4\xd6\xc8\xb8\xf2F{\x04\xde\xf5\x14\x19\xd7\xde\xd8\x99Se\xcf\x84\x8e\xcc\x1boNL\xaf\xdedn}\x9b'\xf6m\xa0\xdaw\xc1\"\xb7o\xb3\x99\x7fE-J\x06\xbc\x9bF\xb1s\x8e\xb5\x99\x11p\x0b\x8e\xa3\xef\xd6Q\x87K\x0e\x1cr& W\x1c9$P@\xae9\x12\xb3)`7\x1c\xab\xe0^9\xce\xe7Yp\xba\xe5`\xfa\xbb6\xea\xd5\xa5\x1e\x961\xe2\xd4\xbbBi4\x06\xacK}\x8a[p\x1c]\x7f\x11\xc6ip\x08\xa9O]\xae8\x12R\x9f\"\xd7\xdc\xa7O}\x8a\xdd\xf0\xfa\x15\xdc+\x8f3\xa4>\x05oy\xa3\xe8\xbb\x8f\xd4\xabK\xfd1\x9b\xe3\xf9\xc3\xf8\x9c\xe5\x98-|\x11\x96,}\xc9+\x16\xad\xb0h\x0e\x05k_\x10<mB\x19\x16m\xb1h\x01\x05\xf3\x99/\xf1\xa0\xb9\x0fa\x89%\xde\xd5\nJ\x16>\x82\x0d\x94,=k\x0b%+_\xdb\x1c\xab\xdb\x84\xc0\x91g\xbf\x9a\xb1\xd0\xfem\x99\x97\x9b\x87YUu\x1c\x9e\x94`\xd1l\xf6+\x0b\xeaJ\xfbN\x0b)\xfd~|Nk\x93\xdb\xa3\xa2\x87\xafFzZ\xfc<\xd8zK\x88\x8d\xd3\xc5:\xd8a\xde\x8c\x01\xc4^7Mg\"\xf3\xe29=e\xb3pj\xef\xf4\x94\xcd\xf1\x13\xf6\xc7)[\xf8\",Y\xfa\x12\xe8\xd9S\xb6\xc2\xa29\x14\xac}A\xf0\xb4	eX\xb4\xc5\xa2\x05\x14\xcc}<\xd8\xb3'\xdb\xb3P\xb6D\x94w\xb5\x82\x92\x85\xe7!k\xe1c\xda@\xc9\xd2\xfb\xd9B\x89\xed9,\xb3\x7f\xdb\xb2\xcb\x0dK\\/\x9e\xee>E\xf6\xb9\xcdn\x06\xc7\xd2\x83\xcc\xb6\xb7A\xa7[\x80\xd8\x1b\x9d\x80i\xe1l}\xeb\x05S\xeb\xbc\xc0RO\xec\xcag\xea\x16\xc0\xce_\x8c\x1e\x9cz\xf0\xdd\xf7\xd0\xe0Yr\xec\xfdz\xe8\xe0Wp\xeb\xbdb/\x0f^_)\x14\x9a\xee\xbb\xff\x16\xc0\x83\xdfW!^\x04\xdf\xfd`q\x9e\xe7\xa9_\x18C\xb7\x00t^)r\xf0	\xc0\xbb\x1fm\x83G1\xb9a\x1c\xde\x02|\xf0+\xa57\xc0\xef\xd9&\xf6.:\x87\xc6\xdd\xb2\x0d\xf3-\xb9\xf6\x99\xc0\xe1\xef\xe2^\xa4A\xc3Uq\x0b@\x171E\x0e>\x01x\x0f\xd7\xcf\xe0R\n\xd6_X7\x82\x1e\xfc\n\xd1z\xf4=\\\x87\xce\xf72\x0d\x17.\xcf\x1bA:\xbf\x14:\xc4\x0b\xc8{\xb8\x92\x9d\xcfU\xea\x13.\xf0\x1bA:\x9f\x14:\xf8\x04\xe4=\xcc\x05\xce\xe7:\xf5\xe9\xdb\x1f\x90\xceg\xdaz\xdfv?\x9b8\x9f\x9b\xd4'L27\x82t>)t\xf0	\xc8{\x98\x8f\x9c\xcfm\xea\x13\xa6\xa9\x1bA:\x9f\x14:\xf8\x04\xe4\x9d\xceh\xce\xab\xfd\xcc\xfa\x1f&:\x10\x9a`s~)\xb8\x0d\x87\x9e\xdbk\xd6\xcf\x8a\xce\xeb\xfcrcN\x87\xb9\xf2Fp\xce#\x01\xc2u\xe5p}\x980\xad;;\x87\xb6\xa1(\xcc\x9aE(\x84zli\x1dJ]%\xb6\xac\x97\xe6W\xe7v\xfe\x90\xe7\xcd\"\x9b3\xdf\x9eT\x8b\xf3\xaf\xab%\xea2\xbc4\xdaP\x0c\x933\x18\x8al\xc1\xeb\x00K-M\xc5\xae\x06a\xdeu\xedX\xb2*\xd0RdK^\x07\x9ajq^v\xb5D\xc3\x19\xe6\xd16\x94Bga\x0d+V\x03\x18ja\x86v\xde\xa5\xf9\xd85b\xcd*\xf0\xa6\"[\xf3:\xbc\xad\xce\xd6lREK/\xcd\xcd\xae\xa2\x0d\xaf\x08\x18E\xb6I\xea\x01S\x9dmX5`\xe8\xd3y\xda\xd5\xb1}Hsp\x91mY\x0d@\xa8\x85Y\xdceK\x9a\xb3]\x05\xc1\x00\xb3<FT\x10\x13V\x82\xb6Z\x9e\xd5\x87\x9a\xfc\xa0\xc3\x19|\xa8g\xf1\x90f\xe7\x82\xcc\xe3P\x0b\xcc\xdb\xb54\xc3\x0f5$\xf3\xf9P\xc3\xe6!\xcd\xd5\x05\x99\xd5\xa1\x06\x98\xc5ki\xbew5\xa4\xb3\xbb\xab!\x14\x0f\xb9\x02CA\x0cP\x03Xji\xf6\x1fjH\xe6\xfa\xa1\x86\xd5C\x9a\xc7\x0b2\xe3C\x0d0\xc3\xd7\xd2w\x81\xab!\x9d\xf9]\x0d\xa18\x9a\xd5\x0bb\x80\x1a\x80RK\xdf\x0c\xae\x06\xe9{\xc0\xd5A\x0d\xd1\x1c_D&\xa8\x07\xe6\xffZ\xfe\xaep5%\xdf\x0c\xae\x9a\xcb\x8d]\x80\xae\xbc \xe5P\xc3`\xa8\x85/\x8e\xe7\xf4\x94\xdf2PQ\xeeO\xaa\xa4\\\x01\xaa\xa9\xd8j\x15\x95-\xf9\xccn\x9d3\xc12\xe6lH\x9a\xb3t'bYR\xcbiO,+j\xa9?\x88eM-\xb7\x9aX6\xd4\xb2\x88L\xaf\xd4\xb4\x8cL[jZE\xa67jZG\xa6\xf9\x8c\xda|\xa6`\xed\xcfI\xceSu\xce\x8e\xf6\x1b\xd3\xfek\xfcW\xab\xfd\x04\x84`\xa0\x0cLmdu\xa9\xad\xce\xd9'8\xf4K\xcc\xeeC\xf0G\x03\xc8b\x91\x99\x11\x95\x99\xe1L\x9e\x11\x9d\x99\xc1|\x95\x11\xa5\x99\xe1wZF\xb4f6G\x1c~?\xec2\xff\xad\x91\x11\xbd\x99\xe1\xf4\x9f\x11\xc5\x99-\x90\xed\xe7\xcb]\x86\xb3hFUg\xb6\xf4\xc8\xe0r\x85e~\x16\xd9e\x9e\xeb\xaf\xfb]\xb6\xc12\x7f\xa5\xee\xb2-\x96\xf9A\xbf\xcb\xdcu\x90\xfd\x80\xda\xcc~@nf\\oB\xa2\x997\x92~.9\x19a\x98)\x08\x9e\xa9\xcel*\xba\xc7\x84\xdc\xb2\x05\xf3\x9e\xdej\x87n\xbfg\xcb8vI|\x92\x11\xc1\xe5'#`\xec\x1e\xcf\x14h6\x172\x83C\x8b\x8b\xd0l\x9ezF,\xd3\xa18\x16y\xe0Pl[\xca\xa5(\xa7`\x05\x81\xc1\xd4(\x8c\xec\xb4\n\xc4sA\xca\x08\xbe\x82\xe0\xdf\xdf\xa4\xb8q\xb3\x10\xbc\xe3\x85\xc3ei\xb6H=#\x96+\xd3l!\x06\x1e\xae\xbfD\x9c2\x06\xfa\xf7\x04\xaeO\xb3\xa5\x10:^\xc9\x89D\xcd\x96\xa9o\x04s\x95\x9a\xad\x04\xcf8\x1f$B5[\xa5\x9e\x11\xcc\xb5j&\xe5\x04\x1bx#\xe0\xc1\xf3:\xf5\x1c\xb2\x11\xee=\x9cgA\xb2\xfa\xb9)\x11\xad\xd9&\xf5\x8c`\xae[\xb3\xad\x90\x0d\x9c\xe1\x12\xe9\x9amS\xcf\x08\xe6*3#\xea\x11\x1d\xc34\x99\x08M\x8a\x05\xb7\x00M\xb5f&\x8a\xcdLV\x9b\x99$73\xae7\xc9\xcc\x98(NbK5'1r\xd5IL\xbd4\xdb\xda0\x12\xe5\x19L\xa9\xf6\x0c6\xae>\x83\x85\xe9O2\xcb&\n\x94\xd8R\x0dJ\x8c\\\x85\x12\x13\xd3\xa1~&M\x94\xa8\xb7\xa4Z\xd4\x9b\xb8\x1a\xf5\x06\xa6G\xe9\x04\x9c(RjL5)\xb5rUJmL\x97\xc2\xa4k\xf3\x9b(SbK\xb5)1ruJLL\x9f\xfa97Q\xa8\xde\x92jTo\xe2*\xd5\x1b\xb8N%su\xaaT\x89Q\xd0\xaa\xc4\x9a\xa8Ub\xe3z\xd5O\xc8\xa9b\xf5&A\xb3z[\xa2Z\xbd\x85\xebV?A\xa7\xca\xd5\x9b\x04\xed\xeam\x89z\xf5\x96^\x9a\xf1\xe1J\x9e\xb1\xde\xc2kR\xd0\xb0\xde\x96\xa8Xo\xe1:\xd6O\xe0\xa9\x92\xf5&A\xcbz[\xa2f\xbd\x85\xebY?\xa1\xa7\x8a\xd6\x9b\x04M\xebm\x89\xaa\xf5\x16\xae6q\x8eO\xf5&Z\x04\xc5\x89\xa6Ds\x0e\x86\xe6\x92\x97Uo\xef\xc3\xe1/\xfb-\x80\x85\x8b\xb5/u\x8bo\xf0![\x07\xf4\x94\x14\xbf\x12\xf8+)\x9f\xcf\x02~\xfe\x9c6\xd7\xde\xad\xcb\xb9\xe5?\xf8\xb0\x0bK\xb2\xd4\x0c\x8b\xb2\x04dW\xdd\x0fv\xa7\x07\xab\xc8\x1f\xf8	\xf4\xb97\xc2+\x89\xde<|&\x80\xefUW\xd9\xd7 =\x02\n\x08\xa4+\xdb\xa6\xae\x03b\xf8L\x00\xf0H9|\xe6Q\xdc\x19\xe0\xce\x017\x1ehvKC\xbd'\xa0{\n\xba%-\xcanB\x9b\xee)\xec.\xc0n\xbc\xf1\xd9-m\xfe=\x01\xdd=h\xf8\xd7\xae\xd3\xf6\xcd\xb5<>\xb2OS|\xab\xfa\xccC=`\xe7\x00\x94as4F\xb0\xf6\xe7\xf4\x92\xcd\x1e\x97|o\xd7z\xed\xd8\xb9ds\xff\x11\xbf\x98.\xd9\"\x94a\xd12\x14\xc1\xf7\xf2%[\xf9\xb29\x94\xacCI\xf0\xb6!\x85X\xb6\xf5e\x0b(\x99\x87\xb8pn\xbf\xd8\x99\x18\x0b\x97\x88\x0b\xeeVP\xb4\x08T$.Bl\x1b(Z\x06_[(\xba\xdc|\x91\xbb\xa8/\xf7\x90\x1d\x10\xd6`\xc7Y\xc1\xa6\xecF@\xb0\x90\x8b(/\xad/\xf7\x90X\xba\x94\xcb\xdc\xf9$\xdd\x08\x9c.\xe6\xc6\x8e=\xfc\x1e\xba\x88,\xe7r\xe7\xdew\x00\x93\x05]\xe6\xda{\xf6=M\x97t\xb9k\x1c\x037\x02\xa7\x8b\xba\xcc9\xc2\xefa\xcc\x84e]\xe6\x1b\xc6\xd2\x8d@\xc3\xc2n\xec\x17\xa0\xf70\xee\xa2\xa5]\xee\x98d;\x10\xe0!\xfeb-\xb8'\xf9\xde\xb0\x1a\xe4\n\xbc\xff\x0d\xf7/\xba\xf7\xde\xfd\xe5\x10\x96xY\xf0p\x99\xdc\x084,\xf2\"\x96\xae\xf2\xda\x11\x18\x0ffP\xc6\xdcq\x08\x9a\xe0\xe9B/\xf3\x1e\xa2\x0eWgX\xeae\xde\xe1\xaa\xbdQlX\xec\x8d=\x03\xd6\xe9h\xb4\xf8\xe5^\xe6\x17.\xfd\x1b\xc5\x86\x05_\x04\xd3\x15_{\xcd\x84\xf6\xf9%_\xe6\x17\xdav\xa3\xd8\xb0\xe8\x8b`\xbav`\xfd\xc6\x83z#\xf8\x85I\xe8F\xb1a\xe17\xf6\x0b\xd8;\x99\xb1\xc2\xd2/\x8b\x17f\xb2\x1b\xc5\x86\xc5\xdf\xd8/`\xefd\xda\xf3\xcb\xb4\xcc\xed0\x1b\xde(\xd2/\xd4\xc6N\x07dO\xa6D\x90\xcf\x97\x96\x94\x85y\xb1 \xa5P\x99-\xaeI1\xae\xd6^zq\x0eu\xae\xe7\x0f6\xc3\xe0\x95Zds\xee\xdf\xdbjy\x92u5\xc5\xa9\xc61\xdef\x0b^\x11X\x8al\xc1\xdb\x81\xa6Z\x9co]-\xd2\xdc\xea\xda\xb3\xe4\xed\xc1\x19\xb3\xc8\x96<_\xdeV\xcb\x93\xaf\xab)\x1e\x920Q\xb6\xa48\x9aA\x8bl\xc5{\x05(\xb54\x0d\xbb\x1a\xc49\xd75f\xcd+!\xbd\xb3\xe6\xbd\x1f\x8c\xb52+\xbb\xda\xc2e\x1e^\xafq\x95mx\x8b\xd0Td\x1b>\xd2\xbc\xad\xce6\xbc*\xb0\xf4\xc2\\\xec\xea\xd9>\xd8<\x88\xb5l\xf9x\x06K-M\xd5\xae-\xe2\xbc\xec*\x99\xcfx-\x18W\x91\xcd\x93\x0b\xc7\x1bke\xea\x1ej\x8b\x876\xcc\xb2-\x9d\x91\xa3\xf9\xb7\xa0\x16\xb8D\x81T\x8b\xd3\xf8PK\xdcA0?\xb7t~\x8ef\xe3\x82Z\xa0\x16 \xd5\xe2\xa4\xeej\x11fp\x977R\x1e\xcd\xcd\x05\xb5@->g\xd2\x14?\xd4\x12\x86<\xbe\xc63\xd4\xb2z\x883uAgu\xa8\x05L\xb58\xe1\xbbZ\x84\xd9\xdd\xd5B\xca\xa3y\xbb\xa0\x16\xa8\x05\xa6\xf4Z\x9c\xfe]-\xe9\\\xef*\xb9\xdc\xf8D\xe0\xe6\xf1\x82\x1a\xa0\x8e\xc1RK\xdf\x05\xcf)\xfc\x8a/3\xdf\xcd\xb9\xef\x06i\x1a\x97\xc1\xaf7\x18\xd0\x8a\x11\x0e\x84\xdfA\xf5y_\x95\x0f\x7f\xf4\xe4\xf0\xf99=T7\xb3\x0f\xc5\xee\xe3s\x9a\x17]S_{\x13\x0cX\xf2\x9c\xb6\xa6\xce\xfb\xea;\xb1a\xc9s\xda\xf5U\xf9\xed\x1e,\xa8\x9b\x86\xf2w_>|~N/\xd5yh\x9c\x7f[*\xac\x8a\xbfC\xa2\xdc\xdf\xfe\x0d)\xc7q\xf0\x19`g\x08\x9c\xbd\xfb\xaf\xb8\xea\x9c\xdd\x01\x84\xc6\xa1\xf4\xf6@\x12\xc5\xf6\x0f\xfcv\xb5\x8f0\x102\xd8\x8aG\xec\xa1~ \xb35\xf6 \xc9!\xfe\xe1o\xe8\x140\xdc\xb1\xf4\xbbi\xed\xcfUjo\xb9\xa1\xe5\xd8\xb4\xd5/\xf6 JoC\xcb\xf0C\x9b\xee\x98\xef\x9b\xcfG\xd1\xd8\x1f\x0c\xd9?w\xb3\x97\xc5\xe5\xf6\xb2\xba\xdc^f/\xedG\x91\xffz6\xb1\xff\x9f\xce\x7fB\xb4]C\x8f\x08\x16\xbcM	\x8b\x9f&\x9a\xb3\xd96x\xab?bo\xf3\xf5\xe5\xf6\xb2\x9c\xa5\xfe\xe6\xd6\x9f5\xce\xd7It\xd4au>\x9b\x96FX\x9d;\xd3\xbf\xa8\xb1l\x02\x15\x1e\xbc\xc4\xcd\xb3\xff_^nC6\xd6\x8b\xc9|\xb5\x9a,\x16\xb3\xc9t\x1d\x88\xe7&f\xd9\xcf\xfe\xf7\x0e\xe0	\xb7^&\x99\x93#\x9a\xff\xc4\xa8\xd9	\x7fR\xf1\xf7\xc8{\xec\xba\xfe\x90\\\xff\xed\x9d\x10{w}\x91V\xf0\x03=\x12\xfb\xc1'b\x89\xa7/\xba'\xf6rn$\x17\xf0\xbb:\xfb\xbb	D\xc2s\xb8(\xdbr\xac\xf3\x9f\x18\xd5\xf6\x95\xc0\xfe\x1b\xaf\x91\xd8u\xfd!\xb9\xfe\xdb\xfb*\xf6>\xf4UR\xc1\x0f\xf4U\xec\x07\xfbJ\x08u\xb4\xafb/\xe4qh\xdaWU]g\xe5\xb5\xb5\xfb~?\xec\x87\x1d|\xf8\xbd\xddy\xcd~K\xb4\xcd7\xe3\x11\xc3G\x86q?:\x1c\xbe\xcf\x86?\xeb\xfc\xde\\{\xf86\x1b\x8a\xdc\x97Ul\x86\xef\xaf\xde\xdc\xe0\x17\x90\xee/\xf7#P\xf8\x19\xa4+\x80\x9f\xae\x12#\xfen\xd5\x15\xb9/\x00j\x85_V\xba\x12\xf8q,5C\x11\x00\xc8\xb6\xe6\x0f\xe1\xb8_\xc7s\xbf\xf1y\xb0S~\x9d%lI\xde!\xddo^\xce\x00\xed\x83mS\x1e\xdb\x1flCro}\xb0\xad\xc7\x03\xcd\xfd\xf0\xea\xc1\x0e\xeee\xe6P-n'\xce\x01!p\xdc8\xdc!\xdc~\xe1\xdeb7st\xc5~\x8fo\xd2^\xd8\x0c<\xb6\xb7\x0f\xb6\xedwd~\xb0\x0d\xbe\xd1\xf8`[y{R\xd4V\xdc\xb4;\xb6\x92\xa6\xc2\xf6\xdc\xccNZ\n\x1bq;@\xbc\xff6Bp\xcb\xed\x04\xe2k\xc1\xcd\xb59\xe2\xc1\xb6\xd1&\xf6\x07\xdb0\x9bR\xa3\x16\xe2\xd6\xd8	\x804\x126\xc1N!\xa4\x9d\xb0\xdd\xb5\xc3\xc0\xee\xccl\xb4\xe2\xc6\xd6	\xa4}\xb0-\xac9\xe2\xc16\xab&\xf6\x07\xdb\x96\x9aR\xe3v\xc2\x06\xd4	\x80\xb4\x13\xb6\x9aN!\xa4\x9d\xb0\xa94\x8em\xdc\x13: p\xfbh\x8eh\x1fl\xa3h\x06x\xb0-\xa1\x83\xf9\xc16\x7f&\xc4\xa8\x89\xb8\xcd3\xb7\x87\xaaqC\xe7\x04\x11\xc2\xc7\xad\x9b\x1d\x84\xee\xd8\xfc`\x9b43@\xfb`\xdb1\xc7\xf6\x07\xdbx\xd9[\x1fl\x8b\xe5@\x8b\xda\x86\x9b)33i\x1al\x9b\xcc\x01\xa4e\xb0A2L\xb4a_\xe4\x07\xdb\n\x99\x01\xda\x07\xdb\xf48\xb6?\xd8\xf6\xc6\xde\xfa`\x1b\x19\x07Z\xdc2\xd8\xb2\x98\x99C\xb5\xb891\x07\x90\x96\xc16\xc4\x0e\x01\xbb\xe6\xb2^\xc3\x0d\x87\x13H\xfb`[\x0bs\xc4\x83m\"L\xec\x0f\xb6]0\xa5Fm\xc4\x8d\x81\x13\x00i%l\x01\x9cBH;a\xb3_\x87\x81\xbdiY;q[\xdf\x04\xd2>\xd8\x06\xbe\x1c\xf1`[\xf5\x12\xfb\x83m\xcaK\xa9Q;q\xfb\xdd\x04\x10\xaa\xc7\x8dvSHh\x04n\xa9;`\xc8N\xba\x0f\xb6y.\x03\xb4\x0f\xb6Mnl\x7f\xb0\x0dq\xbd\xf5\xc1\xb6\xbe\x0d\xb4\xa8u\xb8\xc9-3\x87jq;[\x0e -\x83\x8dk\xe1\x06\x9f\xdf\x17\xc1]\xbepwD	\xf47\xe8\x109\xde*QX\xb8!\xea\xfa\x18\x8d\xf7M2\xba\x8d]\xb3#Tq\xea\x8c\x0ePeG\xbcph\xec\x90\x1d\x98\x1a\x1c\xd2\x9d\x05\xc0#\xdek\xc9X\x1e*\xdex)h\x9e\x06\xbc\x0b\xa3pz&\xaap\x18\xaa\xbf\xcf\x91\xf3\n\xf7g\"\x98\xc5\xca\x8e>\x8d\x1c\xc7Hv\xe2)\"c\x10;\xe8\xd4\xbb\x13r\x8a\xf7t\"4I)\xdc\xe0\xc9\xe0$\xa3p\xb7G\xd1p\xc3$&\x0co\xfd4<\x0b\x06\xef\x03\x15x\x0cfG\x96\x92\x9b\xb7\x18\xc7N*Mn\xf2\x18\x1an\x175t\x92?\xb8wT\xf1I\n\xe1F\x92\x12\xe0^LN!\xdcUjx\x16\x0f;|\x94\xde\xea\xa5\xd73;s\x94\x80c\xa7\xec\xa8Q\xeaT\x18\x7fx'\xaa\xa1\x93\x14\xc2m\xa9\x8aOR\x08\xf7\xa8\x94@nD9\x1coX\x158\x8b\x86\x9d\x1e\xcanb\x19\x16ne\x13l\x0ccg\x85\x12\x97B\xf6\xf0&W\x01\xf3p\xf1\x8eW\x83\xf3d\xe0\xed/\xc5\x87\x9b\xdc$up/,\xa3Y(\xec\xf8\xcfp\xa3\x9a&\x8e\x9d\xfa\xe9\xa1\xb1Cv\xd8gp(\xa4\x0d\xef\x9fel\x925\xb8\x99V\xd0I\xd2\xe0\xce\x9a\xc2\xc3\xfd3G\xb3\x83<\xc3m\xad\x944v~g\x0c\x8e\xfd\xb2c;=4F\xb1\xd3:\x83C)ipk.cy\xd2\xf0>]A'I\x83\x9bv\n\x87\xfb^9mp\x07\xaf\xe1Y4\xec\x00Nz[\x9d\x8e7v\xee&\x01\xc7N\xd9q\x9b\xd4\xa9\x90>\xbc\xeb\xd7\xd0I\x02A\x02\xa8\xf8$\x85\xa0\x07(\x01n\xa9\xc5\x14\xa28\xd0\xf0,\x1ev\x82&\xbdcOS\xc8\x0e\xce$\xe0\xd8);/\x93:\x15R\x88\x82BC\xf3\x90Q]\xa8x\x9e\x12\x94\x1a\x11\x81\x9e\xdd\x11\x05\xcf\xce\xc2\x0c\xf7\xf9\xd2\xa5\xcb\x8e\xc0\x8c\xc1\xb1_v\xf2\xa5\x87\xc6(v\xe0ep($\x0e\xb5\x8a\x8c\xe5iC\xe1\xa2\xa0\x93\xa4\x81\x8a\x81G\xdf\x89\x8aq\xe5\x00&\xe51\x81n\xe7\xc4T\x0c\x85E*\x86:\xf6*FF\xb7\xb1kvb%S1\x11\x94\x1dT\xe9\xa11\x8a\x9dO\x19\x1c\xd2M\xba\x98\x8a\x91\xb1<TT1\n\xbac\xceQ\xc5P8=\x82R8{\xd2\xab\x039\xaf\xa0bD0\x8b\x95\x9d4\x199\x8e\x91\xec\x80ID\xc6 v\xae\xa4w'\xe4\x14U\x8c\x08MR\n*F\x06'\x19\x05\x15C\xd1L\xc5DQ\xa3\x8a\xd1\xf0,\x18T1\n<\x06\xb3\x13\"\xa9\x8a\x89p\xec`\xc8T\xc5\xc4hP1\x1a:\xc9\x1f\xa8\x18\x15\x9f\xa4\x10T\x0c%\x10\x99\x91\xa6\x10T\x8c\x86g\xf1\xb0\xb3\x1e\xa9\xe0HG\x1e;\xe2\x91\x80c\xa7\xecdG\xeaT\x18\x7f\xa8b4t\x92BP1*\x9e\xa7\x04U\x0c%\xc4*&\x8a\x1eU\x8c\x02g\xd1\xb0\xc3\x1a\xb9\x8a\x89\xb1\xa0b\x12l\x0ccG3\x12\x97B\xf6P\xc5(`\x1e.\xaa\x18\x0d\xce\x93\x81*\x86\xe2#\x15\x13E\xce\x8e]\x0c:B\xfa\"a\xa7-\xc6\xe0\xd8/;d\xd1Cc\x14;[18\x14\xd2\x86*F\xc6&Y\x03\x15\xa3\xa0\x93\xa4\x81\x8a\xa1\xf0\xa0K8\x9a\x9d\x9b\x18t\x84\x944v\\b\x0c\x8e\xfd\xb2S\x12=4F\xb1\xc3\x11\x83C)i\xa0bd,O\x1a\xaa\x18\x05\x9d$\x0dT\x0c\x85\x13\x99\x91\xa6\x0dT\x8c\x86g\xd1\xb0\xf3\x0e\xa9\xe0H/Tv\xcc!\x01\xc7N\xd9\xe9\x86\xd4\xa9\x90>T1\x1a:I \xa8\x18\x15\xcfS\x82*\x86\x12\x88\xccH\xf0\xa8b4<\x8b\x87\x1dXH\x05G\x9aBvN!\x01\xc7N\xd9\xf1\x84\xd4\xa9\x90BT1\x1a\x9a\x87\x8c*F\xc5\xf3\x94\xa0\x8a\x89\x08T\xc5D\xc1\xb3\xa3\x07\x83\x8e\x90.]v\xe2`\x0c\x8e\xfd\xb2\x83\x06=4F\xb1\xf3\x05\x83C!q\xa8bd,O\x1b\xaa\x18\x05\x9d$\x0dT\x8c\x8b\xe0\x06[\xf4\xba\x97\x0c\xf1\x95wg\xe9`g\xe7\xc1\xb2\xa5&\xbb\x19?1\xce\x83\xa5\xfe\xa0\xe5\xfe\xa8\"\xe7\xf0\x06\xfbH\x83\x8d\x9a\x16\xccFLKf\x8a\x02YE\xc6E\xe4s\x1d\xd9\x86W\xab\xab>\xaf\xab\x12\x8a\xdd\xc14C\x89=R\xe7\x94\xc3\x06\xd9xbM{\xca\xeb\xe7\xf4z\xb9\x98\xb6\xb4\x0d\x0er\xef\xd0\xb4\xa7\x9d7<\xa7\xf6$\x0c\x11\xe3\x0d\xcfi\x99_\\]\xbf$\x8e\x82\xe59\x1dj\xcd$_\xf6\x0d\xab\xe7\xd4\x1e\xa14\xec\xf3\xed\xcc{S6\xc3n\xe3;oyN\xad=\xeb\x8fms\xfd8&8j\xb4\x15f\xba\xcb\xa1J\xb7Qz]\xe5\x9d\xd9\xfb\x1f>\x0e\x19<5\x8d\xdd\xc2\xf9cG \xef\xd9\xa9\xf9%k\xba\x1b\xc7|\xb4\xf9\xbd+s\xfbK\xce\xeeZ\\\xaa\x9b\xa9\xb3\x1fqm_\xf7\xd5|\xc2f\xbf\x83zwI,A\xb1\x0b]\x0c0\xd7\xd3)\n\xfb\x1bP\xbew\x01\xc9:\x83t>\x10|W\xcb\x042\x12\x80\x10\xba]f\xd0a\x01\x142:dN\xf4\x1e\xab\xefW\x8a\x95\x07\x0c\xf8\xa7CC!\xc5\xa3\xc7\xc7\x15\x06\x91\xc2\x8b\"#\xdd\x0e\xf0\xbf\xcf\xb8\x82p\xa4\xe1\xf5E=_\x0f2\x98\\a\x90\x91m\xbb\xe3A\x06\xb0a\x90%(\x1cd\x80\n\x83\xcc\x15\x8c\x0c2 \x84A&\x12\xc8 \x03\x02\x19d\"\x83\x0e2\xa0\xd0A&r\xa2\x17pC\xbf\x13\xac<\xc8\xc0\x7f4\xc8dR<\xc8|\\d\x90\xc9\xbc(2:\xc8\\\xd1\xdfi\x90A8\xe2 \x1b\xaf\xe7\xebA\xd6\xb7y\xf9\xcd\xfd \xc6~s?j\xd3\xf7\xf6\x085\xbb9\xc1\xf9c\x97Mgks\"(\xdce<\x86\xcd\x88\x9f\xcfjo\xb8\x1b\xf0\xd2\x99\xda\x94p>/f\xe6\xdaY?\xce\xe0\x929\x84+\x94vi!/\xf05\xd8^\x12k\xb0\x86\xb4\x06(\x8dkp\x85\xbc\xe09u\xaf\xdbfx6\xd0\x03\x7fX\x01\xaf\xe1\x863\x83\xdcg\xfb+#\x0e\xe9\x9b\x0bZO\xd5~_'>\x86R\xc4\x0c?\x1e\xe1\x98\xa1\x14162\xb1*(\x8fp\xb2CbzNa\xff\x83\x87\xfb\xb7\xaa\xed.\x15~K\x84\xea,Xq\xf7\x05\xf70\xd4\x0e\n\x83\x03\xc5\x95d\xae\xc8OE\x11j8\xfd)\x86\xd9\xa2\xc8\xd9\xa55\x11\xe4\xd2\x1an\xcf\xecE\xcaA\xae0A&5b\xe1sZ\xb4&\xff\x96}6\xed\xbe{\xd8\xff\xba\xe2](E\x04\xb6\xceC\xb0m}{=\x97yO\xf6\xd1\x18r\xf3\xee\x12\xec\x0bM]W\x97\xae\xea\xdei\x18x\xf0\xd5g6\x7f\x0c\x1b(\xe2\xdd\xe5g\xb6\xc0\x12,Xb\x01\xdc\x9c~f+(\x99\xc3\xe75~\x0e^6\xbe\x08K\xb6P\xb2\x80\xcf\xf3\x19\x16x\xc8\x1c\xeb^b\x01\xbaYA\xc1\x02\xab\xde@\xc1\x12)[(Xa=p\xa6\xc2g\xb6\xf1\xe1\"\xc9NVPf\xff\xb4\xb4\xcb\x0dA\xf6'i\x9f\xd9\xfc\xcf\xbfE\xcfk\xbb\xb7\xa5+\xc1L,\x97\xd3\xa5\xfd\x9f+_\x84\xf2\xcdf\xba\xd9l6\xaf\x88\xc7\x8a\x17kW\xb2\x0c%\xafk\xc4`\xee\x163\xf4\x86%\xab\x19\xb2\xb0d3\x94\xacB\xc9\xd6\xc7\xe6\x13NcX\x87\xf2-\x8d\xd9\xed\x9e	x\xb7\x11\xe7'n\xc2\xe9\x0b\xbf\x7f>\xa7\xbfd\xb3\xc7/\xf6\xc9\x87\xb9\xd9\x89\xf7\x97l\x1e>\xcf]\xc1\"\x00\x16\xae`\x19\n\x96\xae`\x15\nV\xae`\x1d\n\xd6\xae\xc0v\x81/\xb2\x1f\x9e\xffd\x0f0\xc9_~\x1d\xf6\xf7\\\xbfn.\xb7\x9f\x1e\xd3\xee\xf4\xe7]]uv\x01\xa13\xf6\x8c\x8a.H\x9a\xb3\xc1\xdf\x95\xdb`-2\xbf\\L\xde\xe6\xe7\x12~\xb8\x86\xf3t('_\x04\xbc\x90}\x1e<\x16\x1f\xf0C\x8c\"/\xbf}\xb8\xa3\xb5\xb2\xbc\xef\xf3\xf2xrG\x97\x0d\xbf\xc8\x00d\xdd\x94y\xad \x9d\xcd\xfb\x84\xdd\\d\xa8\xdf\xd8e\xc0\x92\xb57J\x10\x16\xe6\x80\xe0\xd6\xe4R\xa8\x7f\xbd\x10`tMN@\xe3\xc2\\\x82n\x050.\xccq\xb0\x00\xc5\x859\x02\x15P\xb80G\x1d\xbaG\x0b\x02\x16_/L\xb0b\xa8\xb80\x97\xa2;\xc99.\xcc\x01\xdcM\xaa\x12\xecp\xf0\x18\xb2&' qa\x8e\x83\xa5Xqa\x8ea\x05$.\xcc\x05\xa4\x00\xc2\x859\xe2N\xcb).\xccq\xa8\x9cRX\x98K\xc0rFaa\x0e\xd0\xf1\x9a\x9c\x80\xc7\x859\x01/\x05\x83\x0bs)\\\x00\xe3\xc2\\\x04\x16p\xb80\x17;\xd5\x92\x87\x0bs\x02Z\xce\x1f,\xccIx9\x85\xb00\x07\x04\xb2h\xa6\xa4\x10\x16\xe6\x04\xbc\x14\x0f.\xcc\xa5p\x01\x8c\x0bs\x11X\xc2\xc1\xc2\\\xecTM!,\xcc	h9\x85\xb00'\xe1\xc5\x94\xe0\xc2\x1c\x10\xa259\x01\x8e\x0bs)\\\x8a\x06\x17\xe6\x12\xb4\x84\x85\x859\x8a\x15`\xb80\x17\xb9\xd4\xb2\x87\x0bs)X\x0c\x17\x17\xe6\x04\xb8\x98\x0c\\\x98\x03|Xe\x93S\x07\x0bs	Z\n\x05\x17\xe68X\x80\xe2\xc2\x1c\x81\n(\\\x98\xa3\x0e\xb5\xb4\xe1\xc2\\\x82\x95\xb3\x06\x0bs)ZN\x1a,\xcc\x01<\xac\xb2\x89h\\\x98K\xd0R$\xb80\xc7\xc1\x02\x14\x17\xe6\x08T@\xe1\xc2\x1cu\xa8&\x0d\x16\xe6\x12\xac\x18*.\xcc\xa5h9i\xb00\x07p\xb2h\xa6\xa4\x0d\x16\xe6\x04\xbc\x14\x0d.\xcc\xa5p\x01\x8c\x0bs\x11X\xc0\xe1\xc2\\\xecTK\x1f.\xcc	h9\x81\xb00'\xe1\xc5\x94\xe0\xc2\x1c\x10\xc8\xa2\x99\x8c\xc7\x859\x01/\xc5\x83\x0bs)\\\x00\xe3\xc2\\\x04\x16p\xb80\x17;\xd5R\x88\x0bs\x02Z\x0c\x19\x17\xe6$\xbc\x98\x12\\\x98C\x02Y\x93\x13\xd0\xb80\x97\xa0\xa5Xpa\x8e\x83\x05(.\xcc\x11\xa8\x80\xc2\x859\xeaPK\x1c.\xcc%X1T\\\x98K\xd1r\xd2`a\xce\xc2\xe1Qv,f\xe0!v\xd2\x84\xe8]\xc3\x98M\x7f35\xaeoB\x8dT\xe6\xa8T*v$j\xabVJ\x95\x8f\xc0TyT\x06\xc5<\x95B5\x11\xab\x8a\xbe;:.\x90$\xe2H\xf3\xa8Z\x12\xa9\x9d^-\x95N\x81K\x7f\x9b5\xa2\xa3\x02\x81\xc8)\x95FE\x95\xc0\xd4\xdbG\x15VJTiTnE4\x95A\xb5W\\\xd1x\xdfQ!&\xf0\xc6\xba\x8e\xa82\x899\xd6sD\xa2\x05j\xac\xd4T2\xd5k2Y\x8f\x99\x8a7\x91\xab2\xa9\x92\xe3L\x95De]R\xddx\xbfP\x8d'S\xc7\xba\x86\x08>\x85<\xd6;D\xfd\x05v,\x02U2\x95\x822Y\x0f\x9b\xeaB\x91\xab2\xa9H\xe4L\x9dD\x14cR\xdd\x17\xbdC\xe4\xa3L\x1d\xeb\x1d\xa2%\x15\xf2\xe8\xb5\x13\x84e`G\xfaR\xe5R\x95)r\xf5\xa0\xa9\xe4\x94\xa8:\x91\xe8OFT9T\x8c\xf2\xca\xc6;\x86*S\x919\xd2D*Se\xeeHj\xa9f\x0dd*]U*\x15\xb0\x12U\x8f\x98\xaaY\x81\xa9\xf2\xa8\xb4\x8dy*\x85\xea\\V\xd5x\x8fP\xd1+\x11\xc7:\x84(`\x91:\xd6\x1fD\x0e\x07.U\xc5*\x95jc\x89\xaa\x07L\x85\xb2\xc0TyT5\xc7<\x95B%4\xab\xea\x8b\xfe zZ\"\x8e4\x8f\x8ak\x91:\xd6\x1fDi\x07n,\xb8U2\x95\xdd2Y\x0f\x9ajp\x91\xab2\xa9 \xe7L\x95D\xd5yR\xddx\xcfP\xa9.S\xc7\xfa\x86\xe8v\x85<\xd6;D\xc4\x07v\xac\xe5U2U\xf42Y\x0f\x9b\xca{\x91\xab2\xa9\xd6\xe7\xcc\xdd\xb1\xf9n\xda\xc7\xffg\xed\xebz\x1b\xd9\x91,\xff\x8a1O\xd3\x80Ym\xc9\xb6\xca\xd7\xf5\xb2\xe8\x19\xcc\xee\x02\xdb\xb3\xc06f\x9e\xfaE\xb2\xb3\\\x9a\x9b\xfa@*}-]\xa1\xff\xfb\x82\xcc y\"x\"]\xbb\xdd}\x81.\x8bq\x0e\x83\x1f)Rq\x98\x991\x1f\xf87\xee\xe6g\x07U\x00N\x9d\xe9&J\x02\x0eyfvP\x1f\x00v	\xfcg\xa8(\x160\xaa\xdfdT\x0e\x08\xd3\xe5\xa1\x8c\xa0y.\x055\x05\xe3j~NP``\xc4\x99\xee\xa1\xda@\xa9s\xf3\x01\xd2\x83\xdc\xe0d\xa4\x07y\xb7\xdf\xa7\xd2Ce\xe3\x83\x8e\xf3G\xab\x95\xa3\xa4\x07\xc7\xa5\x92\x1e\x18up\x9d\xa2\xf4@\x98.\x0f\xa5\x07\xcds)(=\x18Wx\xc3\xf7\xfc\xd9,#\xcet\x0f\xa5\x07J=\xf9nQz\xa8\\|\xa0r\xe6\x08\xb7\x12Pz\xf0\\\xa1\xf4@\x98~\xffPzh\x89.\x0d\xa5\x07Es\x19(=hG\xf3s\x87\xd2\x03\xe1\xcdM\x1dH\x0f\x8c97s =T\xaa\x91\x1e\xbc\x9e\xa2\xf4\xc0\xc9~\x9bQz\xa0\\\x97\x89\xd2\x83e\xba$\x94\x1e\x1aw\xf3\xf3\x82\xd2\x03\xa7\xceM\x0dH\x0f\x0eynv@z\xa8l#=x]F\xe9\x81\x93\xfdf\xa3\xf4@\xb9.\x13\xa5\x07\xcb\xf4I =4\xee>\x99\x1d\x90\x1e8unv@zp\xc8\xb3\xdf\x9d*=T\xb6\x96\x1e\xbc\x1e\xa3\xf4@\xb9~\xa3Qz`T\x9f\x08\xd2\x83!\xba\x1c\x94\x1e\xac\xb3\xf9\x89A\xe9\x812g\xba\x88\xd2\x03\xe7\xce\x0c-J\x0f\x95\xac\xa4\x07\xaf\xb7(=0\xaa\xdfb\x94\x1e\x08\xd3\xe5\xa1\xf4\xa0y.\x05\xa5\x07\xe3j~FPz`\xc4\xb9	\x01\xe9\x81R\xe7\xe6\x03\xa4\x87\xcaU\xd2\x83\xd7U\x94\x1e\x18\xd5o0J\x0f\x84\xe9\xf2Pz\xd0<\x97\x82\xd2\x83q\xf5\xc9|\x80\xf4\xc0\x883\xddC\xe9\x81R\xe7\xe6\x03\xa4\x87\xca5\xd2\x83\xd7Y\x94\x1e8\xd9o4J\x0f\x94\xeb2Qz\xb0L\x97\x84\xd2C\xe3n~fPz\xe0\xd4\xb9\xb9\x01\xe9\xc1!\xcf\xcd\x0eH\x0f\x95m\xa4\x07\xaf\xcb(=p\xb2\xdfl\x94\x1e(\xd7e\xa2\xf4`\x99.	\xef9h\xdc\xcd\xcf\x0eJ\x0f\x9c:\xd3M\x94\x1e\x1c\xf2\xcc\xec\xa0\xf4\x00\xec\xa2\x1f\xccPQz`T\xbf\xc9(=\x10\xa6\xcbC\xe9A\xf3\\\nJ\x0f\xc6\xd5\xfc\x9c\xa0\xf4\xc0\x883\xddC\xe9\x81R\xe7\xe6\x03\xa4\x87M\xceV\x86\xb8\x92%&?\x11#\xb7S\xc8K\xfb\x192\xbf\xbd_\x90\xf1M\xff\x14\x17\x0d\xc5s\xfc0\xe7>\xdaoL\x1bbY|\xa8\xc7\xad\xfd&=a$\xe8\xc1\xdeUR\x1a,	\x05\x107\xd7\x94\x04\xb7mI\x85nc\x92U\xb5\xc6C\"f\xe8\x8e\xddZ\x8d\xddT\xf2<\xfdSZ\xbc?\xf8\xd0b\xab\xfdK\xe4pv\xeb\x0dg\x8b\xbd\xf8\xd8K\xc1\xc6'#\x10\x97\x9e4\x97|Gr\xcdX\xbd.ARi\xa9\xe5%&\xe0\xd9\xee	*\x95\x0b\xee0\xbcv\x03*dW)\xf2\x9e2\x98\xac\xf2\xa4\x01B\x95\x14&\x16\xd4\xc1\x0c\x1a\xd5\xaf\x06=\x180\n^\x16l\xa0\xa8q\x89%\xb6\xc2\xa0P\xd6\x12K}\xfb\x97\xc1\xaa\xa7\x0c,\xb6i*\x8aW-\xfad+G\xbdJ,\xf2\xa4\x81jC}\xca`*\x06}\xca Q\x95\xb2`\xdbV\x14\xa2\x0c\xd6 Q{\x12\xcb\xd0\xbd\x1a\x10\xcaMb)o\xff\xb2PP\x98,\xb4\x1dR\x10\x95\x1ap;\xa2\xa0#	Z\x8bH\x06\x8f\xd2\x11\xc1\xdb\xc6\xa0Z\xd4\xc2\x0d\x18\x05\"\xb1H~\x0b\x8dCMH\xe1\xf2\x1d|\x1a\x0d2\x10A\xb7\xe3\x07\xca\x0f\xc3\xb7C\x08b\x8f\x10\xb4\xd2c\xf0\xa8\xef\x10\xbcm\x0fJ:-\xdc\x80Q\xc5\x11\x8b\xa4\xce08\x10n\x14\x8e\x0f!h5\x04\xdd\x0e!\xc83\x0cO\xae\xc2\xaa\xc8\x08A\xc91\x06\x8e\"L\x0b\xb7\xadA\xdd\xa5A[,H-bI\x0d10TW\xc4\x02o\xff2`\x14TZp\xd3\\\xd4P\x08\xbc\x19\x0c\x94M\x04\x8f\x9a\x89A\xa3R\xd2\xa0mSP\x1c\xb1`\x03E=D,\xb1\x15\x06\x85\x12\x88X\xea\xdb\xbf\x0c\x16U\x8f\x06\xdb\x8e\x1a\x08\x1d-\xba\x1d4\xd06\x04\x8e\xc2\x86A\xa3\x9c\xd1\xa0mKP\xc1\xb0`\x03E\xd1B,\xb1\x15\x06\x85:\x85X\xea\xdb\xbf\x0c\x16\xa5\x89\x06\xdb4\x15\xd5\x88\x16\xdd\x0e\x1a\x08\x10\x02\xd7\xea\x83\xc1\xa3\xe6@\xf0\xb65(3\xb4p\x03FeA,\x92\xa6D\xe3PLP8\xfaUE\xfd\x80\xa0\xdb\x01\x04\xc9\x80\xe1\xdb!\x04\x95@\x08Z\"0x\x14\x06\x08\xde\xb6\x07\xb5\x80\x16n\xc0\x18\xfe\x8bE2\xa0h\x9cz\xca\x00qt\x081\xc8'\xe8\xa6\xc9\x18\xd73|3\x84\x18\xcagB	\xc6\x1b4F\xef\x0d\xda\xb6\x05\x03v\x0b6P\x8c\xd1\xc5\x12[aP\x18\x96\x8b\xa5\xbe\xfd\xcbb!\x12o\xb0MS1\xf8n\xd1\xed\xa0A\xbc\x9do\x13h\x82\x99|\xa3\x006\x0bb\x1d\xc6VO\x1a\xb8\xf1\x8d\xf6\xa8\x8e\xfb\x89K\x15\xecx\xd4\x81:\xc5\xc8\xc7aR\x1e\x86A-\x8fR0&\"\xae\xd4\x0d\x1fn\x80\xe4\x11\x9d\xeea\xb4\xe4RO\xdc-\x86N\x9a\xab\x9e4\xe0q\x94&\xe0q?s\x85A\x95\xc3\xe4\xfd\xc3\x08\x8b\x13)\x0d\xc3\xad\x86F\x19\x18{\xb5\x8e\xfc\xb9\xc3@\xcc\xe1yS\x07Q\x99\xc7\xf4f\x0eB4M5\xc7\xfd\xac\xa7\x18\xaf\xf9d\xdef\x0c\xde\\.eb$\xc7\x98\x94\x84a\x1du\xe7\xcf\x0b\xc6x>\xd5\x9b\x1a\x08\xf8f\xc8\xde\xec@\xf4\xa7\xd9\xe6\xb8\x9fu\x19CA\x9f\xcc\x9b\x8dq\xa1\xcb\xa5L\x0c\x12\x19\x93\x93 b\xa4\xeeff\x07\xc2G\x9f\xea\xcd\x0e\xc4\x923d\xf7\xbbS\x03K\xcd\xd6\xc7\xfd\xac\xc7\x18e\xba\\\xdeh\x0c9=*'B\xfcI\x88\x94\x83\xc1(s\xe6O\x0cF\xa6.\xd3\xe9\"\x86\xa9>\xd7\x19Z\x8cY5Y\x1d\xf7\xb3\xdeb\x00\xebQy\x8b1\x9au\x98\x94\x87\xa1m\xcb\xa3\x14\x8cs\x89+\x7fF0\xe8\xf5\x88\xde\x84@\x04\xecR\xbd\xf9\x80pXs\xd5q?\xeb*\xc6\xc6\x1e\x957\x18\x03e\x87Iy\x185\xb7<J\xc1\x10\x9a\xb8\x9a\x99\x0f\x88\xa7=\xa2\xd3=\x0c\xae]\xaa7\x1f\x10ik\xae9\xeeg\x9d\xc5\xb0\xdb'\xf3Fc\x0c\xeer)\x13\x03r\xc6\xa4$\x8c\xce\xa9;\x7ff0T\xf7\xa9\xde\xdc@\xdc>C\xf6f\x07\x82x\xcd6\xc7\xfd\xac\xcb\x18\xd1\xfbd\xdel\x0c\xef].eb\xac\xcf\x98\x94\x84\x81?u\xe7\xcf\x0e\xaa\x00>\xd5\xe9&J\x023dgvP\x1f0\xec\x12\xf8;T\x14\x0b<*o2*\x07\x0e\x93\xf2PFhy\x94\x82\x9a\x02q\xe5\xcf	\n\x0c\x1e\xd1\xe9\x1e\xaa\x0d.\xd5\x9b\x0f\x90\x1e\xf2m\x02\xb2/Um!\xdf(\x80=m\xa4\x07\xcdVO\x1a\xb8G\xab\x9aS\xf5\x83\xd3H\xa9(=x\xd4\x812Qzp\x98\x94\x87\xd2C\xcb\xa3\x14\x94\x1e\x88+u\xc3\x87{6\xeb\x11\x9d\xee\xa1\xf4\xe0R\x9dAE\xe9As\xd5\x93\x06\xfc\x08W\x13\x8a\x80\xe0\xcd\x1fH\x0f\x0e\x93\xf7\x0f\xa5\x07N\xa44\x94\x1e\x1a\x1ae\xa0\xf4\xd0:\xf2\xe7\x0e\xa5\x07\x87\xe7M\x1dH\x0f\x1e\xd3\x9b9\x90\x1e4UB\xe3\xb9\xef\x12J\x0f>\x99\xb7\x19\xa5\x07\x97K\x99(=0&%\xa1\xf4@\xdd\xf9\xf3\x82\xd2\x83O\xf5\xa6\x06\xa4\x87\x19\xb27; =h\xb6\x84\xc6\xb3\xb3\x03\xd2\x83O\xe6\xcdF\xe9\xc1\xe5R&J\x0f\x8c\xc9I =Pw3\xb3\x03\xd2\x83O\xf5f\x07\xa4\x87\x19\xb27; =h6\xc8\x07\x0e\x17\xa5\x07\x97\xcb\x1b\x8d\xd2\x83G\xe5D\x90\x1e\x08\x91rPz`\xce\xfc\x89A\xe9\xc1e:]D\xe9\xc1\xe7:C\x8b\xd2\x83&W\xfd\xc0\x9b\x15\x90\x1e<*o1J\x0f\x0e\x93\xf2Pzhy\x94\x82\xd2\x03q\xe5\xcf\x08J\x0f\x1e\xd1\x9b\x10\x90\x1e\\\xaa7\x1f =hn\xd5\x0f\x1c*J\x0f\x1e\x957\x18\xa5\x07\x87Iy(=\xb4<JA\xe9\x81\xb8\x9a\x99\x0f\x90\x1e<\xa2\xd3=\x94\x1e\\\xaa7\x1f =h\xae\x84\xc6\xb33\x02\xd2\x83O\xe6\x8dF\xe9\xc1\xe5R&J\x0f\x8cII(=Pw\xfe\xcc\xa0\xf4\xe0S\xbd\xb9\x01\xe9a\x86\xec\xcd\x0eH\x0f\x9a-\xa1\xf1\xdc\xec\xa0\xf4\xe0\x93y\xb3Qzp\xb9\x94\x89\xd2\x03cR\x12J\x0f\xd4\x9d?;(=\xf8T\xa7\x9b(=\xcc\x90\x9d\x01F\xe9\xc1\xb0Qz`\x1dF\xe9\xc1\xa3\xf2&\xa3\xf4\xe00)\x0f\xa5\x87\x96G)(=\x10W\xfe\x9c\xa0\xf4\xe0\x11\x9d\xee\xa1\xf4\xe0R\xbd\xf9\x00\xe9!\xdd\xd3\xdd\xbdN/\xbc\x97\x11\x1c\xd6\xaf\xdb\xf7\xd3\xf3\x9dF\x9cv\xc6^2\x9a\x01\xcaBZDL\x8bf@-&%\x1c\xd0\xa8_~\xf9\xe5\x97\xe3YW%\x19[\x048\x1e\x8e\xd33\x08\xe2\xfc\xee\x1b\x18\xd2-\xffN\xcf\x86\xa6\x1a\x8d\xce\xf5L\xcf\x18\xccV\xb5QUQ\x82\xa9M\xb5\xd8\x8cT\xd3.\xda\xbd\xcf+\x1aa\xf2\xec(\xc9,\xe6V5\xbdg\xb3\x1c\x06S!c\x98~2\x88n\xe5\x06*\x9d!\x99zqHh[{\xd3VB\xf8\x7f\xadrt\xeb\xfbd,I]\x83?\x8e\xacm\x9f\xd6\xb7\xb9\xea\xce|^\xa5\x1a\x10Rc\xffs\xbd\xfd\xc9\xdaFX\x00\x9a\n\xb1\xbev<\xda\xa6\x0d\xa62\xddY\xd2\xba\x16\xa0\xfb\xba\x81\n]\xca\xdc\xf8\xb5U\xf6\xa6\x8dj\xb8I\x13\x1b\xbbn\xe1\xa8\x16G[\xe1\xb4J~\xf3\x86\x84-\xa2CS!\xa1\x98.\x7fZ\xe9FU\xea\xb3f\xfa\xce\xda\xda7m\xc5\xd1\xfa\xff\xaar\xfcd\x955\xa3\xff\xd9^\xa1\xe1\x1b\x0dg\xa3g\x19=c`/\x0da\xfc\x89\xf5\xcd0~f\xf5\xd6\x94\x0dRX/8\xaboY\xd8\x13J\x1a?Ynl_\x10\xad.K\xb6\x94m\x06\xd3\x9c\xcf\x19\xbda\xa8\x0e\x10\xc2\xf8\xe9\xf7\xdd\xf6\xc0\xe0u\x93Z\xc2\x06	\xb4\x1b\x84\xd3\xb7\x1c\xd5\x91\x962\xfe\xccw\xad\xe9\x8a\xa5\xa8\xce\xb0o\xf4F/?\xac?\x94\xd63\x1av	Y\xd2\xa0\xd3\xa1\xdf\x96_\xa8S\xaa\xcaT\xa4n.~]\x9f~\xd4\x87\xd2&\xd4T\xa6a\x87ql`\xa9L\xc1\xf0\xbb\\\x13O)\xc8]\xaed\xca\xa5%_o)[j\xe32\xaf\xb3R\xfa\xa0\xcd\x0f\xc6\xfc\xa4\xcdO\xda\xac\x8d\x0bm\x0ccmX\x9cH\xd68h\xfb4\xcf\x0c\xb4	wfr\x19\xaa\xaf\xa84\x89\x0c3\x86e\xdb\";\"0d\xd8&\x0b\xdb\x84%m\x95\xc5\xf5aI\xdaeQcxh[f'\x03f\x0b[fa\x9b\xf0@[fq}x -\xb3\xa81<\xb5-3\xd7A\x18\xc2\x13k\x99\x85m\xc2\x13m\x99\xc5\xf5\xe1\x89\xb4\xcc\xa2\xd4oh~	\x0e\xacU\xf6:\xb5\xbfw9\xaa'-*5\xbd\xbc\x0f\xa7\xc30=1=\xfd\x0d\x0fJ\x8b\xf1\xb5\xfb\xbe~\xef\xc7l\x97\x8f\nr<l\xf7\xf1\x89\x04\x81\xc8G\x05\xf9XoK\x15\xf1oe\xdc\x1d~\xeb\xb21\xfe\xad\x8c\xfb\xc3\x18\xd6\xf1Y\xc1\xee5c\xa0H\xbe\xb9\xfd\xe1\xe5\xd7\xeb\xeb\xf6t\xec\xd7\x97\xe9\xd3d\xd8\xeec\xba\xc9\xa0\xedX\x880\x03\x98L\xe3z\xd3WK\xfa\x04\x860\x1c>\xb41\x96 \xe0\xa5\xeb{\x83\x88Er\xb3PJDY\xcc5}\xde\xf7\xbe;\x97\xe2\xf8AuGY\xa57\x15\x14\xff\x8a\xad\xb8\xa6?^\xb7C\xf7\x92^\x87P\x1a\x96\x01a\xe8~\xeb\x86SG\x80\xd9$\xc2b$\xbc\x1cz\x0b|9\xf4\xef\xbb\xbd\xc6x\x95NXRoL\xe7y-\x7f=\xc7\x8f\xc6\xaa\xab,(R\xd7\xfe`\xab\xcb	<\xe3\xc0l\xc7nw\n\xa7q=\x8c\xd7)\x17l*\x99\xc6#\x15\xcb(\xc7\xd2\xd0\xed_[T\xb7\x97+n\xc2\xc8\xcb'\x10\x86/\x9dH%51-\xc2r!\xd6v\x1a\x87n|\xf9\xa1pR6\xc1N]\xff}\xfa\xb2N\x90\xf8\x19\xbe\xb0\xf1\xa3\xea^,hz\x17\x0b\xa1s\x15S\xfa\x16\x8bt\xd7b\x89\xea\x99\xb8\xc2\xf6\xc6\"\xdd\xdc\xffz?\x8d\xdb\xef\x17iR\xfe\x14_\xac\x10\xb35\xdave{l\x1a\xc5\x96\xf6\x15k\x1a\xea\x06\x8c\xed\xcc\xb6M7~\xc4\xfc`\x16\x9b\x92\xcdf\xabn\xf5:\xfd\xe2r\x18\x93Q\xd6\xa9\xa9.=b\xac1\x19\x88S\xe4\x8dF\xc6\xd6\x89\xe2c\x91q\xd2\x05\x83%\xdd\xcb\x04\xe9\x1e\xc3c\xe7\xd2,-\xd2\xf7\xf39\x1f\"\xc7\xa2x\xd5M\xa5\xf5\xfa\x8b\x1f\xc3v\xbf\x1d\xb7\xebi\xa9x\x96\x0f\xc0\x8bK\xdcd\xd3\x8b]x++V\xfcK\xf9:\xfd\x18\xe2\x93t\xf0\xb72\xef\x0f\x96|\xa7\xad\x84_\x10\x87\xf5(/dI\x7f\xe3+XRA\xfaQ&\xb6\xfa\x9e\x98\xf49\xf7%\x9ajg^\xfan=|\xdf\x9e\x9f\xd7\xdf\xe3\xd2 \xc3\xfd\xfcO\xff\xf4-/\xd8i\x13\xf8\x96p\xf1e6\xf2\xdd\x9eR\x8d\xaf\xf7\xa7k\xfa\xeb\xfbz\xb7\xed/\xcf\xa7\xcbi\xecv\xe1}{\xfb\xa7~\xbb\xff\xf5\xcf\xeb\x97\xbf\xa4\x92\x7f;\xec\xc7\xdb\x98\x89\xb5\xef\xc2\x84\xb9\xfdK\xf7v\xe8n\xfe\xe3\x7f\xde\xfe\x9f\xc3\xe60\x1en\xff\xf7\xf9\xf2\xd6\xedo\xffc\xf3\xbe\x1f\xdfo\xffe\xbd\x1f\xd7C\xd7\xf7\xb7\xff\xb6\x1d\xd67\x7fY\xefO\xb7\xff:\x1c\xb6\xaf\xd3\x9f\xff\xa3\xeb\x7f\xebb\xd6\xec\x9b\x7f\xef\xde\xbb\xdb\xd8\x8ep\xea\x86m9F\x88i\xca\xe3g\xd5\xba\x7f9\xecOc\xca|~\xfb\xbf\xde_\xb6\xaf\xeb\x9b?\xa5\xf1\x93O\x1b\xfcp\xf3\x97H\x97\x0f\xb7\xff\xda\xfd\xd7\xfa?\xdf\xa5\xecO\xdb\xf14\x0e\xddzw\xf3\x9f\xddP\x80\xdbM7%t\x97\x82\xff\xde\x1d\x86\xb7\xed\xfa\xd66kw\xd8\x1fT\xab\xfe\xdc\xed\xfb\xc3\xed\x9f\x0f\xfb\xf5\xcb\xe166\xf1\xd0\xafOX\xdf\x9f\x0f\xfbhx\x1f\xb6\xddp\xf3\xef\xdd\xc7m\xac\"}U\xa0\xda\x1f\xebm\xcaX?U\xfd\xd1\xc5\xae</\xee\xf2\x85\x13=\xc7\xa4\xf0\xca\xbcT\xe6t\xfa\xa1\xec\xf7\xca.	\xab\x11\xf0\xa0\x001\xb9\xef\xfbN\x01\x1e\x15\xe0\xd4\xed\xb6\x9bC\xff\xaa +\x05i\xcc_\x95\xb9;\x8f\xc3\xba\xc1<\xe9*\xe2}\x95\xca\xc5/ww\xea\xd6O5`r\xcf'\x1d6\xb9U\xb4\x8c\x1e\xc1.\x19\x16\xefa\xa5\x03\x8a\xe0i\\	\xfa\x81\xa1\xa7A&\xe8G\x86\xce#N\xf0+\x86w\xb0_\x19\xb6\xcc\x05\xa9\xfc\x89\x11\xf0Y[:=r<\xa6\xa7'\x15zWu\xc5\xc7\x8b\x9b`\x97\x0c\x8b\xe7|\xce\xf5^+\x96\xe9i\xab~`h\x99\x9e\x16\xfd\xc8\xea.\xd3\xd3\xe2W\xac-\x0e\xf6+kw\x9d\x9e\x96\xf0\xc4:\x8a\xf7#\xf3oOX\\\x7fLe\xa8\xa7\xfd\x08\xcbR\x8c\xa5\xf7\xa5T\xf2\xcbO\xc5\x0f\xb9x\x01\x85\x8f\xa5P\xd7\xbc\xaa\xe5X\xfc\x94\x8b\x97P\xb8\xb8+\xa5\n\xbc(\xcd\xbb\xc7\xd2R\xf5\x03\x94.K\xebVPz_jx\x82\xd2\x87\xd2\x8a\xc5\x12\x9b\\;\x88u\xc4\x9f\x1d\xd9P\x7f\x82\xfc\x08\xc7s\x81\xe7(\xf7\xc7\xa4\x98\xe5\xe2\x94\xc7}\x02K.\xf7j\xf9Mv\xe3\xbe[\xbfn\xf7o\xd3\x16\x1f\xbf6!c\xb4}\x8c\x9e4\xe0\xcb\xf2\xd1\xd6\x91\xd6y\x0d2\x98\xfep8u\n\"\xf7:\xec\xc2\xddu\xb7\x1e\xde\xb6\xfb\xfc\x9be\x17\x16\xb9\x04\xe7v\x17\x96\xa5\x18K\xefK)\\5\xbb\xf0\x90\x8b\xebU\xb3\x0b\x8f\xa5P\xd7\xbc\xaa\xe5X\xfc\x94\x8b\xebt\xed\xe2U\x93K\x15xQ\x9aW\xaf\x9a]X\x94\xaa\xebU\xb3\x8b\xc9\xf2\xa5\x14kX\x966\xd7\xeb`\x17\xaf%)\xad\xd7\xd2n\xba:\xa4\xbc^\x1d\xbbxuHi\xb9:v\x972\xc4Q\xef{\xbe\xfb&\x1f&Y\xa5\x0c\xfb\xb9\xc2\xe2\x0f\xc1\x8aK\xbfr\n\xecR\xa6'\xd5&G\x99\xbaJ5\xba\xe7JH\xf5j\xc6\xd0,\x0f\xbbK\x99\xe9\xc9\x03s\xa0\xea/\xf0\xa9~R=\xc0/\xe5\x8a\x99j\xff\x8ap\x19\x12u)\x9d+a\xaa\xff+i?\x12.\xe5\xe2K\x1e\x16m\xfdpM\x9e+8\xd5\x8e\xe8\xa9n\x00_\xca\x15<\xd5L\x07__\xdb\xe7J\x99\xeag\xc3\xaf)\x97\xb0\xd2^\xa8\x13\xe8\xf09\xac\x8c\x0f\xe6B\x8dP\xfej\xa5~,\xdbN\xc07\xee\\\xc1\xa9\x07\x88\x9e\xea\x06\xf0\xa5~?\xa7\xaaY\xe3\xd5\x17\xf7\x0c\x8c\xa9~\xd2z\xc5\xb8\xd4\xefz\xf2q\xdf6\x1f\x96\x803\xa0S\xfd\x08\x9f\xda\x0f\xe8K]1R\xdd\x0fm\xdd\xb0\x90\x9c\x01\x9d\xeaF\xf8T7\xa0/u\xddIu?\xb6u\xabq\xa9\xe8Tw;*jL\xca\xea\x95\xea^\xb5u\xc3\xa2v\x06t\xaa\x1b\xe1S\xdd\x80\xbe\xd450\xd5\xfd\xd4\xd6\x0dK\xe3\x19\xd0\xa9n\x84Ou\x03\xfa\x82+i\xaa=~6\xd7\x0b,\xb0\"\x0c\x88=\xd5\x8f\x84\xa9~\xc0_\xea\x8a\x9cj_\x1c\xcf\xa6\xf2\xbaN\x9f\x01\x9bj\x06\xb0|O\x0bv\xac\x8bu\xac6/\xceC-\xd6\xab\xf6\xa6\x1a\xc4o\xa6\xf4\xd5\x92\x9c\xe6\xf2\x91\xad\xf3b\x1b\xaa\x8d\xac\xdf\x9b\xb00\xbe\x14\xb9\xa7\xfbA\xf1\xaa\xa6\x1a\xbfvCX\x1a\xa7`\xdc\x84\xa5\xf5	\xd6\x9em\x11\xc5#\xd9\x0f\x8a\xcb{\xe3\x12\xad\x9bpo}\xa2\xb9\xa7\xfb\x86\x18\xc7v\x8f\x10\xcbP-2\xe9\xe8\xf1\xc1x\x04cOv\x92\xe2\x8d\xed\x1b\xc5\xe1\xa3q\xa8\xcc\x9b\xf0h}*{\x1f\x1e\xcd\xe2\x8f\xd6\x91\xed%\xc5\xf1\xca:\x06\xe6&\xac\x1a\xbf`\xee\xc3\xca\xb8\x05\xe3\xd8\xee/\xc5\xe7\xd3\xd5\xdb;6\xe1\xc9x\x04bOv!\xe1\x8dt\xcf\x11\xe3\x00F\xd9\xad\xb0\xa5\x1b0g\xa7h\xef\xf9\xee$\xd6\x91\xecDb\x1a\xc0\xd4\xec2\x1b0\x8aW\xd8\x83z\xb6cU\x8f\xcd\xfeT=\xae\xae\xde\xde\xb3\x01\xa3x\x84\x9d\xa9g\xfb\x980G\xb2k\x89i\x00\xd3\xd4G\x18\xb9\x0d\x18\xc5\xa3\x1aW\xb2\xbbU\x8f\xcd^V=>\\\xbd}j\x03F\xf1\x08\xbbX\xcf\xf6<a\x8ed\x87\x13\xd3\x00\xa6f\xf7\xda\x80Q<\xc2\xde\xd6\xb3\x9d\xb0xd\xfb^\xf1\x89\xc6fO\xdb(\xb3\xf8\x85=\xaf\xe7{d\xf1\xdc\xec\x88\xc5\xed\xf1l\x16\x84\xb2\xdfm\xc0&\x1e\xab\xb1'\x1b\xa7\xf0\xd6\xe7 \xd1q\xfa\xd3F\xc8\xa90G\xc9\x1a\x91#\xe5X\xfa\x11\xce\xa7d\x96{5\xee\xea@&\xebi\x07\xd6{k\xdd\xbd\x82\xf5\xc1Z\xfb7\xb0>Z\xeb\xb9\x07\xeb\xcaZ\x97\xca\xfc\xd5\x9a\xef\x95\xf9\xc9\x9a\x1f\x94\xf9\x17k~T\xe6\xc5\x9d\xb5\x97Q\x95s\xf9\";\xec\xb6\xfb\xf0#\xfe\xaa\x88\xffv\xeagH,\x11b5Zf\x9e\x8e\xed\x9eM\xc7v\x1f>\xa4ru+I*\xa8u\xdbF\x85Vh\x08\xa04\x04\xdc\xb5\x02h\x0d\x01\xd6\xdb\xb0+[\xfas\xc0\xbd>\x80\xde\x10\xea\x96\x1c@q\x08j\xd7\x0c\xa09\x04\xdc\xf6\xc2\xae\xec3\xcf\xa1n?\x01u\x87\x80;D@\xe5!\xd4U<\xa0\xf6\x10\xeaZ\x1bP}\x08\xaa\x9e\xb2>=\x87\xban\x05T B]]\x02j\x0d\xa1|\x17\xc3O\xaa\x0d\xe1'\xe5\x86`\xf5\x06\x99(S\xab\x99>+9\x18\xd2\xb4\xa2\x19\x8eQ\x1d\xc2\x17\xea\x06\x07\xcc\n\x0f\xe1\x0bs\x82\x0c\xa3=\xc85\xd4\xf4E]YV~0\xa4\xdc\x17\xc51\nDX\x10/x\x99Z\x11\",Z\x0f\x887:D\xbe\xb6mG\xa4\xb8\xf6\xe4\xd1x\xa1\xd3bXF\x8d\x90oK\xeb\n\xfbo\x05	C*\x8e\xf4\x98\xe5/^\xda\x96\xc2\x92x\xc1/\xa4\x95%\xc2\xb2\xf5\x80x\xabL\x84%\xed\x88\xfen7\xe2\x84ae?\x8ad\xf5\x89pO\xba\x82+E#Q\x84\xfb\xd6\x07\x12\xacJ\x11\x1e\x88\x07\\s\x1a\xa1\"<\xb4\x1e\x90`\xb5\x8a\xc0\xc6\n;\xdd\xc8\x15\xe1\xb1\xf5\xa0G\xa9\xfe\x1eK\x1e\x88d\xa1\xd6\xc1F\xb4\x08\xab\xd6\x03\x12\xacn\x11\x9e\xc8(\xe1\x8a\xdaH\x17\xe1\xa9\xf5\x80\x04\xab.\x04P\x0c\xb2\x03X\x9a\x1b\x81\x01\xf1R=\xc0\xb9\xc6\x10\\\x91!\xf8*C\xf0d\x86`u\x06\xb3*7J\x83\xb1\xb7Z\x83\x01X\xb5\xc1\x98G\xb6\xea\xe767\x8a\x836\xb7\x9a\x83\xb6[\xd5A[G\xba\x17T\xd7\xf7\xd6\xb5Z\xe1[\xed\xc1\x00\xac\xfa`\xccF\x7fP\xab{\xa3@(\xeb&<X\xcfh\xb6*\x842\x8e|\xdf\xc8\xe6!<Z\xc7z\xb6Z-\xc2\"\xac\x1aa\xedF\x8f\x90\x8d![\x87\xb0j\x1a\xa0\xfd\xafZ\xff\x08\xb0\xaa\x84\xe1\x1b]B\xed\x0fCx2\xbe\xd1\xdaj\x13\xcal\xd5	e\xb4\xfa\x84\xd9cZ\x85\xc2\x00\x88Fa\x10\x8dJa\xecV\xa7P\x9bI\xabT(3\xd1*\x94\xbdQ+\x94u\x84\x8d'y\xc6Mf\x00\xa3\x8c9\x9a\x89f\xa1\xec\x8dj\xa1\xacV\xb7P\x9bO\xab\\(3\xd1.\x94\xbdQ/\x94ud;[6\x0e`${\x16\xd10\xd4\x9e\xd6\xa8\x18\xcaju\x0c\xb5Y\xb5J\x862\x13-C\xd9\x1b5CYG\xd8\xda\x92g\xd8\xc7\x06\xb0I\x97\xc1J\x94\x0547\xdaB5\x1e\x8e\xeb\x97\xed\x18\xe3\x1d\xf9+o\x84\xd9\xb0|,\x96rh.\x05\xe1\xb1\xb2\xf2Yy6}\x05\xdaWc[\xdcU\x9e\x1c\xd5\xc7\x1bf\xbe\xc7\x97\x1fE\x91\xe5\x9a?\x81\xe4R\x00r7v\x81L\x9f\x0d\xe8\xb7\xedi\x1b\xef\x08/()0\xb0\xd3\xcbp\xe8\xfb\x8a\x9a>\x1b\x90\x9c\x96dP8\xb3V\xc9\x11L\xfd\xcc@g\xdb\xf8p\xe6\xcd\xbf4\xc0\x0b\x07\x9e\x9b\x9e\x86\xb3\xd3\xd7K\x0b\xbd8\xd0\xb3\x1d\x98p\xe6Csi\x80\x17\x05\x9c\xfe\x8e\xb7h\x8c\x87\xf7\x97\x1f\xd7\xf0\xd1m~\xdd\x8e\xa1\xc0\x0b\xe09\x01\xe4\xde\xe7\\8\x8d\xe9\x0c\xa9\x8e\xf11\xdc]\x8f\xeb\xd7x+G\xbe\x80\x8faQ\x8ap\xff<\x86e-\xc7\xe2\xfbZ\x0c?3\x8e\xe1\xa1\x94\xd7\x9f\x01\xc7\xf0XKu\xed+0`\xf9S)\xaf\xfb\xda1\xee9\xa5X\xc1\x17\xb5\x95u/8\xc6\xd5:\x17\xd7\x85\xfa\x18\x97\xd2\\\x8c\x95,k\xdb\xeb\x12w\x8c\x8bP.\xae\xeb\xcf1.\x12\xb9\xb8\xac\x0f\xc7K\x1dY\xd1B\x04\x93\x17\x9a<\xdcg\x00\xca\xbd\x17\x19\xa9\xd4\x90\xe3\xa5N\x0c\xde}a\xaaU\x83z\x06\n\xde\x7f\xa1\x1d(\xca\xa5N3\xdc\x81a\x9d(\x1f\x95\x00\xf7`\x18\x17\xcaC\xb9b\xf0.\x0c\xeb\x02\xaf\xa53P\xf0>\x0c\xe3\x04)\x97z\xfd\xd5;1\x8c\x0f\xb8.\xcf\x00\xaf\xf7b\xe8\xfa\x01~\xa9\xd7\xb1\xba\x1b\xc3:0\xb3QIrn\xb5|$n\xcc|\xac\x8c'\xeeH\xf9YY?\xd4\x8d\xf2R\xbef\xf5\xae\x0c\xd3\x19\xf8\xfa\x9d\x01^\xef\xcb\xc8x{cF\xbcr\xf5\x97A\x84\x0c\xeb@w\x028xo\x86\xf1\xa2{Q\xbf\xfd\xf5\xee\x0c\xe3\x05V\x853\xe2\xeb\xfd\x19\xda\x03\xe0/\xb0\x8c\xd4;4L\xfd\xb0\xbc\x9c\x11_\xef\xd1\xd0\xf5\x03\xfe\x02\xebQ\xbdK\xc3\xd4\x0f\xfd=#\xbe\xde\xa7\xa1\xebW\xe3S\x17\xb6z\xa7\x86\xa9\x1f\x16\xbc3\xe2\xeb\xbd\x1a\xba~\xc0_`\x85\xacwk\x98\xfaa\xe5<#\xbe\xde\xaf\xa1\xeb\x07\xfc\x05\x96\xdarG\x85\xa9\xbe\xae\xc0gD\x97{*t\xe5\x15=\xc22\x0c\x8a\xc7q\x80r\xbd\x1eo\xc0b\xd6\xf4\x1eLxc\xc5q\xa4kxq\xb5\xb8\x9a\x15\x0dW\x82MXX\x7f\xca\xde\xf3\xc5^\xd8#[\xd7\xc56\x84\xa5u\xac\xfc.m?\xd1\xdc\xd3\xf5_\x8c#]\xeb\xc58\x84{\xdb_\\\xc17\xe1\xde\x8e\xaf\xb2\xf7|S\x10\xf6H\xd6\x7f1\x0d`jV\xf6Mx\xb0\xb3\n\xeb~\xcf\xb6\x89\xe2\x91\xee	\xc5\xe9\xa3ujf\xf7\xd1^M\x1a\xd0;\xbbG\xf1\xae\x97&\xb5#\x0cae{\x8c\xe6MX\xd9+Y\xd9\xfb\xb0\xb2\xae\xc1:\x92\xbdC*\x1e\xc0\xd4\xec\n\x9b\xf0d\xbf?\xb0\xc5\xf4l\x8b\x91jG\xdc\x1b\xec]\x17\xc7\x01\xadd\x97\xd8\x84E\xf3\xc5U\xdbH\xefl=\xd5{\xbb\xcb\x88m@[\xb3\x7fl\xd0*K\x06\x90{\xba\x1dU\xafz\x82a\xdf\x18\xd0\xd6\xec*\x1b\xb4\x8aW \xf7t\x93*^\xc9\x8e$\xb6\x01m\xcd^\xb3A\xabxUc\xcc\xb6\xae\xea\xb5\xdd\xa7\xaa\xd7\x87\xab\xbb\x03m\xd0*^\xc1\xdc\xd3\x0d\xadx%\xbbW\xf1\n\xb6f_\xda\xa0U\xbc\xc2\xb6\xd5\xd3m\xaexm\xf7\xb4\xe2\xf4x\xb6\x0bU\xd9\xaf6h\x14\x9f\xd5\xda\xb3\xbdO\x98\xd3\x03\xec\xa1\xfb\xad\xdb\x8f\xa7\xe9!\x00]\x06\x0f\xfc\x19p\x0c\x1c-\xb8\x06\x93\xa7q=n_\xae%\xe3\xfb\xf4Y\x9e\x1d\xd9\x9e\xbb\xd7jJ\x1f'\xcbzs:\xf4\xefcW\x8d\xb9D^C\xd2\xf5\xebq\xfb\x1b\xd8s\x89\x84\xbd\xe3\xf6\xe5\xd7K\xb5\xe6\xb8w*\xffV\xca\xa7\xcf\xd2\xad\xed~\xeax\xb9\xe9\xb3\xde\xe8\xf2M\x063\xfd]n\xf2,\xbcD\xb9\x13\xfc]\x06\xdf}S\xdb\xfcv\x1f.\x02\xcc\x80\xea\xf8|\xcdd\xcb\x19\xaf\xf8\xcb#Je\x19Z\x8b6\xd7\xb6\xc6\xfe\x8a5\x0d]\xcc\xe5>\xf5o\xfa\x1b&T\x8c\x97l\xf9\xad\x1b\xe2\x93\x91\xbd\xb2\x9e\xb3\xf5\xc7a\xd8\xfe\x1es\xc2+{\xb6\xd6\xe7;O?\xd6\xaf\x87\x8f\xeb\xe6\x10\x9fa\x8d\x7f>\xdf\xdd,\x8f\xe7\x9b\x87\xe3\xf9\xe6\xeefx\xdb\xac\xff\xf9\xee6\xfe\xf7e\xf1\x07\x99\xb5\x04\x8b\xb7\xc7(R$<\xb5\xa4\xe5\x1fn\xbd\n\xef\x9et\x8d\xfd\x9b\xaeq\xf1x<\xdf\xdc\xdf\xb5u.b\x9d\xd1\xb8xlZi+\xdd\xee\xf7\xdd\x80-\xdd\xeeO\xddx\xe3\xb6i\xa5\xe9\x87\xf71>\xfd\xa2\xbb\x1a\xff\xbb?\x9e\xa7\xd1y\\\xde.\x1e\x1en\x97\xcb\xbb\xdb/\x8f\x9a,\xef\x9a)\x83T\xe7R\x1e\x97\x93\xf2\x9c=\x03Fs~\n\x14=\xec\xf2\x93y\xff\xa8\xf9\xd0\xd5\xf7o\xac\xfa\xbfor\xb4\x874G\xad\x93\x9f\x9c)]\x97L\x18k\xf2\xa7\xd3\xa6k\xda\x1fX5u\x0e\xe5\x99:\xa9>\xbf\x86\x14f`\xfek\xa4\xe8q\x0eI\x0d\x7f\xc7wJW\xdf\xbf\xb1\xea\xff\xbe9\xd4\x1e\xa69l\x9c\xfc\xe4\x1c\xea\xba\xf2\x1c\x92&\x7f:\x87\xba\xa6\xfd\x81US\xe70=\xf3>\xed\x8d\xd3\x9f\xfd\xfarx\x1fag\x9c\x8a\xd3\xa6\xa7!\xb0\x0f\x8e\xddY\x1e\xc8O\x7f\xa5w\x17\xc0S\xf9\xa9P\xde\xc2\x00\x00|\x05C*N\x1b\x06\"\xe0\xa1\xffT*\xef|@\x88\x14\x01\x08\xb2\xd1\\\xa77\xef6\xf9i\x12.=\x06*\x08\x95\x89&Yk\x16\x99S\xaeF\xe5\x9c1\xa0\xe1J\xb2\xcbhLF`\x1e\x99\x82\xb8\x92\x8c1\x95\x9e\x9e\xe3\xcd\x10\xcc\x0dc \xb5\x19\x98\x05\xc6\x82j\x870\xdfKB\xa54/W\x9b\xd9%\x99JZ\x16\x18\x0f\xc8\xe1\xa21\xc3\x95dkQ\x90+\xc9\xcb\x92\x01W\x92\x81\xa5\x90\xd5X`\xae\x15\x8d\x80\xa1\x80\xac*\x06\x03#\x01\xf9S\x12H\xa7M\xc90\xcc\x94\xd2\xc0\x8aG\xcc\x89bQW\x92\xfd\x040W\x92\xe7\x04\xabP\xbd\xc7\x8c&\x0d\x08\x06\x00r\x97\xb40\x18\x03\xc8R\x92p\x92<\xc3|\x0b0\x1fI\x03\x1b\xae$\xf3\x88E]I\x8e\x11\xc0\\I6\x11\xacB\x8f\x01\xe4\x0di@0\x06\x90!\xa4\x85\xc1\x18@.\x90\xfc\xbd\xc9i<*\n\xb3~X\xd4p%\xf9=\x0c\xa8@ \x93G\x85\\I\xce\x0e\xa8@u\x1f\xb3sXLm\n\xe6\xe1hP\xb5[\x98q#\xc1j\xb6\x0c\xe8;\xe4\xd60\xa0\xe1J\xb2hh\xcc\x95\xe4\xcb(\x88+\xc9\x8cQ\xe9\xaa\xdf\x98\x03\xc3@\xa0\xdb\x90\xed\xc2\x82\xa0\xd7\x90\xd7B6\x86\x9a\xce\xe2J2X\x18\xd0p%\xb9*4\xe6J\xb2R\x14\xc4\x95\xe4\x9f\xa8t\xddk\xc84a \xb5\x19\x98S\xc2\x82\xa0\xd7\x90=\"\xa1$\xa9\x81\x99m\xcc\x13\xd1\xc0\x86+\xc9\x08aQW\x92\xfb\x010W\x92\xe5\x01\xabP\xfd\xc7|\x0e\x0d\x08F\x0027\xb40\x18\x03\xc8\xd1\x90p\x92:\xc0\x8c\x01fch`\xc3\x95\xe4]\xb0\xa8+\xc9\xb0\x00\x98+\xc9\xa5\x80U\xa81\xc0\xac	\x0d\xa86\x07\xf3#\xb4\xb0\xda9\xcc\x840\xe1 \x01\xc2\x95\xe4<0\xa0\xe1J\xb2\x1bh\xcc\x95\xe41(\x88+\xc9XP\xe9\xaa\xe7\x98\x9b\xc0@j30\x0b\x81\x05A\xaf!\xdf\x80\x04=	\x0c\xbf\x14%\xf2q~A\"	_\xf7B~N\"\xb4\xfe`<\x8d\x9a\x81\xbf-9c\xd0.0\x8d!%h8\xfe\xea\xb4p\x8d\xc4\xa4\x85M\xc5\xf8\xc2\x1f\xf2{\x94\xe3m\xd3\xf1\xc7\xa9\xc3\xb0\xc3\x83\xbfT\x91\x92~\xb0\x1a\xe8\xf7\xef-\xae\xfc2m\xc6\x1d~\xc3R\x82i;\xa6\x1fdx\x8d\xc6\xac\x83\x06\xad\x81\x98l\xd0VK\xc6\x1c\x7f\xf7Rx3\xe4\xf0#\x98\x13\x9a\x11\x87_\xc4\xc8\x90\x1f\x8f\xf4\"\xc6\x9f\xc7\x1e\xc74\x0c\x7f+;\x14M\xc0\xb4\x81-Ac1[ \xa9\x9c\x0c,\xfe\xa4\xf6\x18\xcd\xd8\xc2\xefk\x97\xd3\x0c/\xfc\xd8F\x92\xfc.\xe5\xc3\x0b\xbf\xbc=\x8ei\x1b&\x00t(\x9a\x80y\xffZ\x82\xc1\xc2\x0ftR9\x1b^\xf8\xb5\xee1\x9a\xe1\x85\x9f\xee.\xa7\x19^\xf8\x1d\x8f$\xf8\xa1n)\xf8\xa3\xde\xa1\x98\x96a\x06?\xce0x\xf8\xb9\xdf\xe05\x14\xf3\xf5\xb5U\x93\x91\xc5@\xc0!\xd8\xe6cT\xe0Q\xec a\x88\x80\x9c\x1a\x044\xc3\n\xf1\x02g\x98f\x91\xec\xff\xf5\xc7{\xbb\xb1\x91\xa4\xff\x05\xae+&\xb9\xfek\xc5dH1\xc6hZN76\x0c8\x1cF3\xa0\x10} \xa5\xc6\x17\x96A\xf2\xf8\xd7\x9f\xf9l@I\xfa~M\xd0\xf5\x93\xac\xfd\x05\xae\x91$Y\x7f\xad\x98\x0d(\x84/M_\xe9\x80b,\xe30\x9a\x01\x85\xc0\x06)\x12\x03\xf0!\x85(\xc7\xe3\xd8\xab\x14B\x1e\x87\xa2	$\xed>\xc4&\x1aK\xb2\xed7q\x8cf`dD\x9a\xc3\x07\x17\xc2$\x97\xd3\x0c/\xc4LH\x92\xf0\x82\x0e/\x06P\x1e\xc7\x0c/I\xa0\x8fQL\xbb\x0c\x90\xbc\xf9@\xd0\x95\x93t\xf9X9\xb9r1\xe8\"=\xa0\xc3\x8b\x11\x98\xcb\xb1C\x85\xe1\x98\"aZ:\xe5\x86\xa4\xc2\xafq\x0f[\x0eH\x06|M\xd0\xf5\x93\xc4\xf7\x05\xae\x91$\xdf}\xad\x98\x0c*\xc6sM_\xe9\x90bp\xe70\x9a\x01\x85HO\x8eF\x9aH/\x95\x0b\x01\xca[\x12\xbe9\x92\x1c\x1c`\xfd*\xd2C\x07*\xd2\xe3\x8cA\xbb 	\xeb\xb3\x88\xa7\xd2\xd5\x93H\xcf\xd6\xaf+&\xe9\xe9k\xc5\xf8\xeePr\xf2\xd0\xb4<O\x98\xf2\x80\x91\x9e\xc38\x19'\x18\xe9!\x053\xd0\xdbH\x0fq\x18\xe9\xa9\x8a1\xd2\xa3\x04;\xec\x10\xe91\xbcF\x93\xfc\xf2\xf9\xb0A\x03IZy}*a\xe0\x10\xe9\xd9V\xf0!\x87H\x8f\x13\x9a\x11\x87H\x0f\x19&\xd2S^0\xd2\xf38v<!\xd2s(\x9a@\x12\xc4\xc3\xf1\x85\xc6\x92\xbc\xf0\xcdQ\x87a@\xa4G\x9a\xc3\xc7\x16\"=\x97\xd3\x0c/DzH2\x91\x9er\x84\x91\x9e\xc7\xb1\xc3\x0b\x91\x9eC\xd1\x04\x92\xe1\x1dNF\x0c\x16\"=R9Y+0\xd2\xf3\x18\xcd\xf0B\xa4\xe7r\xda\xab\xb7FzH\xd2\x91\x9e\xea\x0dFz\x0e\xc5\xb4\x8c\xe4j7G:\x06\x0f\x91^\xe3ACIfv\xa8\x9a\x8c,Fzm\xeb\xe9u\x8b\x91\x9eG\xb1\x83\x84\x91\x1erT\xa4\xa7\xbc`\xa4\xc7\x19fTI\xb2\xf5\x1a\x90\xb5\x83Jr\xac\x17\xb8\xae\x98\xa4V\xaf\x15\x93!\xc5H\xafi9\x1fQ\x88\xf4\x1cF3\xa0\x10\xe9!\xa5\xc6m\x96A\xd2\xa6\xd7\xf8\x8a\x0d(\xc9\x96\xae	\xba~\x92$\xbd\xc05\x92\xe4F\xaf\x15\xb3\x01\x85H\xaf\xe9+\x1dP\x8c\xf4\x1cF3\xa0\x10\xe9!\xc5Dz\xca\x0dFz\x1e\xc7^\xa5\x10\xe99\x14M Y\xce1\xd2SX\x92\xdc\xbc\x8d\xf4\x14\x03#=\xd2\x1c>\xb8\x10\xe9\xb9\x9cfx!\xd2C\x12\x84`\x0d\x07#=\x8fc\x86\x97\xe4+\xc7`\xac]\x06H\x9ar \xe8\xcaIvr\xac\x9c\\\xb9\x18\xe9\x91\x1e\xd0\xe1\xc5H\xcf\xe5\xd8\xa1\xc2HO\x910\xd2SnH\xe6\xf1\x1a_\xb1\xe5\x80$\x1c\xd7\x04]?\xc93^\xe0\x1aI\xd2\x8b\xd7\x8a\xc9\xa0b\xa4\xd7\xf4\x95\x0e)Fz\x0e\xa3\x19P\x88\xf4Rk\xce\x92!!\xdd\xf0\x8b\x8f\xdf$\xebI\x12xL\xd6'k\x8ey\xa0\x00P\x9f\xa3I\xe4\xfe\x0dm*kh\xb2\x9f%}\x88\xd8\xadyi\xec\xc6|o\xccM\xe3\x1e\x14`\xd9\xd4\xff\xa8\xec\xf5I\x8c\xed\xb8\xee\xb7/bJ	\"\xa7\x12\xb9C\xfa\xb0[K\xde\x94\x9c\x182\xbe \x7f2\xbe\x1f\x8f\xdd\xf0\x12\x07\xa5\x86\xcf\xdf\x0f\xc3\xae\x1a\xe4\xc5\xf9\x87\x0f\x8e+\x86	\xf7\xb2>&\xdf\xbf7\xc0j\x99\x90S+\x02\xab\xb3\xde\xc7\x183\x84NiaR\xf3^\xbb\x97\xc3\x94\xa4\xa6Z\xa4}\xf1m\xff\xe3\x8f\xe1\xf0\xfe\xf6\xa3\xc1\xa21;\x0f\xa5\x82\x06^\xdd\xa7\\;\xfdv}\xea^\xcb\xc3\xeb\xd3(\xef\x0e\x87\x98\xb1\xe3\x0d!\xdf\xc2\xee\xf0{8\x9c\xce\x16\xf36\xac/\xa7\x97u~i\xc1\xe9}s\xdc\x9e\xbb>\xfcL\xf5\xf1\x96\x7f\xaf\xdez7\xa7\xa8&i\x80_D)q.\x07\x81\xa6\xab\xa2E\xe2\xb5!\xc8r%\x08\xdaL\x96\xb9P\x84T.\x0bN2W\x8d\x90\xea%\xc2Y\xf6\x12\x12\x1a\\I\x9cW'T\x18e\xee\x11\xef_\\\xc2\xc2\xcb\xc8!\xb6W\x9ap\xf1\x82s\xb8M+\xe1\xf2\x10\xca?\xee\x1a\x94f\xb1K\xf1\x13_?wA\xca\xe2.\x17$dpi/H\x81N\x17d\x83\xc4\x0bR\x90\xf5\x82L\x05\x9f\\\x90B\xaa\x17$%\x99\x0bRHpAR\x96\xbd \x85\x86\x17$\xe5\xd5\xa9\x16F\xbd \x01\xef_\x90\xc2R\x17$'\xb6\x17\xa4p\xd5\x05\xc9\xb9M+\xf1\x82\x9c(\xff\xb8\x0bR\x9aE/\xc8y_?wA\x8e\xc3\xfa\xe5WH!\xd3\x8d\xf1\x89\xb3\x98\x85,\xe2\xc2\x97\xbb\xc7ng\x909\x91\x8c\x86\xde\x99\xfa>\xb6\xaf\x9d\xc5@m\xa7\xae\xef^$w]\x1e\xad\xf7S\xac/\x19\xd2 O\xcd'\xa5\xa7\xb6\xd0\x16(/q\x06\xa9\x97hh\xbdH\xa9\xf6\x92\nm\x81<\x17\x17\xef\xef\x0f9\x95\xe65?\xa8%\xb7\xec\xeb\x14\x9b\xa9,>)ia\xe3A\xd2\x83N\x88\xdd\xf6\xf5\xb5o\xea\x9aJ\x117=\xb4fqS)\xe2bk\xa9[)o\xb0\xbcb0M\x0cy\xbf\xce5\xfd\xbb\xed\xe3\x0b\x8f\xd4+w\xb6{\x82\xc07\xfd$\xa1;^D]\xbe\xb0RI\xba\x04\xf52\xa7\x90)\xff\xaa\x81\xd6\x14\xab\x00=\x0e\x9d\x82\x1d\x87\x8eaB\\\x11,0\xd4\xfc\xbb\xba\xc6\xd6{.\x94D\xc0C\xb7\xfe5|\x1c\x86\xd7\xd35\xfe\x7f2A)\xa2r\xaf\x0b\x0c\xfb<\x0e\xef\xfb\x97\xf5\x08\xefy\x9a\xc6\xee[\x9a\x88R\xd8\xf5\xfd\xf6x\xda\x9e\xbea\x070\xe7\xecGX\\\xa7w-\xe3\xaf\xe7\x8f\xb0\xcc\xa5Xx\x9f\x0b\xe1\x87\xf8Gx\x90\xd2\x1a\x1b|\x84\xc7\\\xa6k]\x95b,}\x92\xd2\xfa\xe8\xf8G||[\n\x15t\x91\xdbU\x7f\xcc\x7f\xc4\x87\x9f\xe5\xe5\xdcP\xb8\xcc\xcd\xaa\x8f\x07\x7f\xc4\x87x%\x836\x14>d\xff\x90\xd6\xeb#\xacJ\xb7\xb0\x82\xb8xJy\xfd1\xf9\x11\x8e\xe7\x0c\xce\x8f\xef~\x84\xc5_\xff\x98\xbd=\xe6\xb7X\xa7\xd2<\x8a\xf7\xf7_\xee\xe3\xff\x8amYm\xab\xd5\x97\xd5j\xb5\xfa\x8a\xbc\xdc\xa0\xe5c)\xbd\xaf\xa5_k\xe9\xe2\xaf\x7f\xcc\xe3\xbf\xac\x9e\x97\xb5\xf4\xa1\x96\xde\xd7\xd2U-}\xa8\xa5O\xb5t\xf1\xd7?\x96	\xb4\xed{\xac\xb6'\xdb\xaf\xf4Ro\xe1\x95w\x85\x7f\xe4\xf7\x84\x17\xc3o\x92\xfe\xf9\xf7pw\xfd=\xaaW\xdd9o\x1e\xbf\x87E-[\x94\xc2e\x05.K\xe1}-\xbc/\x85\x0f\xb5\xf0\xa1\x14>\xd6\xc2\xc7R\x18\xa7\xb5\x14\xc7\x0f\x7f\xfb\xdb\x7f\x8bI\xfe\xd67\xff\\\xdfK\xfeu\xf5t<\xff\xe1\xfae\xf7\xfa\xd7\xe7~{\x8a\xa7V\xa7.\xe6Y;\xd5\x98q\xdf\xe5\x17\x95\xc4^D\xe4\xfax\xec\xd6\xc3z\xff\"O\xf6\xe6\x8d\xa7\x96\xc3\xeef\x0b\xcd\xe7\xa9\xc6\xcd\x9b<\x91\xb6Y\xbf\xfc\xfa\x96\xd2\xe2\x86\xf58\xae_~\xec\xba}y4M\x90\xfd\xe1e\xdd;\xc8d+u\xca\xab\xcd84\xbf\xe5L\xb0pP\x8c\x04r\x8a,\x84tx\xdcB\xcb\xa3g\x02\xc3\x83c\x82\xce\xa7\xc7\x0dz \xe0|rl\xc1\x04\x9a\xef\x0f\x06(A\xe5\x13c\xac0\xe97\x04\x9b\x9fSk\xb0\xb4\xa9\xf9\xa4\xb8E\x9fX\xe5\xf9\x94X\xe0i\x95g\xb0\xef\xdf\x0b\x06\x0e\x86	2\x9f\x0e[0kk>\x196X\x82\xcc\xa7\xc2\x15I@\xf9D\x18\xaa\xf3\xc64\x9f\x06[(\x1fR9	n\xc0|D\xe5\x14X\xd0\xfa\xf0\x97\xe0\xf3	0\xc1\xb3\xc6\xe4\xd3\xdf\x16N\xc0\xf9\xe4W\x81	.\x9f\xfa\xeaJ\xbd\xc1\xcb'\xbe\x04\xcd\xc7ON{\x19\x9e\x0f\xa1\x9c\xf4\nA\x1f\xf0\x12|>\xe5%x\xd6\x9e|\xc2\xdb\xc2	8\x9f\xee*0\xc3\xc9\xc9\xae\xae\xd4\x1dB9\xd5%h>\x84r\xa2\xcb\xf0\xceU8\x9d\xe6\nA\x1d\xe2\x12x>\xc9m\xe1\xac5\xf9\x14\xb7A3\xac\x9c\xe0\"\x96\xc0\xf2\xe9\xad\xaa\xd2\x1b\xbd|r\xdb\x82is\xf3\xa9-\x81\xd3\xc1\xc8'\xb6\x82\xc7\x83Z\x82\xce\xa7\xb5\x0d\x9a5%\x9f\xd4Z0\x81\xe6SZ\x80\x12T>\xa1\xc5\n\xbda\xcb\xa7\xb3\x0d\x96\x8f\x9a\x9c\xcc\xb6h>hr*+\xf0z\xb4J\xd1\xf9D\xb6A\xb3\x96\xe4\xd3X\x0b&\xd0|\x12\x0bP\x82\xca\xa7\xb0X\xa1;hr\x02\xdb`iS\xf3\xe9k\x8b\xe6\x83&'\xaf\x02\xd7\x07\xae\x04\x9fO]	\x9e\xb5&\x9f\xb8\xb6p\x02\xce\xa7\xad\nLp\xf9\xa4UW\xea\x0d_>e%h>\x80r\xc2\xca\xf0|\x08\xe5tU\x08p\xda\xc9\xf1\xf9d\x95\xe0Y{\xf2\xa9j\x0b'\xe0|\xa2\xaa\xc0\x04\x97OSu\xa5\xde\x10\xe6\x93T\x82\xa6M\xce\xa7\xa8\x0cO\x87$\x9f\xa0f\x02\x1c\x9c\x12t>=m\xd0\xac-\xf9\xe4\xd4\x82	4\x9f\x9a\x02\x94\xa0\xf2\x89)V\xe8\x0d\\>-m\xb0\xb4\xa9\xf9\xa4\xb4E\xf3A\x93S\xd2\xddk\xd1\xf6u0#\xaa~\xd3\x05uc\xacf\xe3\x03\x90m\xd71\xbe\xa9\x1e1\xccq\xa9\x18\xec0\xea\xe0:\xc5\xc8\x870]\x1e\x86A\x9a\xe7R0&2\xae\xf0\xa6\xe7\xf9\x00\x89\x11g\xba\x87\xd1\x12\xa5\x9e|\xb7\x18:U.>H9\x13GU\x02\xdeg\xeb\xb9\xc2\xa0\x8a0\xfd\xfea\x84\xd5\x12]\x1a\x86[\x8a\xe620\xf6\xd2\x8e\xe6\xe7\x0e\x031\xc2\x9b\x9b:\x88\xca\x18sn\xe6 D\xabT\x1d\xa9\xb9d\x8c\xd78\xd9o3\x06o\x94\xeb21\x92\xb3L\x97\x84a]\xe3n~^0\xc6\xe3\xd4\xb9\xa9\x81\x80\xcf!\xcf\xcd\x0eD\x7f\x95m\xee\xf2\xf5\xba\x8c\xa1 '\xfb\xcd\xc6\xb8\x90r]&\x06\x89\x96\xe9\x93 bl\xdc}2;\x10>r\xea\xdc\xec@,\xe9\x90g\xbf;5\xb0\xacl\x15_\xba\\\x8c2)\xd7o4\x86\x9c\x8c\xea\x13!\xfe4D\x97\x83\xc1\xa8u6?1\x18\x99R\xe6L\x171L\xe5\xdc\x99\xa1\xc5\x98\xb5\x921tu\xa9\x18\xc02\xaa\xdfb\x8cf	\xd3\xe5ah\xaby.\x05\xe3\\\xe3j~F0\xe8e\xc4\xb9	\x81\x08\x98R\xe7\xe6\x03\xc2\xe1\xca\xc5\xa8\xd8\xa5bl\xcc\xa8~\x831P&L\x97\x87Q\xb3\xe6\xb9\x14\x0c\xa1\x8d\xabO\xe6\x03\xe2iF\x9c\xe9\x1e\x06\xd7\x94:7\x1f\x10iW\xae\x0e\xb8]2\x86\xdd\x9c\xec7\x1acp\xcau\x99\x18\x90[\xa6K\xc2\xe8\xbcq7?3\x18\xaas\xea\xdc\xdc@\xdc\xee\x90\xe7f\x07\x82\xf8\xca\xd6\xb1\xbcK\xc6\x88\x9e\x93\xfdfcxO\xb9.\x13c}\xcbtI\x18\xf87\xee\xe6g\x07U\x00N\x9d\xe9&J\x02\x0eyfvP\x1f\x00v	\xfcg\xa8(\x160\xaa\xdfdT\x0e\x08\xd3\xe5\xa1\x8c\xa0y.\x055\x05\xe3j~NP``\xc4\x99\xee\xa1\xda@\xa9s\xf3\x01\xd2\x83\xdc\xc5e\xa4\x07y\xdf\xe9\xa7\xd2Ce\xe3\x13\xb9\xedd\xa0\xf4P9Jzp\\*\xe9\x81Q\x07\xd7)J\x0f\x84\xe9\xf2Pz\xd0<\x97\x82\xd2\x83q\x85w\xe1\xcf\x9f\xcd2\xe2L\xf7Pz\xa0\xd4\x93\xef\x16\xa5\x87\xca\xc5'{g\x8ep+\x01\xa5\x07\xcf\x15J\x0f\x84\xe9\xf7\x0f\xa5\x87\x96\xe8\xd2PzP4\x97\x81\xd2\x83v4?w(=\x10\xde\xdc\xd4\x81\xf4\xc0\x98s3\x07\xd2C\xa5\x1a\xe9\xc1\xeb)J\x0f\x9c\xec\xb7\x19\xa5\x07\xcau\x99(=X\xa6KB\xe9\xa1q7?/(=p\xea\xdc\xd4\x80\xf4\xe0\x90\xe7f\x07\xa4\x87\xca6\xd2\x83\xd7e\x94\x1e8\xd9o6J\x0f\x94\xeb2Qz\xb0L\x9f\x04\xd2C\xe3\xee\x93\xd9\x01\xe9\x81S\xe7f\x07\xa4\x07\x87<\xfb\xdd\xa9\xd2Cek\xe9\xc1\xeb1J\x0f\x94\xeb7\x1a\xa5\x07F\xf5\x89 =\x18\xa2\xcbA\xe9\xc1:\x9b\x9f\x18\x94\x1e(s\xa6\x8b(=p\xee\xcc\xd0\xa2\xf4P\xc9Jz\xf0z\x8b\xd2\x03\xa3\xfa-F\xe9\x810]\x1eJ\x0f\x9a\xe7RPz0\xae\xe6g\x04\xa5\x07F\x9c\x9b\x10\x90\x1e(un>@z\xa8\\%=x]E\xe9\x81Q\xfd\x06\xa3\xf4@\x98.\x0f\xa5\x07\xcds)(=\x18W\x9f\xcc\x07H\x0f\x8c8\xd3=\x94\x1e(un>@z\xa8\\#=x\x9dE\xe9\x81\x93\xfdF\xa3\xf4@\xb9.\x13\xa5\x07\xcbtI(=4\xee\xe6g\x06\xa5\x07N\x9d\x9b\x1b\x90\x1e\x1c\xf2\xdc\xec\x80\xf4P\xd9Fz\xf0\xba\x8c\xd2\x03'\xfb\xcdF\xe9\x81r]&J\x0f\x96\xe9\x92Pzh\xdc\xcd\xcf\x0eJ\x0f\x9c:\xd3M\x94\x1e\x1c\xf2\xcc\xec\xa0\xf4\x00\xec\xa2\x1f\xccPQz`T\xbf\xc9(=\x10\xa6\xcbC\xe9A\xf3\\\nJ\x0f\xc6\xd5\xfc\x9c\xa0\xf4\xc0\x883\xddC\xe9\x81R\xe7\xe6\x03\xa4\x87MNy\x89\xb8\x92j+?\xca#\xb7SL9J(2\xa7/\x11dLwBq\xd1P<\xc7\x0fs\xee\xa3\xfd\xc6\xb4!\x96\xc5'\x92\xdc\xdao\xd2\xa3R\x82\x1e\xec]%\xa5k\x92M\x05qsMIp\xdb\x96T\xe86&YUk<$b\x86\xee\xd8\xad\xd5\xd8M%\xcf\xd3?\xa5\xc5\xfb\x83\x0f-\xb6\xda\xbfD\x0eg\xb7\xdep\xb6\xd8\x8b\x8f\xbd\x14l|4\x02q\xa7\xed\xef\xdd\xf3\xfa}<\xfc\xed\x8b ^\xac^\x97\xde\x02\x90JK-/13\xd9vOP\xa9\\p\x87\xe1\xb5\x1bP!\xbbJ\x91\xf7\x94\xc1d\x95'\x0d\x10\xaa\xa40\xb1\xa0\x0ef\xd0\xa8~5\xe8\xc1\x80Q\xf0\xb2`\x03E\x8dK,\xb1\x15\x06\x85\xb2\x96X\xea+\xe9\x0cV=e`\xb1MSQ\xbcj\xd1'[9\xeaUb\x91'\x0dT\x1b\xeaS\x06S1\xe8S\x06\x89\xaa\x94\x05\xdb\xb6\xa2\x10e\xb0\x06\x89\xda\x93X\x86\xee\xd5\x80Pn\x12Ky\xe5\x9c\x85\x82\xc2d\xa1\xed\x90\x82\xa8\xd4\x80\xdb\x11\x05\x1dI\xd0ZD2x\x94\x8e\x08\xde6\x06\xd5\xa2\x16n\xc0(\x10\x89E\x92\xf0h\x1cjB\n\x97\xef\xe0\xd3h\x90\x81\x08\xba\x1d?P~\x18\xbe\x1dB\x10{\x84\xa0\x95\x1e\x83G}\x87\xe0m{P\xd2i\xe1\x06\x8c*\x8eX$\x87\x8f\xc1\x81p\xa3p|\x08A\xab!\xe8v\x08A\x9eaxr\x15VEF\x08J\x8e1p\x14aZ\xb8m\x0d\xea.\x0d\xdabAj\x11Kj\x88\x81\xa1\xba\"\x16x=\x9c\x01\xa3\xa0\xd2\x82\x9b\xe6\xa2\x86B\xe0\xcd`\xa0l\"x\xd4L\x0c\x1a\x95\x92\x06m\x9b\x82\xe2\x88\x05\x1b(\xea!b\x89\xad0(\x94@\xc4R_\x01g\xb0\xa8z4\xd8v\xd4@\xe8h\xd1\xed\xa0\x81\xb6!p\x146\x0c\x1a\xe5\x8c\x06m[\x82\n\x86\x05\x1b(\x8a\x16b\x89\xad0(\xd4)\xc4R_\xf3f\xb0(M4\xd8\xa6\xa9\xa8F\xb4\xe8v\xd0@\x80\x10\xb8V\x1f\x0c\x1e5\x07\x82\xb7\xadA\x99\xa1\x85\x1b0*\x0bb\x91\xfcE\x1a\x87b\x82\xc2\xd1\xaf*\xea\x07\x04\xdd\x0e H\x06\x0c\xdf\x0e!\xa8\x04B\xd0\x12\x81\xc1\xa30@\xf0\xb6=\xa8\x05\xb4p\x03\xc6\xf0_,\x92\xfeH\xe30\xe2W8:\x84\x18\xe4\x13t\xd3d\x8c\xeb\x19\xbe\x19B\x0c\xe53\xa1\x04\xe3\x0d\x1a\xa3\xf7\x06m\xdb\x82\x01\xbb\x05\x1b(\xc6\xe8b\x89\xad0(\x0c\xcb\xc5R_\xc9f\xb1\x10\x897\xd8\xa6\xa9\x18|\xb7\xe8v\xd0 \xde\xce\xb7	4\xc1L\xbeQ\x00\x9b\x05\xb1\x0ec\xab'\x0dTw0\xbe\xd1\x1e\xd5q?q\xa9\x82\x1d\x8f:P\xa7\x18\xf98L\xca\xc30\xa8\xe5Q\n\xc6D\xc4\x95\xba\xe1\xc3\x0d\x90<\xa2\xd3=\x8c\x96\\\xea\x89\xbb\xc5\xd0Is\xd5\x93\x06<\x8e\xd2\x04<\xeeg\xae0\xa8r\x98\xbc\x7f\x18aq\"\xa5a\xb8\xd5\xd0(\x03c\xaf\xd6\x91?w\x18\x889<o\xea *\xf3\x98\xde\xccA\x88\xa6\xa9\xe6\xb8\x9f\xf5\x14\xe35\x9f\xcc\xdb\x8c\xc1\x9b\xcb\xa5L\x8c\xe4\x18\x93\x920\xac\xa3\xee\xfcy\xc1\x18\xcf\xa7zS\x03\x01\xdf\x0c\xd9\x9b\x1d\x88\xfe4\xdb\x1c\xf7\xb3.c(\xe8\x93y\xb31.t\xb9\x94\x89A\"cr\x12D\x8c\xd4\xdd\xcc\xec@\xf8\xe8S\xbd\xd9\x81Xr\x86\xec~wj`\xa9\xd9\xfa\xb8\x9f\xf5\x18\xa3L\x97\xcb\x1b\x8d!\xa7G\xe5D\x88?	\x91r0\x18e\xce\xfc\x89\xc1\xc8\xd4e:]\xc40\xd5\xe7:C\x8b1\xab&\xab\xe3~\xd6[\x0c`=*o1F\xb3\x0e\x93\xf20\xb4my\x94\x82q.q\xe5\xcf\x08\x06\xbd\x1e\xd1\x9b\x10\x88\x80]\xaa7\x1f\x10\x0ek\xae:\xeeg]\xc5\xd8\xd8\xa3\xf2\x06c\xa0\xec0)\x0f\xa3\xe6\x96G)\x18B\x13W3\xf3\x01\xf1\xb4Gt\xba\x87\xc1\xb5K\xf5\xe6\x03\"m\xcd5\xc7\xfd\xac\xb3\x18v\xfbd\xdeh\x8c\xc1].eb@\xce\x98\x94\x84\xd19u\xe7\xcf\x0c\x86\xea>\xd5\x9b\x1b\x88\xdbg\xc8\xde\xec@\x10\xaf\xd9\xe6\xb8\x9fu\x19#z\x9f\xcc\x9b\x8d\xe1\xbd\xcb\xa5L\x8c\xf5\x19\x93\x920\xf0\xa7\xee\xfc\xd9A\x15\xc0\xa7:\xddDI`\x86\xec\xcc\x0e\xea\x03\x86]\x02\x7f\x87\x8ab\x81G\xe5MF\xe5\xc0aR\x1e\xca\x08-\x8fRPS \xae\xfc9A\x81\xc1#:\xddC\xb5\xc1\xa5z\xf3\x01\xd2C\xbeM@\xf6\xa5\xaa-\xe4\x1b\x05\xb0\xa7\x8d\xf4\xa0\xd9\xeaI\x035B(=hN\xd5\x0fN#\xa5\xa2\xf4\xe0Q\x07\xcaD\xe9\xc1aR\x1eJ\x0f-\x8fRPz \xae\xd4\x0d\x1f\xee\xd9\xacGt\xba\x87\xd2\x83Ku\x06\x15\xa5\x07\xcdUO\x1a\xf0#\\M(\x02\x827\x7f =8L\xde?\x94\x1e8\x91\xd2Pzhh\x94\x81\xd2C\xeb\xc8\x9f;\x94\x1e\x1c\x9e7u =xLo\xe6@z\xd0T	\x8d\xe7\xbeK(=\xf8d\xdef\x94\x1e\\.e\xa2\xf4\xc0\x98\x94\x84\xd2\x03u\xe7\xcf\x0bJ\x0f>\xd5\x9b\x1a\x90\x1ef\xc8\xde\xec\x80\xf4\xa0\xd9\x12\x1a\xcf\xce\x0eH\x0f>\x997\x1b\xa5\x07\x97K\x99(=0&'\x81\xf4@\xdd\xcd\xcc\x0eH\x0f>\xd5\x9b\x1d\x90\x1ef\xc8\xde\xec\x80\xf4\xa0\xd9 \x1f8\\\x94\x1e\\.o4J\x0f\x1e\x95\x13Az D\xcaA\xe9\x819\xf3'\x06\xa5\x07\x97\xe9t\x11\xa5\x07\x9f\xeb\x0c-J\x0f\x9a\\\xf5\x03oV@z\xf0\xa8\xbc\xc5(=8L\xcaC\xe9\xa1\xe5Q\nJ\x0f\xc4\x95?#(=xDoB@zp\xa9\xde|\x80\xf4\xa0\xb9U?p\xa8(=xT\xde`\x94\x1e\x1c&\xe5\xa1\xf4\xd0\xf2(\x05\xa5\x07\xe2jf>@z\xf0\x88N\xf7Pzp\xa9\xde|\x80\xf4\xa0\xb9\x12\x1a\xcf\xce\x08H\x0f>\x997\x1a\xa5\x07\x97K\x99(=0&%\xa1\xf4@\xdd\xf93\x83\xd2\x83O\xf5\xe6\x06\xa4\x87\x19\xb27; =h\xb6\x84\xc6s\xb3\x83\xd2\x83O\xe6\xcdF\xe9\xc1\xe5R&J\x0f\x8cII(=Pw\xfe\xec\xa0\xf4\xe0S\x9dn\xa2\xf40Cv\x06\x18\xa5\x07\xc3F\xe9\x81u\x18\xa5\x07\x8f\xca\x9b\x8c\xd2\x83\xc3\xa4<\x94\x1eZ\x1e\xa5\xa0\xf4@\\\xf9s\x82\xd2\x83Gt\xba\x87\xd2\x83K\xf5\xe6\x03\xa4\x87tOw\xf7:\xbd\xf0^FpX\xbfn\xdfO\xcfw\x1aq\xda\x19{I'\x07(\x0bi\x111'\x9d\x01\xb5\x98\x94\x91@\xa3~\xf9\xe5\x97_\x8eg]\x95\xa4\xa1\x11\xe0x8N\xcf \x88\xf3\xbbo`H\xb7\xfc;=\x1b\x9aj4:\xd73=c0[\xd5FUE	\xa66\xd5b3RM\xbbh\xf7>\xafh\x84\xc9\xb3\xa3$\xb3\x98[\xd5\xf4\x9e\xcdr\x18L\x85\x8ca\xfa\xc9 \xba\x95\x1b\xa8t\x86d\xea\xc5!\xa1m\xedM[	\xe1\xff\xb5\xca\xd1\xad\xef\x93\xb1$u\x0d\xfe8\xb2\xb6}Z\xdf\xe6\xaa;\xf3y\x95j@H\x8d\xfd\xcf\xf5\xf6'k\x1ba\x01h*\xc4\xfa\xda\xf1h\x9b6\x98\xcatgI\xebZ\x80\xee\xeb\x06*t)s\xe3\xd7V\xd9\x9b6\xaa\xe1&Ml\xec\xba\x85\xa3Z\x1cm\x85\xd3*\xf9\xcd\x1b\x12\xb6\x88\x0eM\x85\x84b\xba\xfci\xa5\x1bU\xa9\xcf\x9a\xe9;kk\xdf\xb4\x15G\xeb\xff\xab\xca\xf1\x93U\xd6\x8c\xfeg{\x85\x86o4\x9c\x8d\x9ee\xf4\x8c\x81\xbd4\x84\xf1'\xd67\xc3\xf8\x99\xd5[S6Ha\xbd\xe0\xac\xbeeaO(i\xfcd\xb9\xb1}A\xb4\xba,\xd9R\xb6\x19Ls>g\xf4\x86\xa1:@\x08\xe3\xa7\xdfw\xdb\x03\x83\xd7Mj	\x1b$\xd0n\x10N\xdfrTGZ\xca\xf83\xdf\xb5\xa6+\x96\xa2:\xc3\xbe\xd1\x1b\xbd\xfc\xb0\xfePZ\xcfh\xd8%dI\x83N\x87~[~\xa1Ny;S\x91\xba\xb9\xf8u}\xfaQ\x1fJ\x9bPS\x99\x86\x1d\xc6\xb1\x81\xa52\x05\xc3\xefrM<\xa5 w\xb9\x92)\xd1\x96|\xbd\xa5l\xa9\x8d\xcb\xbc\xceJ\xe9\x836?\x18\xf3\x936?i\xb36.\xb41\x8c\xb5aq\"Y\xe3\xa0\xed\xd3<3\xd0&\xdc\x99\xc9e\xa8\xbe\xa2\xd2$2\xcc\x18\x96m\x8b\xec\x88\xc0\x90a\x9b,l\x13\x96\xb4U\x16\xd7\x87%i\x97E\x8d\xe1\xa1m\x99\x9d\x0c\x98-l\x99\x85m\xc2\x03m\x99\xc5\xf5\xe1\x81\xb4\xcc\xa2\xc6\xf0\xd4\xb6\xcc\\\x07a\x08O\xace\x16\xb6	O\xb4e\x16\xd7\x87'\xd22\x8bR\xbf\xa1\xf9%8\xb0V\xd9\xeb\xd4\xfe\xde\xe5\xa8\x9e\xb4\xa8\xd4\xf4\xf2>\x9c\x0e\xc3\xf4\xc4\xf4\xf4\xb7$\x0f\x04\xe3k\xf7}\xfd\xde\x8f\xd9.\x1f\x15\xe4x\xd8\xee\xe3\x13	\x02\x91\x8f\n\xf2\xb1\xde\x96*\xe2\xdf\xca\xb8;\xfc\xd6ec\xfc[\x19\xf7\x871\xac\xe3\xb3\x82\xddk\xc6@\x91|s\xfb\xc3\xcb\xaf\xd7\xd7\xed\xe9\xd8\xaf/\xd3\xa7\xc9\xb0\xdd\xc7|\x98A\xdb\xb1\x10a\x060\x99\xc6\xf5\xa6\xaf\x96\xf4	\x0ca8|hc,A\xc0K\xd7\xf7\x06\x11\x8b\xe4f\xa1\x94\x19\xb3\x98k\xfa\xbc\xef}w.\xc5\xf1\x83\xea\x8e\xb2Jo*(\xfe\x15[qM\x7f\xbcn\x87\xee%e\x1e.\x0d\xcb\x800t\xbfu\xc3\xa9#\xc0l\x12a1\x12^\x0e\xbd\x05\xbe\x1c\xfa\xf7\xdd^c\xbcJ',\xa97\xe6\x19\xbd\x96\xbf\x9e\xe3Gc\xd5U\x16\x14\xa9k\x7f\xb0\xd5\xe5\x8c\xa2q`\xb6c\xb7;\x85\xd3\xb8\x1e\xc6\xeb\x9466\x95L\xe3\x91\x8ae\x94ci\xe8\xf6\xaf-\xaa\xdb\xcb\x157a\xe4\xe5\x13\x08\xc3\x97N\xa4\x92\x9ae\x17a\xb9\x10k;\x8dC7\xbe\xfcP8)\x9b`\xa7\xae\xff>}Y'H\xfc\x0c_\xd8\xf8Qu/\x164\xbd\x8b\x85\xd0\xb9\x8a)}\x8bE\xbak\xb1D\xf5L\\a{c\x91n\xee\x7f\xbd\x9f\xc6\xed\xf7\x8b4)\x7f\x8a/V\x88\xd9\x1am\xbb\xb2=6\x8dbK\xfb\x8a5\x0du\x03\xc6vf\xdb\xa6\x1b?b~0\x8bMig\xb3U\xb7z\x9d~q9\x8c\xc9(\xeb\xd4T\x97\x1e1\xd6\x98\x0c\xc4)\xf2F#c\xebD\xf1\xb1\xc88\xe9\x82\xc1\x92\xeee\x82t\x8f\xe1\xb1si\x96\x16\xe9\xfb\xf9\x9c\x0f\x91cQ\xbc\xea\xa6\xd2z\xfd\xc5\x8fa\xbb\xdf\x8e\xdb\xf5\xb4T<\xcb\x07\xe0\xc5%n\xb2\xe9\xc5.\xbc\x95\x15+\xfe\xa5|\x9d~\x0c\xf1I:\xf8[\x99\xf7\x07K\xbe\xd3V\xc2/\x88\xc3z\x94\x17\xb2\xa4\xbf\xf1\x15,\xa9 \xfd(\x13[}OL\xfa\x9c\xfb\x12M\xb53/}\xb7\x1e\xbeo\xcf\xcf\xeb\xefqi\x90\xe1~\xfe\xa7\x7f\xfa\x96\x17\xec\xb4	|K\xb8\xf82\x1b\xf9nO\x89\xf2\xd7\xfb\xd35\xfd\xf5}\xbd\xdb\xf6\x97\xe7\xd3\xe54v\xbb\xf0\xbe\xbd\xfdS\xbf\xdd\xff\xfa\xe7\xf5\xcb_R\xc9\xbf\x1d\xf6\xe3m\xcc\xc4\xdawa\xc2\xdc\xfe\xa5{;t7\xff\xf1?o\xff\xcfas\x18\x0f\xb7\xff\xfb|y\xeb\xf6\xb7\xff\xb1y\xdf\x8f\xef\xb7\xff\xb2\xde\x8f\xeb\xa1\xeb\xfb\xdb\x7f\xdb\x0e\xeb\x9b\xbf\xac\xf7\xa7\xdb\x7f\x1d\x0e\xdb\xd7\xe9\xcf\xff\xd1\xf5\xbfu1\x05\xf8\xcd\xbfw\xef\xddmlG8u\xc3\xb6\x1c#\xc4\\\xec\xf1\xb3j\xdd\xbf\x1c\xf6\xa71\xa5w\xbf\xfd_\xef/\xdb\xd7\xf5\xcd\x9f\xd2\xf8\xc9\xa7\x0d~\xb8\xf9K\xa4\xcb\x87\xdb\x7f\xed\xfek\xfd\x9f\xefR\xf6\xa7\xedx\x1a\x87n\xbd\xbb\xf9\xcfn(\xc0\xed\xa6\x9b\xb2\xd7K\xc1\x7f\xef\x0e\xc3\xdbv}k\x9b\xb5;\xec\x0f\xaaU\x7f\xee\xf6\xfd\xe1\xf6\xcf\x87\xfd\xfa\xe5p\x1b\x9bx\xe8\xd7'\xac\xef\xcf\x87}4\xbc\x0f\xdbn\xb8\xf9\xf7\xee\xe36V\x91\xbe*P\xed\x8f\xf56\xa5\xe9\x9f\xaa\xfe\xe8bW\x9e\x17w\xf9\xc2\x89\x9e\xc7\x1f\xdb\xbd2/\x959\x9d~(\xfb\xbd\xb2K\x16m\x04<(@L\xee\xfb\xbeS\x80G\x058u\xbb\xed\xe6\xd0\xbf*\xc8JA\x1a\xf3We\xee\xce\xe3\xb0n0O\xba\x8ax_\xa5r\xf1\xcb\xdd\x9d\xba\xf5S\x0d\x98\xdc\xf3I\x87Mn\x15-\xa3G\xb0K\x86\xc5{X\xe9\x80\"x\x1aW\x82~`\xe8i\x90	\xfa\x91\xa1\xf3\x88\x13\xfc\x8a\xe1\x1d\xecW\x86-sA*\x7fb\x04|\xd6\x96N\x8f\x1c\x8f\xe9\xe9I\x85\xdeU]\xf1\xf1\xe2&\xd8%\xc3\xe29\x9fs\xbd\xd7\x8aez\xda\xaa\x1f\x18Z\xa6\xa7E?\xb2\xba\xcb\xf4\xb4\xf8\x15k\x8b\x83\xfd\xca\xda]\xa7\xa7%<\xb1\x8e\xe2\xfd\xc8\xfc\xdb\x13\x16\xd7\x1fS\x19\xeai?\xc2\xb2\x14c\xe9})\x95$\xf7S\xf1C.^@\xe1c)\xd45\xafj9\x16?\xe5\xe2%\x14.\xeeJ\xa9\x02/J\xf3\xee\xb1\xb4T\xfd\x00\xa5\xcb\xd2\xba\x15\x94\xde\x97\x1a\x9e\xa0\xf4\xa1\xb4b\xb1\xc4&\xd7\x0eb\x1d\xf1gG6\xd4\x9f ?\xc2\xf1\\\xe09\xca\xfd1)f\xb98%z\x9f\xc0\x92\xe8\xbdZ~\x93\xdd\xb8\xef\xd6\xaf\xdb\xfd\xdb\xb4\xc5\xc7\xafM\xc8\x18m\x1f\xa3'\x0d\xf8\xb2|\xb4u\xa4u^\x83\x0c\xa6?\x1cN\x9d\x82\xc8\xbd\x0e\xbbpw\xdd\xad\x87\xb7\xed>\xfff\xd9\x85E.\xc1\xb9\xdd\x85e)\xc6\xd2\xfbR\nW\xcd.<\xe4\xe2z\xd5\xec\xc2c)\xd45\xafj9\x16?\xe5\xe2:]\xbbx\xd5\xe4R\x05^\x94\xe6\xd5\xabf\x17\x16\xa5\xeaz\xd5\xecb\xf6|)\xc5\x1a\x96\xa5\xcd\xf5:\xd8\xc5kIJ\xeb\xb5\xb4\x9b\xae\x0e)\xafW\xc7.^\x1dRZ\xae\x8e\xdd\xa5\x0cq\xd4\xfb\x9e\xef\xbe\xc9\x87IV)\xc3~\xae\xb0\xf8C\xb0\xe2\xd2\xaf\x9c\x02\xbb\x94\xe9I\xb5\xc9Q\xa6\xaeR\x8d\xee\xb9\x12R\xbd\x9a14\xcb\xc3\xeeRfz\xf2\xc0\x1c\xa8\xfa\x0b|\xaa\x9fT\x0f\xf0K\xb9b\xa6\xda\xbf\"\\\x86D]J\xe7J\x98\xea\xffJ\xda\x8f\x84K\xb9\xf8\x92\x87E[?\\\x93\xe7\nN\xb5#z\xaa\x1b\xc0\x97r\x05O5\xd3\xc1\xd7\xd7\xf6\xb9R\xa6\xfa\xd9\xf0k\xca%\xac\xb4\x17\xea\x04:|\x0e+\xe3\x83\xb9P#\x94\xbfZ\xa9\x1f\xcb\xb6\x13\xf0\x8d;Wp\xea\x01\xa2\xa7\xba\x01|\xa9\xdf\xcf\xa9j\xd6x\xf5\xc5=\x03c\xaa\x9f\xb4^1.\xf5\xbb\x9e|\xdc\xb7\xcd\x87%\xe0\x0c\xe8T?\xc2\xa7\xf6\x03\xfaRW\x8cT\xf7C[7,$g@\xa7\xba\x11>\xd5\x0d\xe8K]wR\xdd\x8fm\xddj\\*:\xd5\xdd\x8e\x8a\x1a\x93\xb2z\xa5\xbaWm\xdd\xb0\xa8\x9d\x01\x9d\xeaF\xf8T7\xa0/u\x0dLu?\xb5u\xc3\xd2x\x06t\xaa\x1b\xe1S\xdd\x80\xbe\xe0J\x9aj\x8f\x9f\xcd\xf5\x02\x0b\xac\x08\x03bO\xf5#a\xaa\x1f\xf0\x97\xba\"\xa7\xda\x17\xc7\xb3\xa9\xbc\xae\xd3g\xc0\xa6\x9a\x01,\xdf\xd3\x82\x1d\xebb\x1d\xab\xcd\x8b\xf3P\x8b\xf5\xaa\xbd\xa9\x06\xf1\x9b)}\xb5$\xa7\xb9|d\xeb\xbc\xd8\x86j#\xeb\xf7&,\x8c/E\xee\xe9~P\xbc\xaa\xa9\xc6\xaf\xdd\x10\x96\xc6)\x187ai}\x82\xb5g[D\xf1H\xf6\x83\xe2\xf2\xde\xb8D\xeb&\xdc[\x9fh\xee\xe9\xbe!\xc6\xb1\xdd#\xc42T\x8bL:z|0\x1e\xc1\xd8\x93\x9d\xa4xc\xfbFq\xf8h\x1c*\xf3&<Z\x9f\xca\xde\x87G\xb3\xf8\xa3ud{Iq\xbc\xb2\x8e\x81\xb9	\xab\xc6/\x98\xfb\xb02n\xc18\xb6\xfbK\xf1\xf9t\xf5\xf6\x8eMx2\x1e\x81\xd8\x93]Hx#\xdds\xc48\x80Qv+l\xe9\x06\xcc\xd9)\xda{\xbe;\x89u$;\x91\x98\x0605\xbb\xcc\x06\x8c\xe2\x15\xf6\xa0\x9e\xedX\xd5c\xb3?U\x8f\xab\xab\xb7\xf7l\xc0(\x1eag\xea\xd9>&\xcc\x91\xecZb\x1a\xc04\xf5\x11Fn\x03F\xf1\xa8\xc6\x95\xecn\xd5c\xb3\x97U\x8f\x0fWo\x9f\xda\x80Q<\xc2.\xd6\xb3=O\x98#\xd9\xe1\xc44\x80\xa9\xd9\xbd6`\x14\x8f\xb0\xb7\xf5l',\x1e\xd9\xbeW|\xa2\xb1\xd9\xd36\xca,~a\xcf\xeb\xf9\x1eY<7;bq{<\x9b\x05\xa1\xecw\x1b\xb0\x89\xc7j\xec\xc9\xc6)\xbc\xf59Ht\x9c\xfe\xb4\x11r*\xccQ\xb2F\xe4H9\x96~\x84\xf3)\x99\xe5^\x8d\xbb:\x90\xc9z\xda\x81\xf5\xdeZw\xaf`}\xb0\xd6\xfe\x0d\xac\x8f\xd6z\xee\xc1\xba\xb2\xd6\xa52\x7f\xb5\xe6{e~\xb2\xe6\x07e\xfe\xc5\x9a\x1f\x95yqg\xedeT\xe5\\\xbe\xc8\x0e\xbb\xed>\xfc\x88\xbf*\xe2\xbf\x9d\xfa\x19\x12K\x84X\x8d\x96\x99\xa7c\xbbg\xd3\xb1\xdd\x87\x0f\xa9\\\xddJ\x92\nj\xdd\xb6Q\xa1\x15\x1a\x02(\x0d\x01w\xad\x00ZC\x80\xf56\xec\xca\x96\xfe\x1cp\xaf\x0f\xa07\x84\xba%\x07P\x1c\x82\xda5\x03h\x0e\x01\xb7\xbd\xb0+\xfb\xccs\xa8\xdbO@\xdd!\xe0\x0e\x11Py\x08u\x15\x0f\xa8=\x84\xba\xd6\x06T\x1f\x82\xaa\xa7\xacO\xcf\xa1\xae[\x01\x15\x88PW\x97\x80ZC(\xdf\xc5\xf0\x93jC\xf8I\xb9!X\xbdA&\xca\xd4j\xa6\xcfJ\x0e\x864\xadh\x86cT\x87\xf0\x85\xba\xc1\x01\xb3\xc2C\xf8\xc2\x9c \xc3h\x0fr\x0d5}QW\x96\x95\x1f\x0c)\xf7Eq\x8c\x02\x11\x16\xc4\x0b^\xa6V\x84\x08\x8b\xd6\x03\xe2\x8d\x0e\x91\xafm\xdb\x11)\xae=y4^\xe8\xb4\x18\x96Q#\xe4\xdb\xd2\xba\xc2\xfe[A\xc2\x90\x8a#=f\xf9\x8b\x97\xb6\xa5\xb0$^\xf0\x0bie\x89\xb0l= \xde*\x13aI;\xa2\xbf\xdb\x8d8aX\xd9\x8f\"Y}\"\xdc\x93\xae\xe0J\xd1H\x14\xe1\xbe\xf5\x81\x04\xabR\x84\x07\xe2\xe1\x01\x08\x8dP\x11\x1eZ\x0fH\xb0ZE`c\x85\x9dn\xe4\x8a\xf0\xd8z\xd0\xa3T\x7f\x8f%\x0fD\xb2P\xeb`#Z\x84U\xeb\x01	V\xb7\x08Od\x94pEm\xa4\x8b\xf0\xd4z@\x82U\x17\x02(\x06\xd9\x01,\xcd\x8d\xc0\x80x\xa9\x1e\xe0\\c\x08\xae\xc8\x10|\x95!x2C\xb0:\x83Y\x95\x1b\xa5\xc1\xd8[\xad\xc1\x00\xac\xda`\xcc#[\xf5s\x9b\x1b\xc5A\x9b[\xcdA\xdb\xad\xea\xa0\xad#\xdd\x0b\xaa\xeb{\xebZ\xad\xf0\xad\xf6`\x00V}0f\xa3?\xa8\xd5\xbdQ \x94u\x13\x1e\xacg4[\x15B\x19G\xbeod\xf3\x10\x1e\xadc=[\xad\x16a\x11V\x8d\xb0v\xa3G\xc8\xc6\x90\xadCX5\x0d\xd0\xfeW\xad\x7f\x04XU\xc2\xf0\x8d.\xa1\xf6\x87!<\x19\xdfhm\xb5	e\xb6\xea\x842Z}\xc2\xec1\xadBa\x00D\xa30\x88F\xa50v\xabS\xa8\xcd\xa4U*\x94\x99h\x15\xca\xde\xa8\x15\xca:\xc2\xc6\x93<\xe3&3\x80Q\xc6\x1c\xcdD\xb3P\xf6F\xb5PV\xab[\xa8\xcd\xa7U.\x94\x99h\x17\xca\xde\xa8\x17\xca:\xb2\x9d-\x1b\x070\x92=\x8bh\x18jOkT\x0ce\xb5:\x86\xda\xacZ%C\x99\x89\x96\xa1\xec\x8d\x9a\xa1\xac#lm\xc93\xecc\x03\xd8\xa4\xcb`%\xca\x02\x9a\x1bm\xa1\x1a\x0f\xc7\xf5\xcbv\x8c\xf1\x8e\xfc\x957\xc2lX>\x16K94\x97\x82\xf0XY\xf9\xac<\x9b\xbe\x02\xed\xab\xb1-\xee*O\x8e\xea\xe3\x0d3\xdf\xe3\xcb\x8f\xa2\xc8r\xcd\x9f@r)\x00\xb9\x1b\xbb@\xa6\xcf\x06\xf4\xdb\xf6\xb4\x8dw\x84\x17\x94\x14\x18\xd8\xe9e8\xf4}EM\x9f\x0dHNK2(\x9cY\xab\xe4\x08\xa6~f\xa0\xb3m|8\xf3\xe6_\x1a\xe0\x85\x03\xcfMO\xc3\xd9\xe9\xeb\xa5\x85^\x1c\xe8\xd9\x0eL8\xf3\xa1\xb94\xc0\x8b\x02N\x7f\xc7[4\xc6\xc3\xfb\xcb\x8fk\xf8\xe86\xbfn\xc7P\xe0\x05\xf0\x9c\x00r\xefs.\x9c\xc6t\x86T\xc7\xf8\x18\xee\xae\xc7\xf5k\xbc\x95#_\xc0\xc7\xb0(E\xb8\x7f\x1e\xc3\xb2\x96c\xf1}-\x86\x9f\x19\xc7\xf0P\xca\xeb\xcf\x80cx\xac\xa5\xba\xf6\x15\x18\xb0\xfc\xa9\x94\xd7}\xed\x18\xf7\x9cR\xac\xe0\x8b\xda\xca\xba\x17\x1c\xe3j\x9d\x8b\xebB}\x8cKi.\xc6J\x96\xb5\xedu\x89;\xc6E(\x17\xd7\xf5\xe7\x18\x17\x89\\\\\xd6\x87\xe3\xa5\x8e\xach!\x82\xc9\x0bM\x1e\xee3\x00\xe5\xde\x8b\x8cTj\xc8\xf1R'\x06\xef\xbe0\xd5\xaaA=\x03\x05\xef\xbf\xd0\x0e\x14\xe5R\xa7\x19\xee\xc0\xb0N\x94\x8fJ\x80{0\x8c\x0b\xe5\xa1\\1x\x17\x86u\x81\xd7\xd2\x19(x\x1f\x86q\x82\x94K\xbd\xfe\xea\x9d\x18\xc6\x07\\\x97g\x80\xd7{1t\xfd\x00\xbf\xd4\xebX\xdd\x8da\x1d\x98\xd9\xa8$9\xb7Z>\x127f>V\xc6\x13w\xa4\xfc\xac\xac\x1f\xeaFy)_\xb3zW\x86\xe9\x0c|\xfd\xce\x00\xaf\xf7ed\xbc\xbd1#^\xb9\xfa\xcb B\x86u\xa0;\x01\x1c\xbc7\xc3x\xd1\xbd\xa8\xdf\xfezw\x86\xf1\x02\xab\xc2\x19\xf1\xf5\xfe\x0c\xed\x01\xf0\x17XF\xea\x1d\x1a\xa6~X^\xce\x88\xaf\xf7h\xe8\xfa\x01\x7f\x81\xf5\xa8\xde\xa5a\xea\x87\xfe\x9e\x11_\xef\xd3\xd0\xf5\xab\xf1\xa9\x0b[\xbdS\xc3\xd4\x0f\x0b\xde\x19\xf1\xf5^\x0d]?\xe0/\xb0B\xd6\xbb5L\xfd\xb0r\x9e\x11_\xef\xd7\xd0\xf5\x03\xfe\x02Km\xb9\xa3\xc2T_W\xe03\xa2\xcb=\x15\xba\xf2\x8a\x1ea\x19\x06\xc5\xe38@\xb9^\x8f7`1kz\x0f&\xbc\xb1\xe28\xd25\xbc\xb8Z\\\xcd\x8a\x86+\xc1&,\xac?e\xef\xf9b/\xec\x91\xad\xebb\x1b\xc2\xd2:V~\x97\xb6\x9fh\xee\xe9\xfa/\xc6\x91\xae\xf5b\x1c\xc2\xbd\xed/\xae\xe0\x9bpo\xc7W\xd9{\xbe)\x08{$\xeb\xbf\x98\x0605+\xfb&<\xd8Y\x85u\xbfg\xdbD\xf1H\xf7\x84\xe2\xf4\xd1:5\xb3\xfbh\xaf&\x0d\xe8\x9d\xdd\xa3x\xd7K\x93\xda\x11\x86\xb0\xb2=F\xf3&\xac\xec\x95\xac\xec}XY\xd7`\x1d\xc9\xde!\x15\x0f`jv\x85Mx\xb2\xdf\x1f\xd8bz\xb6\xc5H\xb5#\xee\x0d\xf6\xae\x8b\xe3\x80V\xb2Kl\xc2\xa2\xf9\xe2\xaam\xa4w\xb6\x9e\xea\xbd\xdde\xc46\xa0\xad\xd9?6h\x95%\x03\xc8=\xdd\x8e\xaaW=\xc1\xb0o\x0chkv\x95\x0dZ\xc5+\x90{\xbaI\x15\xafdG\x12\xdb\x80\xb6f\xaf\xd9\xa0U\xbc\xaa1f[W\xf5\xda\xeeS\xd5\xeb\xc3\xd5\xdd\x816h\x15\xaf`\xee\xe9\x86V\xbc\x92\xdd\xabx\x05[\xb3/m\xd0*^a\xdb\xea\xe96W\xbc\xb6{Zqz<\xdb\x85\xaa\xecW\x1b4\x8a\xcfj\xed\xd9\xde'\xcc\xe9\x01\xf6\xd0\xfd\xd6\xed\xc7\xd3\xf4\x10\x80.\x83\x07\xfe\x0c8\x06\x8e\x16\\\x83\xc9\xd3\xb8\x1e\xb7/\xd7\x92\xf1}\xfa,\xcf\x8el\xcf\xddk5\xa5\x8f\x93e\xbd9\x1d\xfa\xf7\xb1\xab\xc6\\\"\xaf!\xe9\xfa\xf5\xb8\xfd\x0d\xec\xb9D\xc2\xdeq\xfb\xf2\xeb\xa5Zs\xdc;\x95\x7f+\xe5\xd3g\xe9\xd6v?u\xbc\xdc\xf4Yot\xf9&\x83\x99\xfe.7y\x16^\xa2\xdc	\xfe.\x83\xef\xbe\xa9m~\xbb\x0f\x17\x01f@u|\xbef\xb2\xe5\x8cW\xfc\xe5\x11\xa5\xb2\x0c\xadE\x9bk[c\x7f\xc5\x9a\x86.\xe6r\x9f\xfa7\xfd\x0d\x13*\xc6K\xb6\xfc\xd6\x0d\xf1\xc9\xc8^Y\xcf\xd9\xfa\xe30l\x7f\x8f9\xe1\x95=[\xeb\xf3\x9d\xa7\x1f\xeb\xd7\xc3\xc7us\x88\xcf\xb0\xc6?\x9f\xefn\x96\xc7\xf3\xcd\xc3\xf1|sw3\xbcm\xd6\xff|w\x1b\xff\xfb\xb2\xf8\x83\xccZ\x82\xc5\xdbc\x14)\x12\x9eZ\xd2\xf2\x0f\xb7^\x85wO\xba\xc6\xfeM\xd7\xb8x<\x9eo\xee\xef\xda:\x17\xb1\xceh\\<6\xad\xb4\x95n\xf7\xfbn\xc0\x96n\xf7\xa7n\xbcq\xdb\xb4\xd2\xf4\xc3\xfb\x18\x9f~\xd1]\x8d\xff\xdd\x1f\xcf\xd3\xe8<.o\x17\x0f\x0f\xb7\xcb\xe5\xdd\xed\x97GM\x96w\xcd\x94A\xaas)\x8f\xcbIy\xce\x9e\x01\xa39?\x05\x8a\x1ev\xf9\xc9\xbc\x7f\xd4|\xe8\xea\xfb7V\xfd\xdf79\xdaC\x9a\xa3\xd6\xc9O\xce\x94\xaeK&\x8c5\xf9\xd3i\xd35\xed\x0f\xac\x9a:\x87\xf2L\x9dT\x9f_C\n30\xff5R\xf48\x87\xa4\x86\xbf\xe3;\xa5\xab\xef\xdfX\xf5\x7f\xdf\x1cj\x0f\xd3\x1c6N~r\x0eu]y\x0eI\x93?\x9dC]\xd3\xfe\xc0\xaa\xa9s\x98\x9ey\x9f\xf6\xc6\xe9\xcf~}9\xbc\x8f\xb03N\xc5i\xd3\xd3\x10\xd8\x07\xc7\xee,\x0f\xe4\xa7\xbf\xd2\xbb\x0b\xe0\xa9\xfcT(oa\x00\x00\xbe\x82!\x15\xa7\x0d\x03\x11\xf0\xd0\x7f*\x95w> D\x8a\x00\x04\xd9h\xae\xd3\x9bw\x9b\xfc4	\x97\x1e\x03\xbd\x92L4\xc9Z\xb3\xc8\x9cr5*\xe7\x8c\x01\x0dW\x92]Fc\xae$\x8fLA\\I\xc6\x98JO\xcf\xf1f\x08\xe6\x861\x90\xda\x0c\xcc\x02cA\xb5C\x98\xef%\xa1R\x9a\x97b\xcd\x99]\x92\xa9\xa4e\x81\xf1\x80\x1c.\x1a3\\I\xb6\x16\x05\xb9\x92\xbc,\x19p%\x19X\nY\x8d\x05\xe6Z\xd1\x08\x18\n\xc8\xaab00\x12\x90?%\x81t\xda\x94\x0c\xc3L)\x0d\xacx\xc4\x9c(\x16\x951\x98\xfd\x040W\x92\xe7\x04\xabP\xbd\xc7\x8c&\x0d\x08\x06\x00r\x97\xb40\x18\x03\xc8R\x92p\x92<\xc3|\x0b0\x1fI\x03\x1b\xae$\xf3\x88E]I\x8e\x11\xc0\\I6\x11\xacB\x8f\x01\xe4\x0di@0\x06\x90!\xa4\x85\xc1\x18@.\x90\xfc\xbd\xc9i<*\n\xb3~X\xd4p%\xf9=\x0c\xe8J2yT\xc8\x95\xe4\xec\x80\nT\xf71;\x87\xc5\xd4\xa6`\x1e\x8e\x06U\xbb\x85\x197\x12\xacf\xcb\x80\xbeCn\x0d\x03\x1a\xae$\x8b\x86\xc6\\I\xbe\x8c\x82\xb8\x92\xcc\x18\x95\xae\xfa\x8d90\x0c\x04\xba\x0d\xd9.,\x08z\x0dy-dc\xa8\xe9,\xae$\x83\x85\x01\x0dW\x92\xabBc\xae$+EA\\I\xfe\x89J\xd7\xbd\x86L\x13\x06R\x9b\x819%,\x08z\x0d\xd9#\x12J\x92\x1a\x98\xd9\xc6<\x11\x0dl\xb8\x92\x8c\x10\x16u%\xb9\x1f\x00s%Y\x1e\xb0\n\xd5\x7f\xcc\xe7\xd0\x80`\x04 sC\x0b\x831\x80\x1c\x0d	'\xa9\x03\xcc\x18`6\x86\x066\\I\xde\x05\x8b\xba\x92\x0c\x0b\x80\xb9\x92\\\nX\x85\x1a\x03\xcc\x9a\xd0\x80js0?B\x0b\xab\x9d\xc3L\x08\x13\x0e\x12 \\I\xce\x03\x03\x1a\xae$\xbb\x81\xc6\\I\x1e\x83\x82\xb8\x92\x8c\x05\x95\xaez\x8e\xb9	\x0c\xa46\x03\xb3\x10X\x10\xf4\x1a\xf2\x0dH\xd0\x93\xc0\xf0KQ\"\x1f\xe7\x17$\x92\xf0u/\xd2\x1bLl\x88\xd0\xfa\x83\xf14j\x06\xfe\xb6\xe4\x8cA\xbb\xc04\x86\x94\xa0\xe1\x98\xbd\xd0\xc25\x12\x93\x166\x15\xe3\x0b\x7f\xc8\xefQ\x8e\xb7M\xc7\x1f\xa7\x0e\xc3\x0e\x0f\xfeREJ\xfa\xc1j\xa0\xdf\xbf\xb7\xb8\xf2\xcb\xb4\x19w\xf8\x0dK	\xa6\xed\x98~\x90\xe15\x1a\xb3\x0e\x1a\xb4\x06b\xb2A[-\x19s\xfc\xddK\xe1\xcd\x90\xc3\x8f`NhF\x1c~\x11#C~<\xd2\x8b\x18\x7f\x1e{\x1c\xd30\xfc\xad\xecP4\x01\x7f8\xb7\x04\x8d\xc5l\x81\xa4r2\xb0\xf8\x93\xdac4c\x0b\xbf\xaf]N3\xbc\xf0c\x1bI\xf2\xbb\x94\x0f/\xfc\xf2\xf68\xa6m\x98\x00\xd0\xa1h\x02\xe6\xfdk	\x06\x0b?\xd0I\xe5lx\xe1\xd7\xba\xc7h\x86\x17~\xba\xbb\x9cfx\xe1w<\x92\xe0\x87\xba\xa5\xe0\x8fz\x87bZ\x86\x19\xfc8\xc3\xe0\xe1\xe7~\x83\xd7P\xcc\xd7\xd7VMF\x16\x03\x01\x87`\x9b\x8fQ\x81G\xb1\x83\x84!\x02rj\x10\xd0\x0c+\xc4\x0b\x9ca\x9aE\xb2\xff\xd7\x1f\xef\xed\xc6F\x92\xfe\x17\xb8\xae\x98\xe4\xfa\xaf\x15\x93!\xc5\x18\xa3i9\xdd\xd80\xe0p\x18\xcd\x80B\xf4\x81\x94\x1a_X\x06\xc9\xe3_\x7f\xe6\xb3\x01%\xe9\xfb5A\xd7O\xb2\xf6\x17\xb8F\x92d\xfd\xb5b6\xa0\x10\xbe4}\xa5\x03\x8a\xb1\x8c\xc3h\x06\x14\x02\x1b\xa4H\x0c\xc0\x87\x14\xa2\x1c\x8fc\xafR\x08y\x1c\x8a&\x90\xb4\xfb\x10\x9bh,\xc9\xb6\xdf\xc41\x9a\x81\x91\x11i\x0e\x1f\\\x08\x93\\N3\xbc\x103!I\xc2\x0b:\xbc\x18@y\x1c3\xbc$\x81>F1\xed2@\xf2\xe6\x03AWN\xd2\xe5c\xe5\xe4\xca\xc5\xa0\x8b\xf4\x80\x0e/F`.\xc7\x0e\x15\x86c\x8a\x84i\xe9\x94\x1b\x92\n\xbf\xc6=l9 \x19\xf05A\xd7O\x12\xdf\x17\xb8F\x92|\xf7\xb5b2\xa8\x18\xcf5}\xa5C\x8a\xc1\x9d\xc3h\x06\x14\"=9\x1ai\"\xbdT.\x04(oI\xf8\xe6Hi\x12FzX\xbf\x8a\xf4\xd0\x81\x8a\xf48c\xd0.H\xc2\xfa,\xe2\xa9t\xf5$\xd2\xb3\xf5\xeb\x8aIz\xfaZ1\xbe;\x94\x9c<4-\xcf\x13\xa6<`\xa4\xe70N\xc6	FzH\xc1\x0c\xf46\xd2C\x1cFz\xaab\x8c\xf4(\xc1\x0e;Dz\x0c\xaf\xd1$\xbf|>l\xd0@\x92V^\x9fJ\x188Dz\xb6\x15|\xc8!\xd2\xe3\x84f\xc4!\xd2C\x86\x89\xf4\x94\x17\x8c\xf4<\x8e\x1dO\x88\xf4\x1c\x8a&`\xa4\xd7\x124\x96\xe4\x85o\x8e:\x0c\x03\"=\xd2\x1c>\xb6\x10\xe9\xb9\x9cfx!\xd2C\x92\x89\xf4\x94#\x8c\xf4<\x8e\x1d^\x88\xf4\x1c\x8a&\x90\x0c\xefp2b\xb0\x10\xe9\x91\xca\xc9Z\x81\x91\x9e\xc7h\x86\x17\"=\x97\xd3^\xbd5\xd2C\x92\x8e\xf4To0\xd2s(\xa6e$W\xbb9\xd21x\x88\xf4\x1a\x0f\x1aJ2\xb3C\xd5dd1\xd2k[O\xaf[\x8c\xf4<\x8a\x1d$\x8c\xf4\x90\xa3\"=\xe5\x05#=\xce0\xa3J\x92\xad\xd7\x80\xac\x1dT\x92c\xbd\xc0u\xc5$\xb5z\xad\x98\x0c)FzM\xcb\xf9\x88B\xa4\xe70\x9a\x01\x85H\x0f)5n\xb3\x0c\x926\xbd\xc6Wl@I\xb6tM\xd0\xf5\x93$\xe9\x05\xae\x91$7z\xad\x98\x0d(DzM_\xe9\x80b\xa4\xe70\x9a\x01\x85H\x0f)&\xd2Sn0\xd2\xf38\xf6*\x85H\xcf\xa1h\x02\xc9r\x8e\x91\x9e\xc2\x92\xe4\xe6m\xa4\xa7\x18\x18\xe9\x91\xe6\xf0\xc1\x85H\xcf\xe54\xc3\x0b\x91\x1e\x92 \x04k8\x18\xe9y\x1c3\xbc$_9\x06c\xed2@\xd2\x94\x03AWN\xb2\x93c\xe5\xe4\xca\xc5H\x8f\xf4\x80\x0e/Fz.\xc7\x0e\x15Fz\x8a\x84\x91\x9erC2\x8f\xd7\xf8\x8a-\x07$\xe1\xb8&\xe8\xfaI\x9e\xf1\x02\xd7H\x92^\xbcVL\x06\x15#\xbd\xa6\xaftH1\xd2s\x18\xcd\x80B\xa4\x97Zs\x96\x0c	\xe9\x86_|\xfc&YO\x92\xc0c\xb2>Ys\xcc\x03\x05\x80\xfa\x1cM\"\xf7ohSYC\x93\xfd,\xe9C\xc4n\xcdKc7\xe6{cn\x1a\xf7\xa0\x00\xcb\xa6\xfeGe\xafObl\xc7u\xbf}\x11SJ\x109\x95\xc8\x1d\xd2\x87\xddZ\xf2\xa6\xe4\xc4\x90\xf1\x05\xf9\x93\xf1\xfdx\xec\x86\x978(5|\xfe~\x18v\xd5 /\xce?|p\\1H\xce\xa5\xf51\xf9\xfe\xbd\x01V\xcb\x84\x9cZ\x11X\x9d\xf5>\xc6\x98!tJ\x0b\x93\x9a\xf7\xda\xbd\x1c\xa6$5\xd5\"\xed\x8bo\xfb\x1f\x7f\x0c\x87\xf7\xb7\x1f\x0d\x16\x8d\xd9y(\x154\xf0\xea>\xe5\xda\xe9\xb7\xebS\xf7Z\x1e^\x9fFyw8\xc4\x8c\x1do\x08\xf9\x16v\x87\xdf\xc3\xe1t\xb6\x98\xb7a}9\xbd\xac\xf3K\x0bN\xef\x9b\xe3\xf6\xdc\xf5\xe1g\xaa\x8f\xb7\xfc{\xf5\xd6\xbb9E5I\x03\xfc\"J\x89s9\x084]\x15-\x12\xaf\x0dA\x96+A\xd0f\xb2\xcc\x85\"\xa4rYp\x92\xb9j\x84T/\x11\xce\xb2\x97\x90\xd0\xe0J\xe2\xbc:\xa1\xc2(s\x8fx\xff\xe2\x12\x16^F\x0e\xb1\xbd\xd2\x84\x8b\x17\x9c\xc3mZ	\x97\x87P\xfeq\xd7\xa04\x8b]\x8a\x9f\xf8\xfa\xb9\x0bR\x16w\xb9 !\x83K{A\nt\xba \x1b$^\x90\x82\xac\x17d*\xf8\xe4\x82\x14R\xbd )\xc9\\\x90B\x82\x0b\x92\xb2\xec\x05)4\xbc )\xafN\xb50\xea\x05	x\xff\x82\x14\x96\xba 9\xb1\xbd \x85\xab.H\xcemZ\x89\x17\xe4D\xf9\xc7]\x90\xd2,zA\xce\xfb\xfa\xb9\x0br\x1c\xd6/\xbfB\n\x99n\x8cO\x9c\xc5,d\x11\x17\xbe\xdc=v;\x83\xcc\x89d4\xf4\xce\xd4\xf7\xb1}\xed,\x06j;u}\xf7\"\xb9\xeb\xf2h\xbd\x9fb}\xc9\x90\x06yj>)=\xb5\x85\xb6@y\x893H\xbdDC\xebEJ\xb5\x97Th\x0b\xe4\xb9\xb8x\x7f\x7f\xc8\xa94\xaf\xf9A-\xb9e_\xa7\xd8Le\xf1II\x0b\x1b\x0fGD\xec\xb6\xaf\xaf}S\xd7T\x8a\xb8\xe9\xa15\x8b\x9bJ\x11\x17[K\xddJy\x83\xe5\x15\x83ib\xc8\xfbu\xae\xe9\xdfm\x1f_x\xa4^\xb9\xb3\xdd\x13\x04\xbe\xe9'	\xdd\xf1\"\xea\xf2\x85\x95J\xd2%\xa8\x979\x85L\xf9W\x0d\xb4\xa6X\x05\xe8q\xe8\x14\xec8t\x0c\x13\xe2\x8a`\x81\xa1\xe6\xdf\xd55\xb6\xdes\xa1$\x02\x1e\xba\xf5\xaf\xe1\xe30\xbc\x9e\xae\xf1\xff\x93	J\x11\x95{]`\xd8\xe7qx\xdf\xbf\xacGx\xcf\xd34v\xdf\xd2D\x94\xc2\xae\xef\xb7\xc7\xd3\xf6\xf4\x0d;\x809g?\xc2\xe2:\xbdk\x19\x7f=\x7f\x84e.\xc5\xc2\xfb\\\x08?\xc4?\xc2\x83\x94\xd6\xd8\xe0#<\xe62]\xeb\xaa\x14c\xe9\x93\x94\xd6G\xc7?\xe2\xe3\xdbR\xa8\xa0\x8b\xdc\xae\xfac\xfe#>\xfc,/\xe7\x86\xc2enV}<\xf8#>\xc4+\x19\xb4\xa1\xf0!\xfb\x87\xb4^\x1faU\xba\x85\x15\xc4\xc5S\xca\xeb\x8f\xc9\x8fp<gp~|\xf7#,\xfe\xfa\xc7\xec\xed1\xbf\xc5:\x95\xe6Q\xbc\xbf\xffr\x1f\xffWl\xcbj[\xad\xbe\xacV\xab\xd5W\xe4\xe5\x06-\x1fK\xe9}-\xfdZK\x17\x7f\xfdc\x1e\xffe\xf5\xbc\xac\xa5\x0f\xb5\xf4\xbe\x96\xaej\xe9C-}\xaa\xa5\x8b\xbf\xfe\xb1L\xa0m\xdfc\xb5=\xd9~\xa5\x97z\x0b\xaf\xbc+\xfc#\xbf'\xbc\x18~\x93\xf4\xcf\xbf\x87\xbb\xeb\xefQ\xbd\xea\xcey\xf3\xf8=,j\xd9\xa2\x14.+pY\n\xefk\xe1})|\xa8\x85\x0f\xa5\xf0\xb1\x16>\x96\xc28\xad\xa58~\xf8\xdb\xdf\xfe[L\xf2\xb7\xbe\xf9\xe7\xfa^\xf2_~Y\x1e\xcf\x7f\xb8~\xe9\xdf\xfe\xfa\xdcoO\xf1\xd4\xea\xd4\xc5<k\xa7\x1a3\xee\xbb\xfc\xa2\x92\xd8\x8b\x88\\\x1f\x8f\xddzX\xef_\xe4\xc9\xde\xbc\xf1\xd4r\xd8\xddl\xa1\xf9<\xd5\xb8y\x93'\xd26\xeb\x97_\xdfRZ\xdc\xb0\x1e\xc7\xf5\xcb\x8f]\xb7/\x8f\xa6	\xb2?\xbc\xac{\x07\x99l\xa5Ny\xb5\x19\x87\xe6\xb7\x9c	\x16\x0e\x8a\x91@N\x91\x85\x90\x0e\x8f[h9A\x16\x18\x1e\x1c\x13t>=n\xd0\x03\x01\xe7\x93c\x0b&\xd0|\x7f0@	*\x9f\x18c\x85I\xbf!\xd8\xfc\x9cZ\x83\xa5M\xcd'\xc5-\xfa\xc4*\xcf\xa7\xc4\x02O\xab<\x83}\xff^0p0L\x90\xf9t\xd8\x82Y[\xf3\xc9\xb0\xc1\x12d>\x15\xaeH\x02\xca'\xc2P\x9d7\xa6\xf94\xd8B\xf9\x90\xcaIp\x03\xe6#*\xa7\xc0\x82\xd6\x87\xbf\x04\x9fO\x80	\x9e5&\x9f\xfe\xb6p\x02\xce'\xbf\nLp\xf9\xd4WW\xea\x0d^>\xf1%h>~r\xda\xcb\xf0|\x08\xe5\xa4W\x08\xfa\x80\x97\xe0\xf3)/\xc1\xb3\xf6\xe4\x13\xde\x16N\xc0\xf9tW\x81\x19NNvu\xa5\xee\x10\xca\xa9.A\xf3!\x94\x13]\x86w\xae\xc2\xe94W\x08\xea\x10\x97\xc0\xf3In\x0bg\xad\xc9\xa7\xb8\x0d\x9aa\xe5\x04\x17\xb1\x04\x96OoU\x95\xde\xe8\xe5\x93\xdb\x16L\x9b\x9bOm	\x9c\x0eF>\xb1\x15<\x1e\xd4\x12t>\xadm\xd0\xac)\xf9\xa4\xd6\x82	4\x9f\xd2\x02\x94\xa0\xf2	-V\xe8\x0d[>\x9dm\xb0|\xd4\xe4d\xb6E\xf3A\x93SY\x81\xd7\xa3U\x8a\xce'\xb2\x0d\x9a\xb5$\x9f\xc6Z0\x81\xe6\x93X\x80\x12T>\x85\xc5\n\xddA\x93\x13\xd8\x06K\x9b\x9aO_[4\x1f49y\x15\xb8>p%\xf8|\xeaJ\xf0\xac5\xf9\xc4\xb5\x85\x13p>mU`\x82\xcb'\xad\xbaRo\xf8\xf2)+A\xf3\x01\x94\x13V\x86\xe7C(\xa7\xabB\x80\xd3N\x8e\xcf'\xab\x04\xcf\xda\x93OU[8\x01\xe7\x13U\x05&\xb8|\x9a\xaa+\xf5\x860\x9f\xa4\x124mr>Eex:$\xf9\x045\x13\xe0\xe0\x94\xa0\xf3\xe9i\x83fm\xc9'\xa7\x16L\xa0\xf9\xd4\x14\xa0\x04\x95OL\xb1Bo\xe0\xf2ii\x83\xa5M\xcd'\xa5-\x9a\x0f\x9a\x9c\x92\xf6oE\xdb\xd7\xc1\x8c\xa8\xfaM\x17\xd4\x8d\xb1\x9a\x8d\x0f@\xce\xc77\xd5#\x869.\x15\x83\x1dF\x1d\\\xa7\x18\xf9\x10\xa6\xcb\xc30H\xf3\\\n\xc6D\xc6\x15\xde\xf4<\x1f 1\xe2L\xf70Z\xa2\xd4\x93\xef\x16C\xa7\xca\xc5\x07)	\xe7\xfbwK\xc0\xfbl=W\x18T\x11\xa6\xdf?\x8c\xb0Z\xa2K\xc3pK\xd1\\\x06\xc6^\xda\xd1\xfc\xdca FxsS\x07Q\x19c\xce\xcd\x1c\x84h\x95\xaa#5\x97\x8c\xf1\x1a'\xfbm\xc6\xe0\x8dr]&Fr\x96\xe9\x920\xack\xdc\xcd\xcf\x0b\xc6x\x9c:75\x10\xf09\xe4\xb9\xd9\x81\xe8\xaf\xb2\xcd]\xbe^\x971\x14\xe4d\xbf\xd9\x18\x17R\xae\xcb\xc4 \xd12}\x12D\x8c\x8d\xbbOf\x07\xc2GN\x9d\x9b\x1d\x88%\x1d\xf2\xecw\xa7\x06\x96\x95\xad\xe2K\x97\x8bQ&\xe5\xfa\x8d\xc6\x90\x93Q}\"\xc4\x9f\x86\xe8r0\x18\xb5\xce\xe6'\x06#S\xca\x9c\xe9\"\x86\xa9\x9c;3\xb4\x18\xb3V2\x86\xae.\x15\x03XF\xf5[\x8c\xd1,a\xba<\x0cm5\xcf\xa5`\x9ck\\\xcd\xcf\x08\x06\xbd\x8c87!\x10\x01S\xea\xdc|@8\\\xb9\x18\x15\xbbT\x8c\x8d\x19\xd5o0\x06\xca\x84\xe9\xf20j\xd6<\x97\x82!\xb4q\xf5\xc9|@<\xcd\x883\xdd\xc3\xe0\x9aR\xe7\xe6\x03\"\xed\xca\xd5\x01\xb7K\xc6\xb0\x9b\x93\xfdFc\x0cN\xb9.\x13\x03r\xcbtI\x18\x9d7\xee\xe6g\x06CuN\x9d\x9b\x1b\x88\xdb\x1d\xf2\xdc\xec@\x10_\xd9:\x96w\xc9\x18\xd1s\xb2\xdfl\x0c\xef)\xd7eb\xaco\x99.	\x03\xff\xc6\xdd\xfc\xec\xa0\n\xc0\xa93\xddDI\xc0!\xcf\xcc\x0e\xea\x03\xc0.\x81\xff\x0c\x15\xc5\x02F\xf5\x9b\x8c\xca\x01a\xba<\x94\x114\xcf\xa5\xa0\xa6`\\\xcd\xcf	\n\x0c\x8c8\xd3=T\x1b(un>@z\x90\xbb\xb8\x8c\xf4 \xef;\xfdTz\xa8l|\"w\xfeh\xb5r\x94\xf4\xe0\xb8T\xd2\x03\xa3\x0e\xaeS\x94\x1e\x08\xd3\xe5\xa1\xf4\xa0y.\x05\xa5\x07\xe3\n\xef\xc2\x9f?\x9be\xc4\x99\xee\xa1\xf4@\xa9'\xdf-J\x0f\x95\x8bO\xf6\x12\xce\xf7\xef\x96\x80\xd2\x83\xe7\n\xa5\x07\xc2\xf4\xfb\x87\xd2CKti(=(\x9a\xcb@\xe9A;\x9a\x9f;\x94\x1e\x08on\xea@z`\xcc\xb9\x99\x03\xe9\xa1R\x8d\xf4\xe0\xf5\x14\xa5\x07N\xf6\xdb\x8c\xd2\x03\xe5\xbaL\x94\x1e,\xd3%\xa1\xf4\xd0\xb8\x9b\x9f\x17\x94\x1e8unj@zp\xc8s\xb3\x03\xd2Ce\x1b\xe9\xc1\xeb2J\x0f\x9c\xec7\x1b\xa5\x07\xcau\x99(=X\xa6O\x02\xe9\xa1q\xf7\xc9\xec\x80\xf4\xc0\xa9s\xb3\x03\xd2\x83C\x9e\xfd\xeeT\xe9\xa1\xb2\xb5\xf4\xe0\xf5\x18\xa5\x07\xca\xf5\x1b\x8d\xd2\x03\xa3\xfaD\x90\x1e\x0c\xd1\xe5\xa0\xf4`\x9d\xcdO\x0cJ\x0f\x949\xd3E\x94\x1e8wfhQz\xa8d%=x\xbdE\xe9\x81Q\xfd\x16\xa3\xf4@\x98.\x0f\xa5\x07\xcds)(=\x18W\xf33\x82\xd2\x03#\xceM\x08H\x0f\x94:7\x1f =T\xae\x92\x1e\xbc\xae\xa2\xf4\xc0\xa8~\x83Qz L\x97\x87\xd2\x83\xe6\xb9\x14\x94\x1e\x8c\xabO\xe6\x03\xa4\x07F\x9c\xe9\x1eJ\x0f\x94:7\x1f =T\xae\x91\x1e\xbc\xce\xa2\xf4\xc0\xc9~\xa3Qz\xa0\\\x97\x89\xd2\x83e\xba$\x94\x1e\x1aw\xf33\x83\xd2\x03\xa7\xce\xcd\x0dH\x0f\x0eynv@z\xa8l#=x]F\xe9\x81\x93\xfdf\xa3\xf4@\xb9.\x13\xa5\x07\xcbtI(=4\xee\xe6g\x07\xa5\x07N\x9d\xe9&J\x0f\x0eyfvPz\x00v\xd1\x0ff\xa8(=0\xaa\xdfd\x94\x1e\x08\xd3\xe5\xa1\xf4\xa0y.\x05\xa5\x07\xe3j~NPz`\xc4\x99\xee\xa1\xf4@\xa9s\xf3\x01\xd2\xc3&\xa7\xbcD\\I\xb5\x95\x1f\xe5\x91\xdb)$k	C\xe6\xf4%\x82\x8c\xe9N(.\x1a\x8a\xe7\xf8a\xce}\xb4\xdf\x986\xc4\xb2\xf8D\x92[\xfbMzTJ\xd0\x83\xbd\xab\xa44X\xb2\xa9 n\xae)	n\xdb\x92\n\xdd\xc6$\xabj\x8d\x87D\xcc\xd0\x1d\xbb\xb5\x1a\xbb\xa9\xe4y\xfa\xa7\xb4x\x7f\xf0\xa1\xc5V\xfb\x97\xc8\xe1\xec\xd6\x1b\xce\x16{\xf1\xb1\x97\x82\x8d\x8fF .=\xe2\x1f\x0b\x0b\xe2\xc5\xeau	\x92J\x01\xb3\x1f\xd7\xdb=A\xa5r\xc1\x1d\x86\xd7n@\x85\xec*E\xdeS\x06\x93U\x9e4@\xa8\x92\xc2\xc4\x82:\x98A\xa3\xfa\xd5\xa0\x07\x03F\xc1\xcb\x82\x0d\x145.\xb1\xc4V\x18\x14\xcaZb\xa9\xaf\xa43X\xf5\x94\x81\xc56ME\xf1\xaaE\x9fl\xe5\xa8W\x89E\x9e4Pm(\x12\x95\x14\x83>e\x90\xa8JY\xb0m+\nQ\x06k\x90\xa8=\x89e\xe8^\x0d\x08\xe5&\xb1\x94W\xceY((L\x16\xda\x0e)\x88J\x0d\xb8\x1dQ\xd0\x91\x04\xadE$\x83G\xe9\x88\xe0mcP-j\xe1\x06\x8c\x02\x91X$	\x8f\xc6\xa1&\xa4p\xf9\x0e>\x8d\x06\x19\x88\xa0\xdb\xf1\x03\xe5\x87\xe1\xdb!\x04\xb1G\x08Z\xe91x\xd4w\x08\xde\xb6\x07%\x9d\x16n\xc0\xa8\xe2\x88Er\xf8\x18\x1c\x087\n\xc7\x87\x10\xb4\x1a\x82n\x87\x10\xe4\x19\x86'WaUd\x84\xa0\xe4\x18\x03G\x11\xa6\x85\xdb\xd6\xa0\xee\xd2\xa0-\x16\xa4\x16\xb1\xa4\x86\x18\x18\xaa+b\x81\xd7\xc3\x190\n*-\xb8i.j(\x04\xde\x0c\x06\xca&\x82G\xcd\xc4\xa0Q)i\xd0\xb6)(\x8eX\xb0\x81\xa2\x1e\"\x96\xd8\n\x83B	D,\xf5\x15p\x06\x8b\xaaG\x83mG\x0d\x84\x8e\x16\xdd\x0e\x1ah\x1b\x02Ga\xc3\xa0Q\xceh\xd0\xb6%\xa8`X\xb0\x81\xa2h!\x96\xd8\n\x83B\x9dB,\xf55o\x06\x8b\xd2D\x83m\x9a\x8ajD\x8bn\x07\x0d\x04\x08\x81k\xf5\xc1\xe0Qs x\xdb\x1a\x94\x19Z\xb8\x01\xa3\xb2 \x16\xc9_\xa4q(&(\x1c\xfd\xaa\xa2~@\xd0\xed\x00\x82d\xc0\xf0\xed\x10\x82J \x04-\x11\x18<\n\x03\x04o\xdb\x83Z@\x0b7`\x0c\xff\xc5\"\xe9\x8f4\x0e#~\x85\xa3C\x88A>A7M\xc6\xb8\x9e\xe1\x9b!\xc4P>\x13J0\xde\xa01zo\xd0\xb6-\x18\xb0[\xb0\x81b\x8c.\x96\xd8\n\x83\xc2\xb0\\,\xf5\x95l\x16\x0b\x91x\x83m\x9a\x8a\xc1w\x8bn\x07\x0d\xe2\xed|\x9b@\x13\xcc\xe4\x1b\x05\xb0Y\x10\xeb0\xb6z\xd2\xc0\x8do\xb4Gu\xdcO\\\xaa`\xc7\xa3\x0e\xd4)F>\x0e\x93\xf20\x0cjy\x94\x821\x11q\xa5n\xf8p\x03$\x8f\xe8t\x0f\xa3%\x97z\xe2n1t\xd2\\\xf5\xa4\x01\x8f\xa34\x01\x8f\xfb\x99+\x0c\xaa\x1c&\xef\x1fFX\x9cHi\x18n54\xca\xc0\xd8\xabu\xe4\xcf\x1d\x06b\x0e\xcf\x9b:\x88\xca<\xa67s\x10\xa2i\xaa9\xeeg=\xc5x\xcd'\xf36c\xf0\xe6r)\x13#9\xc6\xa4$\x0c\xeb\xa8;\x7f^0\xc6\xf3\xa9\xde\xd4@\xc07C\xf6f\x07\xa2?\xcd6\xc7\xfd\xac\xcb\x18\n\xfad\xdel\x8c\x0b].eb\x90\xc8\x98\x9c\x04\x11#u73;\x10>\xfaTov \x96\x9c!\xbb\xdf\x9d\x1aXj\xb6>\xeeg=\xc6(\xd3\xe5\xf2Fc\xc8\xe9Q9\x11\xe2OB\xa4\x1c\x0cF\x993\x7fb02UL\x8cf\x9d.b\x98\xeas\x9d\xa1\xc5\x98U\x93\xd5q?\xeb-\x06\xb0\x1e\x95\xb7\x18\xa3Y\x87Iy\x18\xda\xb6<J\xc18\x97\xb8\xf2g\x04\x83^\x8f\xe8M\x08D\xc0.\xd5\x9b\x0f\x08\x875W\x1d\xf7\xb3\xaebl\xecQy\x831Pv\x98\x94\x87Qs\xcb\xa3\x14\x0c\xa1\x89\xab\x99\xf9\x80x\xda#:\xdd\xc3\xe0\xda\xa5z\xf3\x01\x91\xb6\xe6\x9a\xe3~\xd6Y\x0c\xbb}2o4\xc6\xe0.\x9721 gLJ\xc2\xe8\x9c\xba\xf3g\x06Cu\x9f\xea\xcd\x0d\xc4\xed3dov \x88\xd7ls\xdc\xcf\xba\x8c\x11\xbdO\xe6\xcd\xc6\xf0\xde\xe5R&\xc6\xfa\x8cII\x18\xf8Sw\xfe\xec\xa0\n\xe0S\x9dn\xa2$0Cvf\x07\xf5\x01\xc3.\x81\xbfCE\xb1\xc0\xa3\xf2&\xa3r\xe00)\x0fe\x84\x96G)\xa8)\x10W\xfe\x9c\xa0\xc0\xe0\x11\x9d\xee\xa1\xda\xe0R\xbd\xf9\x00\xe9!\xdf& \xfbR\xd5\x16\xf2\x8d\x02\xd8\xd3Fz\xd0l\xf5\xa4\x81{\xb4\xaa9U?8\x8d\x94\x8a\xd2\x83G\x1d(\x13\xa5\x07\x87Iy(=\xb4<JA\xe9\x81\xb8R7|\xb8g\xb3\x1e\xd1\xe9\x1eJ\x0f.\xd5\x19T\x94\x1e4W=i\xc0\x8fp5\xa1\x08\x08\xde\xfc\x81\xf4\xe00y\xffPz\xe0DJC\xe9\xa1\xa1Q\x06J\x0f\xad#\x7f\xeePzpx\xde\xd4\x81\xf4\xe01\xbd\x99\x03\xe9AS%4\x9e\xfb.\xa1\xf4\xe0\x93y\x9bQzp\xb9\x94\x89\xd2\x03cR\x12J\x0f\xd4\x9d?/(=\xf8Toj@z\x98!{\xb3\x03\xd2\x83fKh<;; =\xf8d\xdel\x94\x1e\\.e\xa2\xf4\xc0\x98\x9c\x04\xd2\x03u73; =\xf8Tov@z\x98!{\xb3\x03\xd2\x83f\x83|\xe0pQzp\xb9\xbc\xd1(=xTN\x04\xe9\x81\x10)\x07\xa5\x07\xe6\xcc\x9f\x18\x94\x1e\\\xa6\xd3E\x94\x1e|\xae3\xb4(=hr\xd5\x0f\xbcY\x01\xe9\xc1\xa3\xf2\x16\xa3\xf4\xe00)\x0f\xa5\x87\x96G)(=\x10W\xfe\x8c\xa0\xf4\xe0\x11\xbd	\x01\xe9\xc1\xa5z\xf3\x01\xd2\x83\xe6V\xfd\xc0\xa1\xa2\xf4\xe0Qy\x83Qzp\x98\x94\x87\xd2C\xcb\xa3\x14\x94\x1e\x88\xab\x99\xf9\x00\xe9\xc1#:\xddC\xe9\xc1\xa5z\xf3\x01\xd2\x83\xe6Jh<;# =\xf8d\xdeh\x94\x1e\\.e\xa2\xf4\xc0\x98\x94\x84\xd2\x03u\xe7\xcf\x0cJ\x0f>\xd5\x9b\x1b\x90\x1ef\xc8\xde\xec\x80\xf4\xa0\xd9\x12\x1a\xcf\xcd\x0eJ\x0f>\x997\x1b\xa5\x07\x97K\x99(=0&%\xa1\xf4@\xdd\xf9\xb3\x83\xd2\x83Ou\xba\x89\xd2\xc3\x0c\xd9\x19`\x94\x1e\x0c\x1b\xa5\x07\xd6a\x94\x1e<*o2J\x0f\x0e\x93\xf2Pzhy\x94\x82\xd2\x03q\xe5\xcf	J\x0f\x1e\xd1\xe9\x1eJ\x0f.\xd5\x9b\x0f\x90\x1e\xd2=\xdd\xdd\xeb\xf4\xc2{\x19\xc1a\xfd\xba}?=\xdfi\xc4ig\xec%\x9d\x1c\xa0,\xa4E\xc4\x9ct\x06\xd4bRF\x02\x8d\xfa\xe5\x97_~9\x9euU\x92\x86F\x80\xe3\xe18=\x83 \xce\xef\xbe\x81!\xdd\xf2\xef\xf4lh\xaa\xd1\xe8\\\xcf\xf4\x8c\xc1lU\x1bU\x15%\x98\xdaT\x8b\xcdH5\xed\xa2\xdd\xfb\xbc\xa2\x11&\xcf\x8e\x92\xccbnU\xd3{6\xcba0\x152\x86\xe9'\x83\xe8Vn\xa0\xd2\x19\x92\xa9\x17\x87\x84\xb6\xb57m%\x84\xff\xd7*G\xb7\xbeO\xc6\x92\xd45\xf8\xe3\xc8\xda\xf6i}\x9b\xab\xee\xcc\xe7U\xaa\x01!5\xf6?\xd7\xdb\x9f\xacm\x84\x05\xa0\xa9\x10\xebk\xc7\xa3m\xda`*\xd3\x9d%\xadk\x01\xba\xaf\x1b\xa8\xd0\xa5\xcc\x8d_[eo\xda\xa8\x86\x9b4\xb1\xb1\xeb\x16\x8ejq\xb4\x15N\xab\xe47oH\xd8\":4\x15\x12\x8a\xe9\xf2\xa7\x95nT\xa5>k\xa6\xef\xac\xad}\xd3V\x1c\xad\xff\xaf*\xc7OVY3\xfa\x9f\xed\x15\x1a\xbe\xd1p6z\x96\xd13\x06\xf6\xd2\x10\xc6\x9fX\xdf\x0c\xe3gVoM\xd9 \x85\xf5\x82\xb3\xfa\x96\x85=\xa1\xa4\xf1\x93\xe5\xc6\xf6\x05\xd1\xea\xb2dK\xd9f0\xcd\xf9\x9c\xd1\x1b\x86\xea\x00!\x8c\x9f~\xdfm\x0f\x0c^7\xa9%l\x90@\xbbA8}\xcbQ\x1di)\xe3\xcf|\xd7\x9a\xaeX\x8a\xea\x0c\xfbFo\xf4\xf2\xc3\xfaCi=\xa3a\x97\x90%\x0d:\x1d\xfam\xf9\x85:\xe5\xedLE\xea\xe6\xe2\xd7\xf5\xe9G}(mBMe\x1av\x18\xc7\x06\x96\xca\x14\x0c\xbf\xcb5\xf1\x94\x82\xdc\xe5J\xa6D[\xf2\xf5\x96\xb2\xa56.\xf3:+\xa5\x0f\xda\xfc`\xccO\xda\xfc\xa4\xcd\xda\xb8\xd0\xc60\xd6\x86\xc5\x89d\x8d\x83\xb6O\xf3\xcc@\x9bpg&\x97\xa1\xfa\x8aJ\x93\xc80cX\xb6-\xb2#\x02C\x86m\xb2\xb0MX\xd2VY\\\x1f\x96\xa4]\x165\x86\x87\xb6ev2`\xb6\xb0e\x16\xb6	\x0f\xb4e\x16\xd7\x87\x07\xd22\x8b\x1a\xc3S\xdb2s\x1d\x84!<\xb1\x96Y\xd8&<\xd1\x96Y\\\x1f\x9eH\xcb,J\xfd\x86\xe6\x97\xe0\xc0Ze\xafS\xfb{\x97\xa3z\xd2\xa2R\xd3\xcb\xfbp:\x0c\xd3\x13\xd3\xd3\xdf\xf0\xa0\xb4\x18_\xbb\xef\xeb\xf7~\xccv\xf9\xa8 \xc7\xc3v\x1f\x9fH\x10\x88|T\x90\x8f\xf5\xb6T\x11\xffV\xc6\xdd\xe1\xb7.\x1b\xe3\xdf\xca\xb8?\x8ca\x1d\x9f\x15\xec^3\x06\x8a\xe4\x9b\xdb\x1f^~\xbd\xbenO\xc7~}\x99>M\x86\xed>\xe6\xc3\x0c\xda\x8e\x85\x083\x80\xc94\xae7}\xb5\xa4O`\x08\xc3\xe1C\x1bc	\x02^\xba\xbe7\x88X$7\x0b\xa5\xcc\x98\xc5\\\xd3\xe7}\xef\xbbs)\x8e\x1fTw\x94UzSA\xf1\xaf\xd8\x8ak\xfa\xe3u;t/\xe9u\x08\xa5a\x19\x10\x86\xee\xb7n8u\x04\x98M\",F\xc2\xcb\xa1\xb7\xc0\x97C\xff\xbe\xdbk\x8cW\xe9\x84%\xf5\xc6<\xa3\xd7\xf2\xd7s\xfch\xac\xba\xca\x82\"u\xed\x0f\xb6\xba\x9cQ4\x0e\xccv\xecv\xa7p\x1a\xd7\xc3x\x9d\xd2\xc6\xa6\x92i<R\xb1\x8cr,\x0d\xdd\xfe\xb5Eu{\xb9\xe2&\x8c\xbc|\x02a\xf8\xd2\x89TR\xb3\xec\",\x17bm\xa7q\xe8\xc6\x97\x1f\n'e\x13\xec\xd4\xf5\xdf\xa7/\xeb\x04\x89\x9f\xe1\x0b\x1b?\xaa\xee\xc5\x82\xa6w\xb1\x10:W1\xa5o\xb1Hw-\x96\xa8\x9e\x89+lo,\xd2\xcd\xfd\xaf\xf7\xd3\xb8\xfd~\x91&\xe5O\xf1\xc5\n1[\xa3mW\xb6\xc7\xa6Qli_\xb1\xa6\xa1n\xc0\xd8\xcel\xdbt\xe3G\xcc\x0ff\xb1)\xedl\xb6\xeaV\xaf\xd3/.\x871\x19e\x9d\x9a\xea\xd2#\xc6\x1a\x93\x818E\xdehdl\x9d(>\x16\x19']0X\xd2\xbdL\x90\xee1<v.\xcd\xd2\"}?\x9f\xf3!r,\x8aW\xddTZ\xaf\xbf\xf81l\xf7\xdbq\xbb\x9e\x96\x8ag\xf9\x00\xbc\xb8\xc4M6\xbd\xd8\x85\xb7\xb2b\xc5\xbf\x94\xaf\xd3\x8f!>I\x07\x7f+\xf3\xfe`\xc9w\xdaJ\xf8\x05qX\x8f\xf2B\x96\xf47\xbe\x82%\x15\xa4\x1feb\xab\xef\x89I\x9fs_\xa2\xa9v\xe6\xa5\xef\xd6\xc3\xf7\xed\xf9y\xfd=.\x0d2\xdc\xcf\xff\xf4O\xdf\xf2\x82\x9d6\x81o	\x17_f#\xdf\xed)Q\xfez\x7f\xba\xa6\xbf\xbe\xafw\xdb\xfe\xf2|\xba\x9c\xc6n\x17\xde\xb7\xb7\x7f\xea\xb7\xfb_\xff\xbc~\xf9K*\xf9\xb7\xc3~\xbc\x8d\x99X\xfb.L\x98\xdb\xbfto\x87\xee\xe6?\xfe\xe7\xed\xff9l\x0e\xe3\xe1\xf6\x7f\x9f/o\xdd\xfe\xf6?6\xef\xfb\xf1\xfd\xf6_\xd6\xfbq=t}\x7f\xfbo\xdba}\xf3\x97\xf5\xfet\xfb\xaf\xc3a\xfb:\xfd\xf9?\xba\xfe\xb7.\xa6\x00\xbf\xf9\xf7\xee\xbd\xbb\x8d\xed\x08\xa7n\xd8\x96c\x84\x98\x8b=~V\xad\xfb\x97\xc3\xfe4\xa6\xf4\xee\xb7\xff\xeb\xfde\xfb\xba\xbe\xf9S\x1a?\xf9\xb4\xc1\x0f7\x7f\x89t\xf9p\xfb\xaf\xdd\x7f\xad\xff\xf3]\xca\xfe\xb4\x1dO\xe3\xd0\xadw7\xff\xd9\x0d\x05\xb8\xddtS\xf6z)\xf8\xef\xddax\xdb\xaeom\xb3v\x87\xfdA\xb5\xea\xcf\xdd\xbe?\xdc\xfe\xf9\xb0_\xbf\x1cnc\x13\x0f\xfd\xfa\x84\xf5\xfd\xf9\xb0\x8f\x86\xf7a\xdb\x0d7\xff\xde}\xdc\xc6*\xd2W\x05\xaa\xfd\xb1\xde\xa64\xfdS\xd5\x1f]\xec\xca\xf3\xe2._8\xd1\xf3\xf8c\xbbW\xe6\xa52\xa7\xd3\x0fe\xbfWv\xc9\xa2\x8d\x80\x07\x05\x88\xc9}\xdfw\n\xf0\xa8\x00\xa7n\xb7\xdd\x1c\xfaW\x05Y)Hc\xfe\xaa\xcc\xddy\x1c\xd6\x0d\xe6IW\x11\xef\xabT.~\xb9\xbbS\xb7~\xaa\x01\x93{>\xe9\xb0\xc9\xad\xa2e\xf4\x08v\xc9\xb0x\x0f+\x1dP\x04O\xe3J\xd0\x0f\x0c=\x0d2A?2t\x1eq\x82_1\xbc\x83\xfd\xca\xb0e.H\xe5O\x8c\x80\xcf\xda\xd2\xe9\x91\xe31==\xa9\xd0\xbb\xaa+>^\xdc\x04\xbbdX<\xe7s\xae\xf7Z\xb1LO[\xf5\x03C\xcb\xf4\xb4\xe8GVw\x99\x9e\x16\xbfbmq\xb0_Y\xbb\xeb\xf4\xb4\x84'\xd6Q\xbc\x1f\x99\x7f{\xc2\xe2\xfac*C=\xedGX\x96b,\xbd/\xa5\x92\xe4~*~\xc8\xc5\x0b(|,\x85\xba\xe6U-\xc7\xe2\xa7\\\xbc\x84\xc2\xc5])U\xe0Ei\xde=\x96\x96\xaa\x1f\xa0tYZ\xb7\x82\xd2\xfbR\xc3\x13\x94>\x94V,\x96\xd8\xe4\xdaA\xac#\xfe\xec\xc8\x86\xfa\x13\xe4G8\x9e\x0b<G\xb9?&\xc5,\x17\xa7D\xef\x13X\x12\xbdW\xcbo\xb2\x1b\xf7\xdd\xfau\xbb\x7f\x9b\xb6\xf8\xf8\xb5	\x19\xa3\xedc\xf4\xa4\x01_\x96\x8f\xb6\x8e\xb4\xcek\x90\xc1\xf4\x87\xc3\xa9S\x10y\xdc}\x17\xee\xae\xbb\xf5\xf0\xb6\xdd\xe7\xdf,\xbb\xb0\xc8%8\xb7\xbb\xb0,\xc5Xz_J\xe1\xaa\xd9\x85\x87\\\\\xaf\x9a]x,\x85\xba\xe6U-\xc7\xe2\xa7\\\\\xa7k\x17\xaf\x9a\\\xaa\xc0\x8b\xd2\xbcz\xd5\xec\xc2\xa2T]\xaf\x9a]\xcc\x9e/\xa5X\xc3\xb2\xb4\xb9^\x07\xbbx-Ii\xbd\x96v\xd3\xd5!\xe5\xf5\xea\xd8\xc5\xabCJ\xcb\xd5\xb1\xbb\x94!\x8ez\xdf\xf3\xdd7\xf90\xc9*e\xd8\xcf\x15\x16\x7f\x08V\\\xfa\x95S`\x972=\xa969\xca\xd4U\xaa\xd1=WB\xaaW3\x86fy\xd8]\xcaLO\x1e\x98\x03U\x7f\x81O\xf5\x93\xea\x01~)W\xccT\xfbW\x84\xcb\x90\xa8K\xe9\\	S\xfd_I\xfb\x91p)\x17_\xf2\xb0h\xeb\x87k\xf2\\\xc1\xa9vDOu\x03\xf8R\xae\xe0\xa9f:\xf8\xfa\xda>W\xcaT?\x1b~M\xb9\x84\x95\xf6B\x9d@\x87\xcfae|0\x17j\x84\xf2W+\xf5c\xd9v\x02\xbeq\xe7\nN=@\xf4T7\x80/\xf5\xfb9U\xcd\x1a\xaf\xbe\xb8g`L\xf5\x93\xd6+\xc6\xa5~\xd7\x93\x8f\xfb\xb6\xf9\xb0\x04\x9c\x01\x9d\xeaG\xf8\xd4~@_\xea\x8a\x91\xea~h\xeb\x86\x85\xe4\x0c\xe8T7\xc2\xa7\xba\x01}\xa9\xebN\xaa\xfb\xb1\xad[\x8dKE\xa7\xba\xdbQQcRV\xafT\xf7\xaa\xad\x1b\x16\xb53\xa0S\xdd\x08\x9f\xea\x06\xf4\xa5\xae\x81\xa9\xee\xa7\xb6nX\x1a\xcf\x80Nu#|\xaa\x1b\xd0\x17\\IS\xed\xf1\xb3\xb9^`\x81\x15a@\xec\xa9~$L\xf5\x03\xfeRW\xe4T\xfb\xe2x6\x95\xd7u\xfa\x0c\xd8T3\x80\xe5{Z\xb0c]\xacc\xb5yq\x1ej\xb1^\xb57\xd5 ~3\xa5\xaf\x96\xe44\x97\x8fl\x9d\x17\xdbPmd\xfd\xde\x84\x85\xf1\xa5\xc8=\xdd\x0f\x8aW5\xd5\xf8\xb5\x1b\xc2\xd28\x05\xe3&,\xadO\xb0\xf6l\x8b(\x1e\xc9~P\\\xde\x1b\x97h\xdd\x84{\xeb\x13\xcd=\xdd7\xc48\xb6{\x84X\x86j\x91IG\x8f\x0f\xc6#\x18{\xb2\x93\x14ol\xdf(\x0e\x1f\x8dCe\xde\x84G\xebS\xd9\xfb\xf0h\x16\x7f\xb4\x8el/)\x8eW\xd6107a\xd5\xf8\x05s\x1fV\xc6-\x18\xc7v\x7f)>\x9f\xae\xde\xde\xb1	O\xc6#\x10{\xb2\x0b	o\xa4{\x8e\x18\x070\xcan\x85-\xdd\x809;E{\xcfw'\xb1\x8ed'\x12\xd3\x00\xa6f\x97\xd9\x80Q\xbc\xc2\x1e\xd4\xb3\x1d\xabzl\xf6\xa7\xeaqu\xf5\xf6\x9e\x0d\x18\xc5#\xecL=\xdb\xc7\x849\x92]KL\x03\x98\xa6>\xc2\xc8m\xc0(\x1e\xd5\xb8\x92\xdd\xadzl\xf6\xb2\xea\xf1\xe1\xea\xedS\x1b0\x8aG\xd8\xc5z\xb6\xe7	s$;\x9c\x98\x0605\xbb\xd7\x06\x8c\xe2\x11\xf6\xb6\x9e\xed\x84\xc5#\xdb\xf7\x8aO46{\xdaF\x99\xc5/\xecy=\xdf#\x8b\xe7fG,n\x8fg\xb3 \x94\xfdn\x036\xf1X\x8d=\xd98\x85\xb7>\x07\x89\x8e\xd3\x9f6BN\x859J\xd6\x88\x1c)\xc7\xd2\x8fp>%\xb3\xdc\xabqW\x072YO;\xb0\xde[\xeb\xee\x15\xac\x0f\xd6\xda\xbf\x81\xf5\xd1Z\xcf=XW\xd6\xbaT\xe6\xaf\xd6|\xaf\xccO\xd6\xfc\xa0\xcc\xbfX\xf3\xa32/\xee\xac\xbd\x8c\xaa\x9c\xcb\x17\xd9a\xb7\xdd\x87\x1f\xf1WE\xfc\xb7S?Cb\x89\x10\xab\xd12\xf3tl\xf7l:\xb6\xfb\xf0!\x95\xab[IRA\xad\xdb6*\xb4BC\x00\xa5!\xe0\xae\x15@k\x08\xb0\xde\x86]\xd9\xd2\x9f\x03\xee\xf5\x01\xf4\x86P\xb7\xe4\x00\x8aCP\xbbf\x00\xcd!\xe0\xb6\x17ve\x9fy\x0eu\xfb	\xa8;\x04\xdc!\x02*\x0f\xa1\xae\xe2\x01\xb5\x87P\xd7\xda\x80\xeaCP\xf5\x94\xf5\xe99\xd4u+\xa0\x02\x11\xea\xea\x12Pk\x08\xe5\xbb\x18~Rm\x08?)7\x04\xab7\xc8D\x99Z\xcd\xf4Y\xc9\xc1\x90\xa6\x15\xcdp\x8c\xea\x10\xbeP78`Vx\x08_\x98\x13d\x18\xedA\xae\xa1\xa6/\xea\xca\xb2\xf2\x83!\xe5\xbe(\x8eQ \xc2\x82x\xc1\xcb\xd4\x8a\x10a\xd1z@\xbc\xd1!\xf2\xb5m;\"\xc5\xb5'\x8f\xc6\x0b\x9d\x16\xc32j\x84|[ZW\xd8\x7f+H\x18Rq\xa4\xc7,\x7f\xf1\xd2\xb6\x14\x96\xc4\x0b~!\xad,\x11\x96\xad\x07\xc4[e\",iG\xf4w\xbb\x11'\x0c+\xfbQ$\xabO\x84{\xd2\x15\\)\x1a\x89\"\xdc\xb7>\x90`U\x8a\xf0@<\xe0\x9a\xd3\x08\x15\xe1\xa1\xf5\x80\x04\xabU\x046V\xd8\xe9F\xae\x08\x8f\xad\x07=J\xf5\xf7X\xf2@$\x0b\xb5\x0e6\xa2EX\xb5\x1e\x90`u\x8b\xf0DF	W\xd4F\xba\x08O\xad\x07$Xu!\x80b\x90\x1d\xc0\xd2\xdc\x08\x0c\x88\x97\xea\x01\xce5\x86\xe0\x8a\x0c\xc1W\x19\x82'3\x04\xab3\x98U\xb9Q\x1a\x8c\xbd\xd5\x1a\x0c\xc0\xaa\x0d\xc6<\xb2U?\xb7\xb9Q\x1c\xb4\xb9\xd5\x1c\xb4\xdd\xaa\x0e\xda:\xd2\xbd\xa0\xba\xbe\xb7\xae\xd5\n\xdfj\x0f\x06`\xd5\x07c6\xfa\x83Z\xdd\x1b\x05BY7\xe1\xc1zF\xb3U!\x94q\xe4\xfbF6\x0f\xe1\xd1:\xd6\xb3\xd5j\x11\x16a\xd5\x08k7z\x84l\x0c\xd9:\x84U\xd3\x00\xed\x7f\xd5\xfaG\x80U%\x0c\xdf\xe8\x12j\x7f\x18\xc2\x93\xf1\x8d\xd6V\x9bPf\xabN(\xa3\xd5'\xcc\x1e\xd3*\x14\x06@4\n\x83hT\nc\xb7:\x85\xdaLZ\xa5B\x99\x89V\xa1\xec\x8dZ\xa1\xac#l<\xc93n2\x03\x18e\xcc\xd1L4\x0beoT\x0be\xb5\xba\x85\xda|Z\xe5B\x99\x89v\xa1\xec\x8dz\xa1\xac#\xdb\xd9\xb2q\x00#\xd9\xb3\x88\x86\xa1\xf6\xb4F\xc5PV\xabc\xa8\xcd\xaaU2\x94\x99h\x19\xca\xde\xa8\x19\xca:\xc2\xd6\x96<\xc3>6\x80M\xba\x0cV\xa2,\xa0\xb9\xd1\x16\xaa\xf1p\\\xbfl\xc7\x18\xef\xc8_y#\xcc\x86\xe5c\xb1\x94Cs)\x08\x8f\x95\x95\xcf\xca\xb3\xe9+\xd0\xbe\x1a\xdb\xe2\xae\xf2\xe4\xa8>\xde0\xf3=\xbe\xfc(\x8a,\xd7\xfc	$\x97\x02\x90\xbb\xb1\x0bd\xfal@\xbfmO\xdbxGxAI\x81\x81\x9d^\x86C\xdfW\xd4\xf4\xd9\x80\xe4\xb4$\x83\xc2\x99\xb5J\x8e`\xeag\x06:\xdb\xc6\x873o\xfe\xa5\x01^8\xf0\xdc\xf44\x9c\x9d\xbe^Z\xe8\xc5\x81\x9e\xed\xc0\x843\x1f\x9aK\x03\xbc(\xe0\xf4w\xbcEc<\xbc\xbf\xfc\xb8\x86\x8fn\xf3\xebv\x0c\x05^\x00\xcf	 \xf7>\xe7\xc2iLgHu\x8c\x8f\xe1\xeez\\\xbf\xc6[9\xf2\x05|\x0c\x8bR\x84\xfb\xe71,k9\x16\xdf\xd7b\xf8\x99q\x0c\x0f\xa5\xbc\xfe\x0c8\x86\xc7Z\xaak_\x81\x01\xcb\x9fJy\xdd\xd7\x8eq\xcf)\xc5\n\xbe\xa8\xad\xac{\xc11\xae\xd6\xb9\xb8.\xd4\xc7\xb8\x94\xe6b\xacdY\xdb^\x97\xb8c\\\x84rq]\x7f\x8eq\x91\xc8\xc5e}8^\xea\xc8\x8a\x16\"\x98\xbc\xd0\xe4\xe1>\x03P\xee\xbd\xc8H\xa5\x86\x1c/ub\xf0\xee\x0bS\xad\x1a\xd43P\xf0\xfe\x0b\xed@Q.u\x9a\xe1\x0e\x0c\xebD\xf9\xa8\x04\xb8\x07\xc3\xb8P\x1e\xca\x15\x83waX\x17x-\x9d\x81\x82\xf7a\x18'H\xb9\xd4\xeb\xaf\xde\x89a|\xc0uy\x06x\xbd\x17C\xd7\x0f\xf0K\xbd\x8e\xd5\xdd\x18\xd6\x81\x99\x8dJ\x92s\xab\xe5#qc\xe6ce<qG\xca\xcf\xca\xfa\xa1n\x94\x97\xf25\xabwe\x98\xce\xc0\xd7\xef\x0c\xf0z_F\xc6\xdb\x1b3\xe2\x95\xab\xbf\x0c\"dX\x07\xba\x13\xc0\xc1{3\x8c\x17\xdd\x8b\xfa\xed\xafwg\x18/\xb0*\x9c\x11_\xef\xcf\xd0\x1e\x00\x7f\x81e\xa4\xde\xa1a\xea\x87\xe5\xe5\x8c\xf8z\x8f\x86\xae\x1f\xf0\x17X\x8f\xea]\x1a\xa6~\xe8\xef\x19\xf1\xf5>\x0d]\xbf\x1a\x9f\xba\xb0\xd5;5L\xfd\xb0\xe0\x9d\x11_\xef\xd5\xd0\xf5\x03\xfe\x02+d\xbd[\xc3\xd4\x0f+\xe7\x19\xf1\xf5~\x0d]?\xe0/\xb0\xd4\x96;*L\xf5u\x05>#\xba\xdcS\xa1+\xaf\xe8\x11\x96aP<\x8e\x03\x94\xeb\xf5x\x03\x16\xb3\xa6\xf7`\xc2\x1b+\x8e#]\xc3\x8b\xab\xc5\xd5\xach\xb8\x12l\xc2\xc2\xfaS\xf6\x9e/\xf6\xc2\x1e\xd9\xba.\xb6!,\xadc\xe5wi\xfb\x89\xe6\x9e\xae\xffb\x1c\xe9Z/\xc6!\xdc\xdb\xfe\xe2\n\xbe	\xf7v|\x95\xbd\xe7\x9b\x82\xb0G\xb2\xfe\x8bi\x00S\xb3\xb2o\xc2\x83\x9dUX\xf7{\xb6M\x14\x8ftO(N\x1f\xadS3\xbb\x8f\xf6j\xd2\x80\xde\xd9=\x8aw\xbd4\xa9\x1da\x08+\xdbc4o\xc2\xca^\xc9\xca\xde\x87\x95u\x0d\xd6\x91\xec\x1dR\xf1\x00\xa6fW\xd8\x84'\xfb\xfd\x81-\xa6g[\x8cT;\xe2\xde`\xef\xba8\x0eh%\xbb\xc4&,\x9a/\xae\xdaFzg\xeb\xa9\xde\xdb]Fl\x03\xda\x9a\xfdc\x83VY2\x80\xdc\xd3\xed\xa8z\xd5\x13\x0c\xfb\xc6\x80\xb6fW\xd9\xa0U\xbc\x02\xb9\xa7\x9bT\xf1Jv$\xb1\x0dhk\xf6\x9a\x0dZ\xc5\xab\x1ac\xb6uU\xaf\xed>U\xbd>\\\xdd\x1dh\x83V\xf1\n\xe6\x9enh\xc5+\xd9\xbd\x8aW\xb05\xfb\xd2\x06\xad\xe2\x15\xb6\xad\x9ens\xc5k\xbb\xa7\x15\xa7\xc7\xb3]\xa8\xca~\xb5A\xa3\xf8\xac\xd6\x9e\xed}\xc2\x9c\x1e`\x0f\xddo\xdd~<M\x0f\x01\xe82x\xe0\xcf\x80c\xe0h\xc15\x98<\x8d\xebq\xfbr-\x19\xdf\xa7\xcf\xf2\xec\xc8\xf6\xdc\xbdVS\xfa8Y\xd6\x9b\xd3\xa1\x7f\x1f\xbbj\xcc%\xf2\x1a\x92\xae_\x8f\xdb\xdf\xc0\x9eK$\xec\x1d\xb7/\xbf^\xaa5\xc7\xbdS\xf9\xb7R>}\x96nm\xf7S\xc7\xcbM\x9f\xf5F\x97o2\x98\xe9\xefr\x93g\xe1%\xca\x9d\xe0\xef2\xf8\xee\x9b\xda\xe6\xb7\xfbp\x11`\x06T\xc7\xe7k&[\xcex\xc5_\x1eQ*\xcb\xd0Z\xb4\xb9\xb65\xf6W\xaci\xe8b.\xf7\xa9\x7f\xd3\xdf0\xa1b\xbcd\xcbo\xdd\x10\x9f\x8c\xec\x95\xf5\x9c\xad?\x0e\xc3\xf6\xf7\x98\x13^\xd9\xb3\xb5>\xdfy\xfa\xb1~=|\\7\x87\xf8\x0ck\xfc\xf3\xf9\xeefy<\xdf<\x1c\xcf7w7\xc3\xdbf\xfd\xcfw\xb7\xf1\xbf/\x8b?\xc8\xac%X\xbc=F\x91\"\xe1\xa9%-\xffp\xebUx\xf7\xa4k\xec\xdft\x8d\x8b\xc7\xe3\xf9\xe6\xfe\xae\xads\x11\xeb\x8c\xc6\xc5c\xd3J[\xe9v\xbf\xef\x06l\xe9v\x7f\xea\xc6\x1b\xb7M+M?\xbc\x8f\xf1\xe9\x17\xdd\xd5\xf8\xdf\xfd\xf1<\x8d\xce\xe3\xf2v\xf1\xf0p\xbb\\\xde\xdd~y\xd4dy\xd7L\x19\xa4:\x97\xf2\xb8\x9c\x94\xe7\xec\x190\x9a\xf3S\xa0\xe8a\x97\x9f\xcc\xfbG\xcd\x87\xae\xbe\x7fc\xd5\xff}\x93\xa3=\xa49j\x9d\xfc\xe4L\xe9\xbad\xc2X\x93?\x9d6]\xd3\xfe\xc0\xaa\xa9s(\xcf\xd4I\xf5\xf95\xa40\x03\xf3_#E\x8fsHj\xf8;\xbeS\xba\xfa\xfe\x8dU\xff\xf7\xcd\xa1\xf60\xcda\xe3\xe4'\xe7P\xd7\x95\xe7\x904\xf9\xd39\xd45\xed\x0f\xac\x9a:\x87\xe9\x99\xf7io\x9c\xfe\xec\xd7\x97\xc3\xfb\x08;\xe3T\x9c6=\x0d\x81}p\xec\xce\xf2@~\xfa+\xbd\xbb\x00\x9e\xcaO\x85\xf2\x16\x06\x00\xe0+\x18Rq\xda0\x10\x01\x0f\xfd\xa7Ry\xe7\x03B\xa4\x08@\x90\x8d\xe6:\xbdy\xb7\xc9O\x93p\xe91PA\xa8L4\xc9Z\xb3\xc8\x9cr5*\xe7\x8c\x01\x0dW\x92]Fc\xae$\x8fLA\\I\xc6\x98JO\xcf\xf1f\x08\xe6\x861\x90\xda\x0c\xcc\x02cA\xb5C\x98\xef%\xa1R\x9a\x97b\xcd\x99]\x92\xa9\xa4e\x81\xf1\x80\x1c.\x1a3\\I\xb6\x16\x05\xb9\x92\xbc,\x19p%\x19X\nY\x8d\x05\xe6Z\xd1\x08\x18\n\xc8\xaab00\x12\x90?%\x81t\xda\x94\x0c\xc3L)\x0d\xacx\xc4\x9c(\x16u%\xd9O\x00s%yN\xb0\n\xd5{\xcch\xd2\x80`\x00 wI\x0b\x831\x80,%	'\xc93\xcc\xb7\x00\xf3\x914\xb0\xe1J2\x8fX\xd4\x95\xe4\x18\x01\xcc\x95d\x13\xc1*\xf4\x18@\xde\x90\x06\x04c\x00\x19BZ\x18\x8c\x01\xe4\x02\xc9\xdf\x9b\x9c\xc6\xa3\xa20\xeb\x87E\x0dW\x92\xdf\xc3\x80\xae$\x93G\x85\\I\xce\x0e\xa8@u\x1f\xb3sXLm\n\xe6\xe1hP\xb5[\x98q#\xc1j\xb6\x0c\xe8;\xe4\xd60\xa0\xe1J\xb2hh\xcc\x95\xe4\xcb(\x88+\xc9\x8cQ\xe9\xaa\xdf\x98\x03\xc3@\xa0\xdb\x90\xed\xc2\x82\xa0\xd7\x90\xd7B6\x86\x9a\xce\xe2J2X\x18\xd0p%\xb9*4\xe6J\xb2R\x14\xc4\x95\xe4\x9f\xa8t\xddk\xc84a \xb5\x19\x98S\xc2\x82\xa0\xd7\x90=\"\xa1$\xa9\x81\x99m\xcc\x13\xd1\xc0\x86+\xc9\x08aQW\x92\xfb\x010W\x92\xe5\x01\xabP\xfd\xc7|\x0e\x0d\x08F\x0027\xb40\x18\x03\xc8\xd1\x90p\x92:\xc0\x8c\x01fch`\xc3\x95\xe4]\xb0\xa8+\xc9\xb0\x00\x98+\xc9\xa5\x80U\xa81\xc0\xac	\x0d\xa86\x07\xf3#\xb4\xb0\xda9\xcc\x840\xe1 \x01\xc2\x95\xe4<0\xa0\xe1J\xb2\x1bh\xcc\x95\xe41(\x88+\xc9XP\xe9\xaa\xe7\x98\x9b\xc0@j30\x0b\x81\x05A\xaf!\xdf\x80\x04=	\x0c\xbf\x14%\xf2q~A\"	_\xf7B~N\"\xb4\xfe`<\x8d\x9a\x81\xbf-9c\xd0.0\x8d!%h8f/\xb4p\x8d\xc4\xa4\x85M\xc5\xf8\xc2\x1f\xf2{\x94\xe3m\xd3\xf1\xc7\xa9\xc3\xb0\xc3\x83\xbfT\x91\x92~\xb0\x1a\xe8\xf7\xef-\xae\xfc2m\xc6\x1d~\xc3R\x82i;\xa6\x1fdx\x8d\xc6\xac\x83\x06\xad\x81\x98l\xd0VK\xc6\x1c\x7f\xf7Rx3\xe4\xf0#\x98\x13\x9a\x11\x87_\xc4\xc8\x90\x1f\x8f\xf4\"\xc6\x9f\xc7\x1e\xc74\x0c\x7f+;\x14M\xc0\xb4\x81-Ac1[ \xa9\x9c\x0c,\xfe\xa4\xf6\x18\xcd\xd8\xc2\xefk\x97\xd3\x0c/\xfc\xd8F\x92\xfc.\xe5\xc3\x0b\xbf\xbc=\x8ei\x1b&\x00t(\x9a\x80y\xffZ\x82\xc1\xc2\x0ftR9\x1b^\xf8\xb5\xee1\x9a\xe1\x85\x9f\xee.\xa7\x19^\xf8\x1d\x8f$\xf8\xa1n)\xf8\xa3\xde\xa1\x98\x96a\x06?\xce0x\xf8\xb9\xdf\xe05\x14\xf3\xf5\xb5U\x93\x91\xc5@\xc0!\xd8\xe6cT\xe0Q\xec a\x88\x80\x9c\x1a\x044\xc3\n\xf1\x02g\x98f\x91\xec\xff\xf5\xc7{\xbb\xb1\x91\xa4\xff\x05\xae+&\xb9\xfek\xc5dH1\xc6hZN76\x0c8\x1cF3\xa0\x10} \xa5\xc6\x17\x96A\xf2\xf8\xd7\x9f\xf9l@I\xfa~M\xd0\xf5\x93\xac\xfd\x05\xae\x91$Y\x7f\xad\x98\x0d(\x84/M_\xe9\x80b,\xe30\x9a\x01\x85\xc0\x06)\x12\x03\xf0!\x85(\xc7\xe3\xd8\xab\x14B\x1e\x87\xa2	$\xed>\xc4&\x1aK\xb2\xed7q\x8cf`dD\x9a\xc3\x07\x17\xc2$\x97\xd3\x0c/\xc4LH\x92\xf0\x82\x0e/\x06P\x1e\xc7\x0c/I\xa0\x8fQL\xbb\x0c\x90\xbc\xf9@\xd0\x95\x93t\xf9X9\xb9r1\xe8\"=\xa0\xc3\x8b\x11\x98\xcb\xb1C\x85\xe1\x98\"aZ:\xe5\x86\xa4\xc2\xafq\x0f[\x0eH\x06|M\xd0\xf5\x93\xc4\xf7\x05\xae\x91$\xdf}\xad\x98\x0c*\xc6sM_\xe9\x90bp\xe70\x9a\x01\x85HO\x8eF\x9aH/\x95\x0b\x01\xca[\x12\xbe9\x92\x1c\x1c`\xfd*\xd2C\x07*\xd2\xe3\x8cA\xbb 	\xeb\xb3\x88\xa7\xd2\xd5\x93H\xcf\xd6\xaf+&\xe9\xe9k\xc5\xf8\xeePr\xf2\xd0\xb4<O\x98\xf2\x80\x91\x9e\xc38\x19'\x18\xe9!\x053\xd0\xdbH\x0fq\x18\xe9\xa9\x8a1\xd2\xa3\x04;\xec\x10\xe91\xbcF\x93\xfc\xf2\xf9\xb0A\x03IZy}*a\xe0\x10\xe9\xd9V\xf0!\x87H\x8f\x13\x9a\x11\x87H\x0f\x19&\xd2S^0\xd2\xf38v<!\xd2s(\x9a@\x12\xc4\xc3\xf1\x85\xc6\x92\xbc\xf0\xcdQ\x87a@\xa4G\x9a\xc3\xc7\x16\"=\x97\xd3\x0c/DzH2\x91\x9er\x84\x91\x9e\xc7\xb1\xc3\x0b\x91\x9eC\xd1\x04\x92\xe1\x1dNF\x0c\x16\"=R9Y+0\xd2\xf3\x18\xcd\xf0B\xa4\xe7r\xda\xab\xb7FzH\xd2\x91\x9e\xea\x0dFz\x0e\xc5\xb4\x8c\xe4j7G:\x06\x0f\x91^\xe3ACIfv\xa8\x9a\x8c,Fzm\xeb\xe9u\x8b\x91\x9eG\xb1\x83\x84\x91\x1erT\xa4\xa7\xbc`\xa4\xc7\x19fTI\xb2\xf5\x1a\x90\xb5\x83Jr\xac\x17\xb8\xae\x98\xa4V\xaf\x15\x93!\xc5H\xafi9\x1fQ\x88\xf4\x1cF3\xa0\x10\xe9!\xa5\xc6m\x96A\xd2\xa6\xd7\xf8\x8a\x0d(\xc9\x96\xae	\xba~\x92$\xbd\xc05\x92\xe4F\xaf\x15\xb3\x01\x85H\xaf\xe9+\x1dP\x8c\xf4\x1cF3\xa0\x10\xe9!\xc5Dz\xca\x0dFz\x1e\xc7^\xa5\x10\xe99\x14M Y\xce1\xd2SX\x92\xdc\xbc\x8d\xf4\x14\x03#=\xd2\x1c>\xb8\x10\xe9\xb9\x9cfx!\xd2C\x12\x84`\x0d\x07#=\x8fc\x86\x97\xe4+\xc7`\xac]\x06H\x9ar \xe8\xcaIvr\xac\x9c\\\xb9\x18\xe9\x91\x1e\xd0\xe1\xc5H\xcf\xe5\xd8\xa1\xc2HO\x910\xd2SnH\xe6\xf1\x1a_\xb1\xe5\x80$\x1c\xd7\x04]?\xc93^\xe0\x1aI\xd2\x8b\xd7\x8a\xc9\xa0b\xa4\xd7\xf4\x95\x0e)Fz\x0e\xa3\x19P\x88\xf4Rk\xce\x92!!\xdd\xf0\x8b\x8f\xdf$\xebI\x12xL\xd6'k\x8ey\xa0\x00P\x9f\xa3I\xe4\xfe\x0dm*kh\xb2\x9f%}\x88\xd8\xadyi\xec\xc6|o\xccM\xe3\x1e\x14`\xd9\xd4\xff\xa8\xec\xf51\x8d\xed\xb8\xee\xb7/bJ	\"\xa7\x12\xb9C\xfa\xb0[\xef\xd18e\x84\x98\x8c\xef\xc7c7\xbc\xc4A\xa9\xe1\xf3\xf7\xc3\xb0\xab\x06yq\xfe\xe1\x83\xe3\x8aAr.\xad\x8f\xc9\xf7\xef\x0d\xb0Z&\xe4\xd4\x8a\xc0\xea\xac\xf71\xc6\x0c\xa1SZ\x98\xd4\xbc\xd7\xee\xe50%\xa9\xa9\x16i_|\xdb\xff\xf8c8\xbc\xbf\xfdh\xb0h\xcc\xceC\xa9\xa0\x81W\xf7)\xd7N\xbf]\x9f\xba\xd7\xf2\xf0\xfa4\x90\xbb\xc3!f\xecxC\xc8\xb7\xb0;\xfc\x1e\x0e\xa7\xb3\xc5\xbc\x0d\xeb\xcb\xe9e\x9d_Zpz\xdf\x1c\xb7\xe7\xae\x0f?S}\xbc\xe5\xdf\xab\xb7\xde\xcd)\xaaI\x1a\xe0\x17QJ\x9c\xcbA\xa0\xe9\xaah\x91xm\x08\xb2\\	\x826\x93e.\x14!\x95\xcb\x82\x93\xccU#\xa4z\x89p\x96\xbd\x84\x84\x06W\x12\xe7\xd5	\x15F\x99{\xc4\xfb\x17\x97\xb0\xf02r\x88\xed\x95&\\\xbc\xe0\x1cn\xd3J\xb8<\x84\xf2\x8f\xbb\x06\xa5Y\xecR\xfc\xc4\xd7\xcf]\x90\xb2\xb8\xcb\x05	\x19\\\xda\x0bR\xa0\xd3\x05\xd9 \xf1\x82\x14d\xbd S\xc1'\x17\xa4\x90\xea\x05II\xe6\x82\x14\x12\\\x90\x94e/H\xa1\xe1\x05Iyu\xaa\x85Q/H\xc0\xfb\x17\xa4\xb0\xd4\x05\xc9\x89\xed\x05)\\uArn\xd3J\xbc '\xca?\xee\x82\x94f\xd1\x0br\xde\xd7\xcf]\x90\xe3\xb0~\xf9\x15R\xc8tc|\xe2,f!\x8b\xb8\xf0\xe5\xee\xb1\xdb\x19dN$\xa3\xa1w\xa6\xbe\x8f\xedkg1P\xdb\xa9\xeb\xbb\x17\xc9]\x97G\xeb\xfd\x14\xebK\x864\xc8S\xf3I\xe9\xa9-\xb4\x05\xcaK\x9cA\xea%\x1aZ/R\xaa\xbd\xa4B[ \xcf\xc5\xc5\xfb\xfbCN\xa5y\xcd\x0fj\xc9-\xfb:\xc5f*\x8bOJZ\xd8x\x90\xf4\xa0\x13b\xb7}}\xed\x9b\xba\xa6R\xc4M\x0f\xadY\xdcT\x8a\xb8\xd8Z\xeaV\xca\x1b,\xaf\x18L\x13C\xde\xafsM\xffn\xfb\xf8\xc2#\xf5\xca\x9d\xed\x9e \xf0M?I\xe8\x8e\x17Q\x97/\xacT\x92.A\xbd\xcc)d\xca\xbfj\xa05\xc5*@\x8fC\xa7`\xc7\xa1c\x98\x10W\x04\x0b\x0c5\xff\xae\xae\xb1\xf5\x9e\x0b\xa7\xba7C\xb7\xfe5|\x1c\x86\xd7\xd35\xfe\x7f2A)\xa2r\xaf\x0b\x0c\xfb<\x0e\xef\xfb\x97\xf5\x08\xefy\x9a\xc6\xee[\x9a\x88R\xd8\xf5\xfd\xf6x\xda\x9e\xbea\x070\xe7\xecGX\\\xa7w-\xe3\xaf\xe7\x8f\xb0\xcc\xa5Xx\x9f\x0b\xe1\x87\xf8Gx\x90\xd2\x1a\x1b|\x84\xc7\\\xa6k]\x95b,}\x92\xd2\xfa\xe8\xf8G||[\n\x15t\x91\xdbU\x7f\xcc\x7f\xc4\x87\x9f\xe5\xe5\xdcP\xb8\xcc\xcd\xaa\x8f\x07\x7f\xc4\x87x%\x836\x14>d\xff\x90\xd6\xeb#\xacJ\xb7\xb0\x82\xb8xJy\xfd1\xf9\x11\x8e\xe7\x0c\xce\x8f\xef~\x84\xc5_\xff\x98\xbd=\xe6\xb7X\xa7\xd2<\x8a\xf7\xf7_\xee\xe3\xff\x8amYm\xab\xd5\x97\xd5j\xb5\xfa\x8a\xbc\xdc\xa0\xe5c)\xbd\xaf\xa5_k\xe9\xe2\xaf\x7f\xcc\xe3\xbf\xac\x9e\x97\xb5\xf4\xa1\x96\xde\xd7\xd2U-}\xa8\xa5O\xb5t\xf1\xd7?\x96	\xb4\xed{\xac\xb6'\xdb\xaf\xf4Ro\xe1\x95w\x85\x7f\xe4\xf7\x84\x17\xc3o\x92\xfe\xf9\xf7pw\xfd=\xaaW\xdd9o\x1e\xbf\x87E-[\x94\xc2e\x05.K\xe1}-\xbc/\x85\x0f\xb5\xf0\xa1\x14>\xd6\xc2\xc7R\x18\xa7\xb5\x14\xc7\x0f\x7f\xfb\xdb\x7f\x8bI\xfe\xd67\xff\x0c\xef%_\xde\xdd\x1d\xcf\x7f\xb8~9\xf7\x7f}\xee\xb7\xa7xlu\xeab\xa2\xb5S\xfd\x1d\xb6\xef\xf2\x9bJb7\"r}<v\xeba\xbd\x7f\x91G{\xf3\xceS\xcba{\xb3\x85\xe6\xf3T\xe3\xe6M\x1eI\xdb\xac_~}Kyq\xc3z\x1c\xd7/?v\xdd\xbe<\x9b&\xc8\xfe\xf0\xb2\xee\x1dd\xb2\x95:\xe5\xddf\x1c\x9a_s&X8)F\x029F\x16B:=n\xa1\xe5\xd93\x81\xe1\xc91A\xe7\xe3\xe3\x06=\x10p>:\xb6`\x02\xcd7\x08\x03\x94\xa0\xf2\x911V\x98\x04\x1c\x82\xcd\x0f\xaa5X\xda\xd4|T\xdc\xa2O\xac\xf2|L,\xf0\xb4\xcc3\xd8\xf7\xef\x05\x03'\xc3\x04\x99\x8f\x87-\x98\xb55\x1f\x0d\x1b,A\xe6c\xe1\x8a$\xa0|$\x0c\xd5yc\x9a\x8f\x83-\x94\x0f\xa9\x1c\x057`>\xa2r\x0c,h}\xfaK\xf0\xf9\x08\x98\xe0Yc\xf2\xf1o\x0b'\xe0|\xf4\xab\xc0\x04\x97\x8f}u\xa5\xde\xe0\xe5#_\x82\xe6\xe3'\xc7\xbd\x0c\xcf\x87P\x8ez\x85\xa0Ox	>\x1f\xf3\x12<kO>\xe2m\xe1\x04\x9c\x8fw\x15\x98\xe1\xe4hWW\xea\x0e\xa1\x1c\xeb\x124\x1fB9\xd2ex\xe7*\x9c\x8es\x85\xa0Nq	<\x1f\xe5\xb6p\xd6\x9a|\x8c\xdb\xa0\x19V\x8ep\x11K`\xf9\xf8VU\xe9\x8d^>\xbam\xc1\xb4\xb9\xf9\xd8\x96\xc0\xe9`\xe4#[\xc1\xe3I-A\xe7\xe3\xda\x06\xcd\x9a\x92\x8fj-\x98@\xf31-@	*\x1f\xd1b\x85\xde\xb0\xe5\xe3\xd9\x06\xcbGM\x8ef[4\x1f49\x96\x15x=[\xa5\xe8|$\xdb\xa0YK\xf2q\xac\x05\x13h>\x8a\x05(A\xe5cX\xac\xd0\x1d49\x82m\xb0\xb4\xa9\xf9\xf8\xb5E\xf3A\x93\xa3W\x81\xeb\x13W\x82\xcf\xc7\xae\x04\xcfZ\x93\x8f\\[8\x01\xe7\xe3V\x05&\xb8|\xd4\xaa+\xf5\x86/\x1f\xb3\x124\x1f@9bex>\x84r\xbc*\x048\xee\xe4\xf8|\xb4J\xf0\xac=\xf9X\xb5\x85\x13p>RU`\x82\xcb\xc7\xa9\xbaRo\x08\xf3Q*A\xd3&\xe7cT\x86\xa7C\x92\x8fP3\x01NN	:\x1f\x9f6h\xd6\x96|tj\xc1\x04\x9a\x8fM\x01JP\xf9\xc8\x14+\xf4\x06.\x1f\x976X\xda\xd4|T\xda\xa2\xf9\xa0\xc91\xe9\xb9/\xe2\xbe\x0efD\xd6o\xba\xa0\xee\x8c\xd5l|\x02r>\xbe\xa9\x1e1\xccq\xa9\x18\xec0\xea\xe0:\xc5\xc8\x870]\x1e\x86A\x9a\xe7R0&2\xae\xf0\xae\xe7\xf9\x00\x89\x11g\xba\x87\xd1\x12\xa5\x9e|\xb7\x18:U.>I9\x13GU\x02\xdeh\xeb\xb9\xc2\xa0\x8a0\xfd\xfea\x84\xd5\x12]\x1a\x86[\x8a\xe620\xf6\xd2\x8e\xe6\xe7\x0e\x031\xc2\x9b\x9b:\x88\xca\x18sn\xe6 D\xabT\x1d\xa9\xb9d\x8c\xd78\xd9o3\x06o\x94\xeb21\x92\xb3L\x97\x84a]\xe3n~^0\xc6\xe3\xd4\xb9\xa9\x81\x80\xcf!\xcf\xcd\x0eD\x7f\x95mn\xf3\xf5\xba\x8c\xa1 '\xfb\xcd\xc6\xb8\x90r]&\x06\x89\x96\xe9\x93 bl\xdc}2;\x10>r\xea\xdc\xec@,\xe9\x90g\xbf;5\xb0\xacl\x15_\xba\\\x8c2)\xd7o4\x86\x9c\x8c\xea\x13!\xfe4D\x97\x83\xc1\xa8u6?1\x18\x99R\xe6L\x171L\xe5\xdc\x99\xa1\xc5\x98\xb5\x921tu\xa9\x18\xc02\xaa\xdfb\x8cf	\xd3\xe5ah\xaby.\x05\xe3\\\xe3j~F0\xe8e\xc4\xb9	\x81\x08\x98R\xe7\xe6\x03\xc2\xe1\xca\xc5\xa8\xd8\xa5bl\xcc\xa8~\x831P&L\x97\x87Q\xb3\xe6\xb9\x14\x0c\xa1\x8d\xabO\xe6\x03\xe2iF\x9c\xe9\x1e\x06\xd7\x94:7\x1f\x10iW\xae\x0e\xb8]2\x86\xdd\x9c\xec7\x1acp\xcau\x99\x18\x90[\xa6K\xc2\xe8\xbcq7?3\x18\xaas\xea\xdc\xdc@\xdc\xee\x90\xe7f\x07\x82\xf8\xca\xd6\xb1\xbcK\xc6\x88\x9e\x93\xfdfcxO\xb9.\x13c}\xcbtI\x18\xf87\xee\xe6g\x07U\x00N\x9d\xe9&J\x02\x0eyfvP\x1f\x00v	\xfcg\xa8(\x160\xaa\xdfdT\x0e\x08\xd3\xe5\xa1\x8c\xa0y.\x055\x05\xe3j~NP``\xc4\x99\xee\xa1\xda@\xa9s\xf3\x01\xd2\x83\xdc\xc6e\xa4\x07y\xe1\xe9\xa7\xd2Ce\xe3#\xb9\xf3G\xab\x95\xa3\xa4\x07\xc7\xa5\x92\x1e\x18up\x9d\xa2\xf4@\x98.\x0f\xa5\x07\xcds)(=\x18Wx\x1b\xfe\xfc\xd9,#\xcet\x0f\xa5\x07J=\xf9nQz\xa8\\|\xb4w\xe6\x08\xb7\x12Pz\xf0\\\xa1\xf4@\x98~\xffPzh\x89.\x0d\xa5\x07Es\x19(=hG\xf3s\x87\xd2\x03\xe1\xcdM\x1dH\x0f\x8c97s =T\xaa\x91\x1e\xbc\x9e\xa2\xf4\xc0\xc9~\x9bQz\xa0\\\x97\x89\xd2\x83e\xba$\x94\x1e\x1aw\xf3\xf3\x82\xd2\x03\xa7\xceM\x0dH\x0f\x0eynv@z\xa8l#=x]F\xe9\x81\x93\xfdf\xa3\xf4@\xb9.\x13\xa5\x07\xcb\xf4I =4\xee>\x99\x1d\x90\x1e8unv@zp\xc8\xb3\xdf\x9d*=T\xb6\x96\x1e\xbc\x1e\xa3\xf4@\xb9~\xa3Qz`T\x9f\x08\xd2\x83!\xba\x1c\x94\x1e\xac\xb3\xf9\x89A\xe9\x812g\xba\x88\xd2\x03\xe7\xce\x0c-J\x0f\x95\xac\xa4\x07\xaf\xb7(=0\xaa\xdfb\x94\x1e\x08\xd3\xe5\xa1\xf4\xa0y.\x05\xa5\x07\xe3j~FPz`\xc4\xb9	\x01\xe9\x81R\xe7\xe6\x03\xa4\x87\xcaU\xd2\x83\xd7U\x94\x1e\x18\xd5o0J\x0f\x84\xe9\xf2Pz\xd0<\x97\x82\xd2\x83q\xf5\xc9|\x80\xf4\xc0\x883\xddC\xe9\x81R\xe7\xe6\x03\xa4\x87\xca5\xd2\x83\xd7Y\x94\x1e8\xd9o4J\x0f\x94\xeb2Qz\xb0L\x97\x84\xd2C\xe3n~fPz\xe0\xd4\xb9\xb9\x01\xe9\xc1!\xcf\xcd\x0eH\x0f\x95m\xa4\x07\xaf\xcb(=p\xb2\xdfl\x94\x1e(\xd7e\xa2\xf4`\x99.	\xa5\x87\xc6\xdd\xfc\xec\xa0\xf4\xc0\xa93\xddD\xe9\xc1!\xcf\xcc\x0eJ\x0f\xc0.\xfa\xc1\x0c\x15\xa5\x07F\xf5\x9b\x8c\xd2\x03a\xba<\x94\x1e4\xcf\xa5\xa0\xf4`\\\xcd\xcf	J\x0f\x8c8\xd3=\x94\x1e(un>@z\xd8\xe4\x9c\x97\x88+\xb9\xb6\xf2\xb3<r;\x85\xa4-a\xc8\x9c\xbfD\x901\xdf	\xc5EC\xf1\x1c?\xcc\xb9\x8f\xf6\x1b\xd3\x86X\x16\x1fIrk\xbfI\xcfJ	z\xb0w\x95\x94\x06K:\x15\xc4\xcd5%\xc1m[R\xa1\xdb\x98dU\xad\xf1\x90\x88\x19\xbac\xb7Vc7\x95<O\xff\x94\x16\xef\x0f>\xb4\xd8j\xff\x129\x9c\xddz\xc3\xd9b/>\xf6R\xb0\xf1\xd9\x08\xc4\xa5g\xfccaA\xbcX\xbd.AR)`\xf6\xe3z\xbb'\xa8T.\xb8\xc3\xf0\xda\x0d\xa8\x90]\xa5\xc8{\xca`\xb2\xca\x93\x06\x08UR\x98XP\x073hT\xbf\x1a\xf4`\xc0(xY\xb0\x81\xa2\xc6%\x96\xd8\n\x83BYK,\xf5\x9dt\x06\xab\x9e2\xb0\xd8\xa6\xa9(^\xb5\xe8\x93\xad\x1c\xf5*\xb1\xc8\x93\x06\xaa\x0d\xf5)\x83\xa9\x18\xf4)\x83DU\xca\x82m[Q\x882X\x83D\xedI,C\xf7j@(7\x89\xa5\xbcs\xceBAa\xb2\xd0vHATj\xc0\xed\x88\x82\x8e$h-\"\x19<JG\x04o\x1b\x83jQ\x0b7`\x14\x88\xc4\"Yx4\x0e5!\x85\xcbw\xf0i4\xc8@\x04\xdd\x8e\x1f(?\x0c\xdf\x0e!\x88=B\xd0J\x8f\xc1\xa3\xbeC\xf0\xb6=(\xe9\xb4p\x03F\x15G,\x92\xc4\xc7\xe0@\xb8Q8>\x84\xa0\xd5\x10t;\x84 \xcf0<\xb9\n\xab\"#\x04%\xc7\x188\x8a0-\xdc\xb6\x06u\x97\x06m\xb1 \xb5\x88%5\xc4\xc0P]\x11\x0b\xbc\x1f\xce\x80QPi\xc1MsQC!\xf0f0P6\x11<j&\x06\x8dJI\x83\xb6MAq\xc4\x82\x0d\x14\xf5\x10\xb1\xc4V\x18\x14J b\xa9\xef\x803XT=\x1al;j t\xb4\xe8v\xd0@\xdb\x108\n\x1b\x06\x8drF\x83\xb6-A\x05\xc3\x82\x0d\x14E\x0b\xb1\xc4V\x18\x14\xea\x14b\xa9\xefy3X\x94&\x1al\xd3TT#Zt;h @\x08\\\xab\x0f\x06\x8f\x9a\x03\xc1\xdb\xd6\xa0\xcc\xd0\xc2\x0d\x18\x95\x05\xb1H\x02#\x8dC1A\xe1\xe8W\x15\xf5\x03\x82n\x07\x10$\x03\x86o\x87\x10T\x02!h\x89\xc0\xe0Q\x18 x\xdb\x1e\xd4\x02Z\xb8\x01c\xf8/\x16\xc9\x7f\xa4q\x18\xf1+\x1c\x1dB\x0c\xf2	\xbai2\xc6\xf5\x0c\xdf\x0c!\x86\xf2\x99P\x82\xf1\x06\x8d\xd1{\x83\xb6m\xc1\x80\xdd\x82\x0d\x14ct\xb1\xc4V\x18\x14\x86\xe5b\xa9\xefd\xb3X\x88\xc4\x1bl\xd3T\x0c\xbe[t;h\x10o\xe7\xdb\x04\x9a`&\xdf(\x80\xcd\x82X\x87\xb1\xd5\x93\x06n|\xa3=\xaa\xe3~\xe2R\x05;\x1eu\xa0N1\xf2q\x98\x94\x87aP\xcb\xa3\x14\x8c\x89\x88+u\xc3\x87\x1b yD\xa7{\x18-\xb9\xd4\x13w\x8b\xa1\x93\xe6\xaa'\x0dx\x1c\xa5	x\xdc\xcf\\aP\xe50y\xff0\xc2\xe2DJ\xc3p\xab\xa1Q\x06\xc6^\xad#\x7f\xee0\x10sx\xde\xd4AT\xe61\xbd\x99\x83\x10MS\xcdq?\xeb)\xc6k>\x99\xb7\x19\x837\x97K\x99\x18\xc91&%aXG\xdd\xf9\xf3\x821\x9eO\xf5\xa6\x06\x02\xbe\x19\xb27;\x10\xfdi\xb69\xeeg]\xc6P\xd0'\xf3fc\\\xe8r)\x13\x83D\xc6\xe4$\x88\x18\xa9\xbb\x99\xd9\x81\xf0\xd1\xa7z\xb3\x03\xb1\xe4\x0c\xd9\xfd\xee\xd4\xc0R\xb3\xf5q?\xeb1F\x99.\x977\x1aCN\x8f\xca\x89\x10\x7f\x12\"\xe5`0\xca\x9c\xf9\x13\x83\x91\xa9\xcbt\xba\x88a\xaa\xcfu\x86\x16cVMV\xc7\xfd\xac\xb7\x18\xc0zT\xdeb\x8cf\x1d&\xe5ah\xdb\xf2(\x05\xe3\\\xe2\xca\x9f\x11\x0cz=\xa27!\x10\x01\xbbTo> \x1c\xd6\\u\xdc\xcf\xba\x8a\xb1\xb1G\xe5\x0d\xc6@\xd9aR\x1eF\xcd-\x8fR0\x84&\xaef\xe6\x03\xe2i\x8f\xe8t\x0f\x83k\x97\xea\xcd\x07D\xda\x9ak\x8e\xfbYg1\xec\xf6\xc9\xbc\xd1\x18\x83\xbb\\\xca\xc4\x80\x9c1)	\xa3s\xea\xce\x9f\x19\x0c\xd5}\xaa77\x10\xb7\xcf\x90\xbd\xd9\x81 ^\xb3\xcdq?\xeb2F\xf4>\x997\x1b\xc3{\x97K\x99\x18\xeb3&%a\xe0O\xdd\xf9\xb3\x83*\x80Ou\xba\x89\x92\xc0\x0c\xd9\x99\x1d\xd4\x07\x0c\xbb\x04\xfe\x0e\x15\xc5\x02\x8f\xca\x9b\x8c\xca\x81\xc3\xa4<\x94\x11Z\x1e\xa5\xa0\xa6@\\\xf9s\x82\x02\x83Gt\xba\x87j\x83K\xf5\xe6\x03\xa4\x87|\x9b\x80\xecKU[\xc87\n`O\x1b\xe9A\xb3\xd5\x93\x06\xee\xd1\xaa\xe6T\xfd\xe04R*J\x0f\x1eu\xa0L\x94\x1e\x1c&\xe5\xa1\xf4\xd0\xf2(\x05\xa5\x07\xe2J\xdd\xf0\xe1\x9e\xcdzD\xa7{(=\xb8TgPQz\xd0\\\xf5\xa4\x01?\xc2\xd5\x84\" x\xf3\x07\xd2\x83\xc3\xe4\xfdC\xe9\x81\x13)\x0d\xa5\x87\x86F\x19(=\xb4\x8e\xfc\xb9C\xe9\xc1\xe1yS\x07\xd2\x83\xc7\xf4f\x0e\xa4\x07M\x95\xd0x\xee\xbb\x84\xd2\x83O\xe6mF\xe9\xc1\xe5R&J\x0f\x8cII(=Pw\xfe\xbc\xa0\xf4\xe0S\xbd\xa9\x01\xe9a\x86\xec\xcd\x0eH\x0f\x9a-\xa1\xf1\xec\xec\x80\xf4\xe0\x93y\xb3Qzp\xb9\x94\x89\xd2\x03cr\x12H\x0f\xd4\xdd\xcc\xec\x80\xf4\xe0S\xbd\xd9\x01\xe9a\x86\xec\xcd\x0eH\x0f\x9a\x0d\xf2\x81\xc3E\xe9\xc1\xe5\xf2F\xa3\xf4\xe0Q9\x11\xa4\x07B\xa4\x1c\x94\x1e\x983\x7fbPzp\x99N\x17Qz\xf0\xb9\xce\xd0\xa2\xf4\xa0\xc9U?\xf0f\x05\xa4\x07\x8f\xca[\x8c\xd2\x83\xc3\xa4<\x94\x1eZ\x1e\xa5\xa0\xf4@\\\xf93\x82\xd2\x83G\xf4&\x04\xa4\x07\x97\xea\xcd\x07H\x0f\x9a[\xf5\x03\x87\x8a\xd2\x83G\xe5\x0dF\xe9\xc1aR\x1eJ\x0f-\x8fRPz \xaef\xe6\x03\xa4\x07\x8f\xe8t\x0f\xa5\x07\x97\xea\xcd\x07H\x0f\x9a+\xa1\xf1\xec\x8c\x80\xf4\xe0\x93y\xa3Qzp\xb9\x94\x89\xd2\x03cR\x12J\x0f\xd4\x9d?3(=h*R\xbd\xb9\x01\xe9a\x86\xec\xcd\x0eH\x0f\x9a-\xa1\xf1\xdc\xec\xa0\xf4\xe0\x93y\xb3Qzp\xb9\x94\x89\xd2\x03cR\x12J\x0f\xd4\x9d?;(=\xf8T\xa7\x9b(=\xcc\x90\x9d\x01F\xe9\xc1\xb0Qz`\x1dF\xe9\xc1\xa3\xf2&\xa3\xf4\xe00)\x0f\xa5\x87\x96G)(=\x10W\xfe\x9c\xa0\xf4\xe0\x11\x9d\xee\xa1\xf4\xe0R\xbd\xf9\x00\xe9!\xdd\xd3\xdd\xbdN/\xbc\x97\x11\x1c\xd6\xaf\xdb\xf7\xd3\xf3\x9dF\x9cv\xc6^\xf2\xc9\x01\xcaBZDLJg@-&\xa5$\xd0\xa8_~\xf9\xe5\x97\xe3YW5\xaaf\x8f\x87\xe3\xf4\x0c\x828\xbf\xfb&5DC\xba\xe5\xdf\xe9\xd9\xd0T\xa3\xd1\xb9\x9e\xe9\x19\x83\xd9\xaa6\xaa*J0\xb5\xa9\x16\x9b\x91j\xdaE\xbb\xf7yE#L\x9e\x1d%\x99Ew\xac\xd8,\x87\xc1T\xc8\x18\xa6\x9f\x0c\xa2[\xb9\x81JgH\xa6^\x1c\x12\xda\xd6\xde\xb4\x95\x10\xfe_\xab\x1c\xdd\xfa>\x19KR\xd7\xe0\x8f#k\xdb\xa7\xf5m\xae\xba3\x9fW\xa9\x06\x84\xd4\xd8\xff\\o\x7f\xb2\xb6\x11\x16\x80\xa6B\xac\xaf\x1d\x8f\xb6i\x83\xa9Lw\x96\xb4\xae\x05\xe8\xben\xa0B\x9727~m\x95\xbdi\xa3\x1an\xd2\xc4\xc6\xae[8\xaa\xc5\xd1V8\xad\x92\xdf\xbc!a\x8b\xe8\xd0TH(\xa6\xcb\x9fV\xbaQ\x95\xfa\xac\x99\xbe\xb3\xb6\xf6M[q\xb4\xfe\xbf\xaa\x1c?Ye\xcd\xe8\x7f\xb6Wh\xf8F\xc3\xd9\xe8YF\xcf\x18\xd8KC\x18\x7fb}3\x8c\x9fY\xbd5e\x83\x14\xd6\x0b\xce\xea[\x16\xf6\x84\x92\xc6O\x96\x1b\xdb\x17D\xab\xcb\x92-e\x9b\xc14\xe7sFo\x18\xaa\x03\x840~\xfa}\xb7=0x\xdd\xa4\x96\xb0A\x02\xed\x06\xe1\xf4-Gu\xa4\xa5\x8c?\xf3]k\xbab)\xaa3\xec\x1b\xbd\xd1\xcb\x0f\xeb\x0f\xa5\xf5\x8c\x86]B\x964\xe8t\xe8\xb7\xe5\x17\xea\x94\xb83\x15\xa9\x9b\x8b_\xd7\xa7\x1f\xf5\xa1\xb4	5\x95i\xd8a\x1c\x1bX*S0\xfc.\xd7\xc4S\nr\x97+\x992m\xc9\xd7[\xca\x96\xda\xb8\xcc\xeb\xac\x94>h\xf3\x831?i\xf3\x936k\xe3B\x1b\xc3X\x1b\x16'\x925\x0e\xda>\xcd3\x03m\xc2\x9d\x99\\\x86\xea+*M\"\xc3\x8ca\xd9\xb6\xc8\x8e\x08\x0c\x19\xb6\xc9\xc26aI[eq}X\x92vY\xd4\x18\x1e\xda\x96\xd9\xc9\x80\xd9\xc2\x96Y\xd8&<\xd0\x96Y\\\x1f\x1eH\xcb,j\x0cOm\xcb\xccu\x10\x86\xf0\xc4Zfa\x9b\xf0D[fq}x\"-\xb3(\xf5\x1b\x9a_\x82\x03k\x95\xbdN\xed\xef]\x8e\xeaI\x8bJM/\xef\xc3\xe90LOLO\x7f\xc3\x83\xd2b|\xed\xbe\xaf\xdf\xfb1\xdb\xe5\xa3\x82\x1c\x0f\xdb}|\"A \xf2QA>\xd6\xdbRE\xfc[\x19w\x87\xdf\xbal\x8c\x7f+\xe3\xfe0\x86u|V\xb0{\xcd\x18(\x92on\x7fx\xf9\xf5\xfa\xba=\x1d\xfb\xf5e\xfa4\x19\xb6\xfb\x98\x103h;\x16\"\xcc\x00&\xd3\xb8\xde\xf4\xd5\x92>\x81!\x0c\x87\x0fm\x8c%\x08x\xe9\xfa\xde b\x91\xdc,\x94Rc\x16sM\x9f\xf7\xbd\xef\xce\xa58~P\xddQV\xe9M\x05\xc5\xbfb+\xae\xe9\x8f\xd7\xed\xd0\xbd\xa4\xd7!\x94\x86e@\x18\xba\xdf\xba\xe1\xd4\x11`6\x89\xb0\x18	/\x87\xde\x02_\x0e\xfd\xfbn\xaf1^\xa5\x13\x96\xd4\x1b\x13\x8d^\xcb_\xcf\xf1\xa3\xb1\xea*\x0b\x8a\xd4\xb5?\xd8\xearJ\xd180\xdb\xb1\xdb\x9d\xc2i\\\x0f\xe3u\xca\x1b\x9bJ\xa6\xf1H\xc52\xca\xb14t\xfb\xd7\x16\xd5\xed\xe5\x8a\x9b0\xf2\xf2	\x84\xe1K'RIM\xb3\x8b\xb0\\\x88\xb5\x9d\xc6\xa1\x1b_~(\x9c\x94M\xb0S\xd7\x7f\x9f\xbe\xac\x13$~\x86/l\xfc\xa8\xba\x17\x0b\x9a\xde\xc5B\xe8\\\xc5\x94\xbe\xc5\"\xdd\xb5X\xa2z&\xae\xb0\xbd\xb1H7\xf7\xbf\xdeO\xe3\xf6\xfbE\x9a\x94?\xc5\x17+\xc4l\x8d\xb6]\xd9\x1e\x9bF\xb1\xa5}\xc5\x9a\x86\xba\x01c;\xb3m\xd3\x8d\x1f1?\x98\xc5\xa6\xbc\xb3\xd9\xaa[\xbdN\xbf\xb8\x1c\xc6d\x94uj\xaaK\x8f\x18kL\x06\xe2\x14y\xa3\x91\xb1u\xa2\xf8Xd\x9ct\xc1`I\xf72A\xba\xc7\xf0\xd8\xb94K\x8b\xf4\xfd|\xce\x87\xc8\xb1(^uSi\xbd\xfe\xe2\xc7\xb0\xddo\xc7\xedzZ*\x9e\xe5\x03\xf0\xe2\x127\xd9\xf4b\x17\xde\xca\x8a\x15\xffR\xbeN?\x86\xf8$\x1d\xfc\xad\xcc\xfb\x83%\xdfi+\xe1\x17\xc4a=\xca\x0bY\xd2\xdf\xf8\n\x96T\x90~\x94\x89\xad\xbe'&}\xce}\x89\xa6\xda\x99\x97\xbe[\x0f\xdf\xb7\xe7\xe7\xf5\xf7\xb84\xc8p?\xff\xd3?}\xcb\x0bv\xda\x04\xbe%\\|\x99\x8d|\xb7\xa7L\xf9\xeb\xfd\xe9\x9a\xfe\xfa\xbe\xdem\xfb\xcb\xf3\xe9r\x1a\xbb]x\xdf\xde\xfe\xa9\xdf\xee\x7f\xfd\xf3\xfa\xe5/\xa9\xe4\xdf\x0e\xfb\xf16fb\xed\xbb0an\xff\xd2\xbd\x1d\xba\x9b\xff\xf8\x9f\xb7\xff\xe7\xb09\x8c\x87\xdb\xff}\xbe\xbcu\xfb\xdb\xff\xd8\xbc\xef\xc7\xf7\xdb\x7fY\xef\xc7\xf5\xd0\xf5\xfd\xed\xbfm\x87\xf5\xcd_\xd6\xfb\xd3\xed\xbf\x0e\x87\xed\xeb\xf4\xe7\xff\xe8\xfa\xdf\xba\x98\x03\xfc\xe6\xdf\xbb\xf7\xee6\xb6#\x9c\xbaa[\x8e\x11b2\xf6\xf8Y\xb5\xee_\x0e\xfb\xd3\x98\xf2\xbb\xdf\xfe\xaf\xf7\x97\xed\xeb\xfa\xe6Oi\xfc\xe4\xd3\x06?\xdc\xfc%\xd2\xe5\xc3\xed\xbfv\xff\xb5\xfe\xcfw)\xfb\xd3v<\x8dC\xb7\xde\xdd\xfcg7\x14\xe0v\xd3M\xe9\xeb\xa5\xe0\xbfw\x87\xe1m\xbb\xbe\xb5\xcd\xda\x1d\xf6\x07\xd5\xaa?w\xfb\xfep\xfb\xe7\xc3~\xfdr\xb8\x8dM<\xf4\xeb\x13\xd6\xf7\xe7\xc3>\x1a\xde\x87m7\xdc\xfc{\xf7q\x1b\xabH_\x15\xa8\xf6\xc7z\x9b\xf2\xf4OU\x7ft\xb1+\xcf\x8b\xbb|\xe1D\xcf\xe3\x8f\xed^\x99\x97\xca\x9cN?\x94\xfd^\xd9%\x8d6\x02\x1e\x14 f\xf7}\xdf)\xc0\xa3\x02\x9c\xba\xddvs\xe8_\x15d\xa5 \x8d\xf9\xab2w\xe7qX7\x98']E\xbc\xafR\xb9\xf8\xe5\xeeN\xdd\xfa\xa9\x06L\xee\xf9\xa4\xc3&\xb7\x8a\x96\xd1#\xd8%\xc3\xe2=\xact@\x11<\x8d+A?0\xf44\xc8\x04\xfd\xc8\xd0y\xc4	~\xc5\xf0\x0e\xf6+\xc3\x96\xb9 \x95?1\x02>kK\xa7G\x8e\xc7\xf4\xf4\xa4B\xef\xaa\xae\xf8xq\x13\xec\x92a\xf1\x9c\xcf\xb9\xdek\xc52=m\xd5\x0f\x0c-\xd3\xd3\xa2\x1fY\xddezZ\xfc\x8a\xb5\xc5\xc1~e\xed\xae\xd3\xd3\x12\x9eXG\xf1~d\xfe\xed	\x8b\xeb\x8f\xa9\x0c\xf5\xb4\x1faY\x8a\xb1\xf4\xbe\x94J\x96\xfb\xa9\xf8!\x17/\xa0\xf0\xb1\x14\xea\x9aW\xb5\x1c\x8b\x9fr\xf1\x12\n\x17w\xa5T\x81\x17\xa5y\xf7XZ\xaa~\x80\xd2ei\xdd\nJ\xefK\x0dOP\xfaPZ\xb1Xb\x93k\x07\xb1\x8e\xf8\xb3#\x1b\xeaO\x90\x1f\xe1x.\xf0\x1c\xe5\xfe\x98\x14\xb3\\\x9c2\xbdO`\xc9\xf4^-\xbf\xc9n\xdcw\xeb\xd7\xed\xfem\xda\xe2\xe3\xd7&d\x8c\xb6\x8f\xd1\x93\x06|Y>\xda:\xd2:\xafA\x06\xd3\x1f\x0e\xa7NA\xe4^\x87]\xb8\xbb\xee\xd6\xc3\xdbv\x9f\x7f\xb3\xec\xc2\"\x97\xe0\xdc\xee\xc2\xb2\x14c\xe9})\x85\xabf\x17\x1erq\xbdjv\xe1\xb1\x14\xea\x9aW\xb5\x1c\x8b\x9frq\x9d\xae]\xbcjr\xa9\x02/J\xf3\xeaU\xb3\x0b\x8bRu\xbdjv1}\xbe\x94b\x0d\xcb\xd2\xe6z\x1d\xec\xe2\xb5$\xa5\xf5Z\xdaMW\x87\x94\xd7\xabc\x17\xaf\x0e)-W\xc7\xeeR\x868\xea}\xcfw\xdf\xe4\xc3$\xab\x94a?WX\xfc!Xq\xe9WN\x81]\xca\xf4\xa4\xda\xe4(SW\xa9F\xf7\\	\xa9^\xcd\x18\x9a\xe5aw)3=y`\x0eT\xfd\x05>\xd5O\xaa\x07\xf8\xa5\\1S\xed_\x11.C\xa2.\xa5s%L\xf5\x7f%\xedG\xc2\xa5\\|\xc9\xc3\xa2\xad\x1f\xae\xc9s\x05\xa7\xda\x11=\xd5\x0d\xe0K\xb9\x82\xa7\x9a\xe9\xe0\xebk\xfb\\)S\xfdl\xf85\xe5\x12V\xda\x0bu\x02\x1d>\x87\x95\xf1\xc1\\\xa8\x11\xca_\xad\xd4\x8fe\xdb	\xf8\xc6\x9d+8\xf5\x00\xd1S\xdd\x00\xbe\xd4\xef\xe7T5k\xbc\xfa\xe2\x9e\x811\xd5OZ\xaf\x18\x97\xfa]O>\xee\xdb\xe6\xc3\x12p\x06t\xaa\x1f\xe1S\xfb\x01}\xa9+F\xaa\xfb\xa1\xad\x1b\x16\x923\xa0S\xdd\x08\x9f\xea\x06\xf4\xa5\xae;\xa9\xee\xc7\xb6n5.\x15\x9d\xeanGE\x8dIY\xbdR\xdd\xab\xb6nX\xd4\xce\x80Nu#|\xaa\x1b\xd0\x97\xba\x06\xa6\xba\x9f\xda\xbaai<\x03:\xd5\x8d\xf0\xa9n@_p%M\xb5\xc7\xcf\xe6z\x81\x05V\x84\x01\xb1\xa7\xfa\x910\xd5\x0f\xf8K]\x91S\xed\x8b\xe3\xd9T^\xd7\xe93`S\xcd\x00\x96\xefi\xc1\x8eu\xb1\x8e\xd5\xe6\xc5y\xa8\xc5z\xd5\xdeT\x83\xf8\xcd\x94\xbeZ\x92\xd3\\>\xb2u^lC\xb5\x91\xf5{\x13\x16\xc6\x97\"\xf7t?\x90\x9aG\xb2\xf6\x8bi\x08K\xe3\x14x\x9b\xb0\xb4>\xc1\xda\xb3-\xa2x$\xfbAqyo\\\xa2u\x13\xee\xadO4\xf7t\xdf\x10\xe3\xd8\xee\x11b\x19\xaaE&\x1d=>\x18\x8f`\xec\xc9N\"u\x8et\xdf\x10\xe3\x10\x1e\x8dC5]\x9b\xf0h}\x9a\xe9|4\x8b?ZG\xb6\x97\x14\xc7+\xeb\x18\x98\x9b\xb0j\xfc\x82\xb9\x0f+\xe3\x16\x8cc\xbb\xbf\x14\x9fOWo\xef\xd8\x84'\xe3\x11\x88=\xd9\x85\x847\xd2=G\x8c\x03\x18e\xb7\xc2\x96n\xc0\x9c\x9d\xa2\xbd\xe7\xbb\x93XG\xb2\x13\x89i\x00S\xb3\xcbl\xc0(^a\x0f\xea\xd9\x8e%\xd5\x8ed\x7f\x12\xd3\x00\xa6f\xef\xd9\x80Q<\xc2\xce\xd4\xb3}L\xaa\x1d\xc9\xae%\xa6\x01L\x93G\x18\xb9\x0d\x18\xc5\xa3\x1aW\xb2\xbbI\xb5#\xd9\xcb\xc44\x80\xa9\xd9\xa76`\x14\x8f\xb0\x8b\xf5l\xcf\x93jG\xb2\xc3\x89i\x00S\xb3{m\xc0(\x1eao\xeb\xd9N(\xd5\x8et\xdf\x13\xa3\x1c\x98z{\xdaF\x99\xc5/\xd0{\xbeG\n{lwD\xb1\x0c`\xb1\xfb\xdd\x06l\xe2\xb1n\x86=\xd98\xa5\xce\xf59Ht\x9c\xfe\xb4\x11r*\xccQ\xb2F\xe4H9\x96~\x84\xf3)\x99\xe5^\x8d\xbb:\x90\xc9z\xda\x81\xf5\xdeZw\xaf`}\xb0\xd6\xfe\x0d\xac\x8f\xd6z\xee\xc1\xba\xb2\xd6\xa52\x7f\xb5\xe6{e~\xb2\xe6\x07e\xfe\xc5\x9a\x1f\x95yqg\xedeT\xe5\\\xbe\xc8\x0e\xbb\xed>\xfc\x88\xbf*\xe2\xbf\x9d\xfa\x19\x12K\x84X\x8d\x96\x99\xa7c\xbbg\xd3\xb1\xdd\x87\x0f\xa9\\\xddJ\x92\nj\xdd\xb6Q\xa1\x15\x1a\x02(\x0d\x01w\xad\x00ZC\x80\xf56\xec\xca\x96\xfe\x1cp\xaf\x0f\xa07\x84\xba%\x07P\x1c\x82\xda5\x03h\x0e\x01\xb7\xbd\xb0+\xfb\xccs\xa8\xdbO@\xdd!\xe0\x0e\x11Py\x08u\x15\x0f\xa8=\x84\xba\xd6\x06T\x1f\x82\xaa\xa7\xacO\xcf\xa1\xae[\x01\x15\x88PW\x97\x80ZC(\xdf\xc5\xf0\x93jC\xf8I\xb9!X\xbdA&\xca\xd4j\xa6\xcfJ\x0e\x864-.\x86cT\x87\xf0\x85\xba\xc1\x01\xb3\xc2C\xf8\xc2\x9c \xc3h\x0fr\x0d5}QW\x96\x95\x1f\x0c)\xf7Eq\x8c\x02\x11\x16\xc4\x0b^\xa6V\x84\x08\x8b\xd6\x03\xe2\x8d\x0e\x91\xafm\xdb\x11)\xae=y4^\xe8\xb4\x18\x96Q#\xe4\xdb\xd2\xba\xc2\xfe[A\xc2\x90\x8a#=f\xf9\x8b\x97\xb6\xc3\xb0$^\xf0\x0bie\x89\xb0l= \xde*\x13aI;\xa2\xbf\xdb\x8d8aX\xd9\x8f\"Y}\"\xdc\x93\xae\xe0J\xd1H\x14\xe1\xbe\xf5\x81\x04\xabR\x84\x07\xe2\x01\xd7\x9cF\xa8\x08\x0f\xad\x07$X\xad\"\xb0\xb1\xc2N7rExl=\xe8Q\xaa\xbf\xc7\x92\x07\"Y\xa8u\xb0\x11-\xc2\xaa\xf5\x80\x04\xab[\x84'2J\xb8\xa26\xd2Exj= \xc1\xaa\x0b\x01\x14\x83\xec\x00\x96\xe6F`@\xbcT\x0fp\xae1\x04Wd\x08\xbe\xca\x10<\x99!X\x9d\xc1\xac\xca\x8d\xd2`\xec\xad\xd6`\x00Vm0f\xa37\xe8\xdd\xbeQ\x1c\xb4\xb9\xd5\x1c\xb4\xdd\xaa\x0e\xda:\xd2\xbd \x0f\xd7\x10\xee\xadk\xb5\xc2\xb7\xda\x83\x01X\xf5\xc1\x98\x8d\xfe\xa0V\xf7F\x81P\xd6Mx\xb0\x9e\xd1lU\x08e4:\x84]\xea\x1b%\xc2\x02Z-\xc2\"\xfa\xf0h\xdck\xbb\xd1#dc\xc8\xec!\xac\x9a\x06\xe8Q_\xb5\xfe\x11`U	\xc37\xba\x84\xda\x1f\x86\xf0d|\xa3\xb5\xd5&\x94\xd9\xaa\x13\xcah\xf5	\xb3\xc7\xb4\n\x85\x01\x10\x8d\xc2 \x1a\x95\xc2\xd8\xadN\xa16\x93V\xa9Pf\xa2U({\xa3V(\xab\xd5+\xd4&\xd3*\x16\xcaL4\x0beoT\x0be\xb5\xba\x85\xda|Z\xe5B\x99\x89v\xa1\xec\x8dz\xa1\xacV\xbfP\x9bR\xab`(3\xd10\x94\xbdQ1\x94\xd5\xea\x18j\xb3j\x95\x0ce&Z\x86\xb27j\x86\xb2ZU\x01\xf7\xb1VW@+Q\x16\xd0\xdch\x0b\xd5x8\xae_\xb6c\x8cw\xe4\xaf\xbc\x11f\xc3\xf2\xb1X\xca\xa1\xb9\x14\x84\xc7\xca\xcag\xe5\xd9\xf4\x15h_\x8dmqWyrT\x1fo\x98\xf9\x1e_~\x14E\x96k\xfe\x04\x92K\x01\xc8\xdd\xd8\x052}6\xa0\xdf\xb6\xa7m\xbc#\xbc\xa0\xa4\xc0\xc0N/\xc3\xa1\xef+j\xfal@rZ\x92A\xe1\xccZ%G0\xf53\x03\x9dm\xe3\xc3\x997\xff\xd2\x00/\x1cxnz\x1a\xceN_/-\xf4\xe2@\xcfv`\xc2\x99\x0f\xcd\xa5\x01^\x14p\xfa;\xde\xa21\x1e\xde_~\\\xc3G\xb7\xf9u;\x86\x02/\x80\xe7\x04\x90{\x9fs\xe14\xa63\xa4:\xc6\xc7pw=\xae_\xe3\xad\x1c\xf9\x02>\x86E)\xc2\xfd\xf3\x18\x96\xb5\x1c\x8b\xefk1\xfc\xcc8\x86\x87R^\x7f\x06\x1c\xc3c-\xd5\xb5\xaf\xc0\x80\xe5O\xa5\xbc\xeek\xc7\xb8\xe7\x94b\x05_\xd4V\xd6\xbd\xe0\x18W\xeb\\\\\x17\xeac\\Js1V\xb2\xacm\xafK\xdc1.B\xb9\xb8\xae?\xc7\xb8H\xe4\xe2\xb2>\x1c/udE\x0b\x11L^h\xf2p\x9f\x01(\xf7^d\xa4RC\x8e\x97:1x\xf7\x85\xa9V\x0d\xea\x19(x\xff\x85v\xa0(\x97:\xcdp\x07\x86u\xa2|T\x02\xdc\x83a\\(\x0f\xe5\x8a\xc1\xbb0\xac\x0b\xbc\x96\xce@\xc1\xfb0\x8c\x13\xa4\\\xea\xf5W\xef\xc40>\xe0\xba<\x03\xbc\xde\x8b\xa1\xeb\x07\xf8\xa5^\xc7\xean\x0c\xeb\xc0\xccF%\xa9\xfb1\x8c\x1b3\x1f+\xe3\x89;R~V\xd6\x0fu\xa3\xbc\x94\xafY\xbd+\xc3t\x06\xbe~g\x80\xd7\xfb22\xde\xde\x98\x11\xaf\\\xfde\x10!\xc3:\xd0\x9d\x00\x0e\xde\x9ba\xbc\xe8^\xd4o\x7f\xbd;\xc3x\x81U\xe1\x8c\xf8z\x7f\x86\xf6\x00\xf8\x0b,#\xf5\x0e\x0dS?,/g\xc4\xd7{4t\xfd\x80\xbf\xc0zT\xef\xd20\xf5C\x7f\xcf\x88\xaf\xf7i\xe8\xfa\xd5\xf8\xd4\x85\xad\x9cn\xd9\xfaa\xc1;#\xbe\xde\xab\x91	\xf6\x10\xecx\x81\x15\xb2\xde\xada\xea\x87\x95\xf3\x8c\xf8z\xbf\x86\xae\x1f\xf0\x17Xj\xcb\x1d\x15\xa6\xfa\xba\x02\x9f\x11]\xee\xa9\xd0\x95W\xf4\x08\xcb0(\x1e\xc7\x01\xca\xf5z\xbc\x01\x8bY\xd3{0\xe1\x8d\x15\xc7\x91\xae\xe1\xc5\xd5\xe2jV4\\	6aa\xfd){\xcf\x17{a\x8fl]\x17\xdb\x10\x96\xd6\xb1\xf2\xbb\xb4\xfdDsO\xd7\x7f1\x8et\xad\x17\xe3\x10\xeem\x7fq\x05\xdf\x84{;\xbe\xca\xde\xf3MA\xd8#Y\xff\xc54\x80I.\x03\xf4\xfa`g\x15\xac=\xdb&\x8aG\xba'\x14\xa7\x8f\xd6\xa9\x99\xddG{5i@\xef\xec\x1e\xc5\xbb^\x9a\xd4\x8e0\x84\x95\xed1\x9a7ae\xafde\xef\xc3\xca\xba\x06\xebH\xf6\x0e\xa9x\x00S\xb3+l\xc2\x93\xfd\xfe\xc0\x16\xd3\xb3-F\xaa\x1dqo\xb0w]\x1c\x07\xb4\x92]b\x13\x16\xcd\x17Wm#\xbd\xb3\xf5T\xef\xed.#\xb6\x01m\xcd\xfe\xb1A\xab,\x19@\xee\xe9vT\xbd\xea	\x86}c@[\xb3\xabl\xd0*^\x81\xdc\xd3M\xaax%;\x92\xd8\x06\xb45{\xcd\x06\xad\xe2U\x8d1\xdb\xba\xaa\xd7v\x9f\xaa^\x1f\xae\xee\x0e\xb4A\xabx\x05sO7\xb4\xe2\x95\xec^\xc5+\xd8\x9a}i\x83V\xf1\n\xdbVO\xb7\xb9\xe2\xb5\xdd\xd3\x8a\xd3\xe3\xd9.Te\xbf\xda\xa0Q|Vk\xcf\xf6>aN\x0f\xb0\x87\xee\xb7n?\x9e\xa6\x87\x00t\x19<\xf0g\xc01p\xb4\xe0\x1aL\x9e\xc6\xf5\xb8}\xb9\x96\x8c\xef\xd3gyvd{\xee^\xab)}\x9c,\xeb\xcd\xe9\xd0\xbf\x8f]5\xe6\x12y\x0dI\xd7\xaf\xc7\xedo`\xcf%\x12\xf6\x8e\xdb\x97_/\xd5\x9a\xe3\xde\xa9\xfc[)\x9f>K\xb7\xb6\xfb\xa9\xe3\xe5\xa6\xcfz\xf3\xe67\x19\xcc\xf4w\xb9\xc9\xb3\xf0\x12\xe5N\xf0w\x19|\xf7Mm\xf3\xdb}\xb8\x080\x03\xaa\xe3\xf35\x93-g\xbc\xe2/\x8f\xa8Xeh-\xda\\\xdb\x1a\xfb+\xd64t1\x97\xfb\xd4\xbf\xe9o\x98P1^\xb2\xe5\xb7n\x88OF\xf6\xcaz\xce\xd6\x1f\x87a\xfb{\xcc	\xaf\xec\xd9Z\x9f\xef<\xfdX\xbf\x1e>\xae\x9bC|\x865\xfe\xf9|w\xb3<\x9eo\x1e\x8e\xe7\x9b\xbb\x9b\xe1m\xb3\xfe\xe7\xbb\xdb\xf8\xdf\x97\xc5\x1fd\xd6\x12,\xde\x1e\xa3H\x91\xf0\xd4\x92\x96\x7f\xb8\xf5*\xbc{\xd25\xf6o\xba\xc6\xc5\xe3\xf1|s\x7f\xd7\xd6\xb9\x88uF\xe3\xe2\xb1i\xa5\xadt\xbb\xdfw\x03\xb6t\xbb?u\xe3\x8d\xdb\xa6\x95\xa6\x1f\xde\xc7\xf8\xf4\x8b\xeej\xfc\xef\xfex\x9eF\xe7qy\xbbxx\xb8].\xefn\xbf<j\xb2\xbck\xa6\x0cR\x9dKy\\N\xcas\xf6\x0c\x18\xcd\xf9)P\xf4\xb0\xcbO\xe6\xfd\xa3\xe6CW\xdf\xbf\xb1\xea\xff\xbe\xc9\xd1\x1e\xd2\x1c\xb5N~r\xa6t]2a\xac\xc9\x9fN\x9b\xaei\x7f`\xd5\xd49\x94g\xea\xa4\xfa\xfc\x1aR\x98\x81\xf9\xaf\x91\xa2\xc79$5\xfc\x1d\xdf)]}\xff\xc6\xaa\xff\xfb\xe6P{\x98\xe6\xb0q\xf2\x93s\xa8\xeb\xcasH\x9a\xfc\xe9\x1c\xea\x9a\xf6\x07VM\x9d\xc3\xf4\xcc\xfb\xb47N\x7f\xf6\xeb\xcb\xe1\x1do\x9b\x9c\x8a\xd3\xa6\xa7!\xb0\x0f\x8e\xddY\x1e\xc8O\x7f\xa5w\x17\xc0S\xf9\xa9P\xde\xc2\x00\x00|\x05C*N\x1b\x06\"\xe0\xa1\xffT*\xef|@\x88\x14\x01\x08\xb2\xd1\\\xa77\xef6\xf9i\x12.=\x06*\x08\x95\x89&Yk\x16\x99S\xaeF\xe5\x9c1\xa0\xe1J\xb2\xcbh\xcc\x95\xe4\x91)\x88+\xc9\x18S\xe9\xe99\xde\x0c\xc1\xdc0\x06R\x9b\x81Y`,\xa8v\x08\xf3\xbd$TJ\xf3r\xb5\x99]\x92\xa9\xa4e\x81\xf1\x80\x1c.\x1a3\\I\xb6\x16\x05\xc9\x00\xcc\xcb\x92\x01W\x92\x81\xa5\x90\xd5X`\xae\x15\x8d\x80\xa1\x80\xac*\x06\x03#\x01\xf9S\x12H\xa7M\xc90\xcc\x94\xd2\xc0\x8aG\xcc\x89bQW\x92\xfd\x040W\x92\xe7\x04\xabP\xbd\xc7\x8c&\x0d\x08\x06\x00r\x97\xb40\x18\x03\xc8R\x92p\x92<\xc3|\x0b0\x1fI\x03\x1b\xae$\xf3\x88E]I\x8e\x11\xc0\\I6\x11\xacB\x8f\x01\xe4\x0di@0\x06\x90!\xa4\x85\xc1\x18@.\x90\xfc\xbd\xc9i<*\n\xb3~X\xd4p%\xf9=\x0c\xe8J2yT\xc8\x95\xe4\xec\x80\nT\xf71;\x87\xc5\xd4\xa6`\x1e\x8e\x06U\xbb\x85\x197\x12\xacf\xcb\x80\xbeCn\x0d\x03\x1a\xae$\x8b\x86\xc6\\I\xbe\x8c\x82\xb8\x92\xcc\x18\x95\xae\xfa\x8d90\x0c\x04\xba\x0d\xd9.,\x08z\x0dy-dc\xa8\xe9,\xae$\x83\x85\x01\x0dW\x92\xabBc\xae$+EA\\I\xfe\x89J\xd7\xbd\x86L\x13\x06R\x9b\x819%,\x08z\x0d\xd9#\x12J\x92\x1a\x98\xd9\xc6<\x11\x0dl\xb8\x92\x8c\x10\x16u%\xb9\x1f\x00s%Y\x1e\xb0\n\xd5\x7f\xcc\xe7\xd0\x80`\x04 sC\x0b\x831\x80\x1c\x0d	'\xa9\x03\xcc\x18`6\x86\x066\\I\xde\x05\x8b\xba\x92\x0c\x0b\x80\xb9\x92\\\nX\x85\x1a\x03\xcc\x9a\xd0\x80js0?B\x0b\xab\x9d\xc3L\x08\x13\x0e\x12 \\I\xce\x03\x03\x1a\xae$\xbb\x81\xc6\\I\x1e\x83\x82\xb8\x92\x8c\x05\x95\xaez\x8e\xb9	\x0c\xa46\x03\xb3\x10X\x10\xf4\x1a\xf2\x0dH\xd0\x93\xc0\xf0KQ\"\x1f\xe7\x17$\x92\xf0u/\xe4\xe7$B\xeb\x0f\xc6\xd3\xa8\x19\xf8\xdb\x923\x06\xed\x02\xd3\x18R\x82\x86c\xf6B\x0b\xd7HLZ\xd8T\x8c/\xfc!\xbfG9\xde6\x1d\x7f\x9c:\x0c;<\xf8K\x15)\xe9\x07\xab\x81~\xff\xde\xe2\xca/\xd3f\xdc\xe17,%\x98\xb6c\xfaA\x86\xd7h\xfcuk\xd0\x1a\x88\xc9\x06m\xb5d\xcc\xf1w/\x857C\x0e?\x829\xa1\x19q\xf8E\x8c\x0c\xf9\xf1H/b\xfcy\xecqL\xc3\xf0\xb7\xb2C\xd1\x04L\x1b\xd8\x124\x16\xb3\x05\x92\xca\xc9\xc0\xe2Oj\x8f\xd1\x8c-\xfc\xbev9\xcd\xf0\xc2\x8fm$\xc9\xefR>\xbc\xf0\xcb\xdb\xe3\x98\xb6a\x02@\x87\xa2	\x98\xf7\xaf%\x18,\xfc@'\x95\xb3\xe1\x85_\xeb\x1e\xa3\x19^\xf8\xe9\xeer\x9a\xe1\x85\xdf\xf1H\x82\x1f\xea\x96\x82?\xea\x1d\x8ai\x19f\xf0\xe3\x0c\x83\x87\x9f\xfb\x0d^C1__[5\x19Y\x0c\x04\x1c\x82m>F\x05\x1e\xc5\x0e\x12\x86\x08\xc8\xa9A@3\xac\x10/p\x86i\x16\xc9\xfe_\x7f\xbc\xb7\x1b\x1bI\xfa_\xe0\xbab\x92\xeb\xbfVL\x86\x14c\x8c\xa6\xe5tc\xc3\x80\xc3a4\x03\n\xd1\x07Rj|a\x19$\x8f\x7f\xfd\x99\xcf\x06\x94\xa4\xef\xd7\x04]?\xc9\xda_\xe0\x1aI\x92\xf5\xd7\x8a\xd9\x80B\xf8\xd2\xf4\x95\x0e(\xc62\x0e\xa3\x19P\x08l\x90\"1\x00\x1fR\x88r<\x8e\xbdJ!\xe4q(\x9a@\xd2\xeeCl\xa2\xb1$\xdb~\x13\xc7h\x06FF\xa49|p!Lr9\xcd\xf0B\xcc\x84$	/\xe8\xf0b\x00\xe5q\xcc\xf0\x92\x04\xfa\x18\xc5\xb4\xcb\x00\xc9\x9b\x0f\x04]9I\x97\x8f\x95\x93+\x17\x83.\xd2\x03:\xbc\x18\x81\xb9\x1c;T\x18\x8e)\x12\xa6\xa5SnH*\xfc\x1a\xf7\xb0\xe5\x80d\xc0\xd7\x04]?I|_\xe0\x1aI\xf2\xdd\xd7\x8a\xc9\xa0b<\xd7\xf4\x95\x0e)\x06w\x0e\xa3\x19P\x88\xf4\xe4h\xa4\x89\xf4R\xb9\x10\xa0\xbc%\xe1\x9b#\xc9\xc1\x01\xd6\xaf\"=t\xa0\"=\xce\x18\xb4\x0b\x92\xb0>\x8bx*]=\x89\xf4l\xfd\xbab\x92\x9e\xbeV\x8c\xef\x0e%'\x0fM\xcb\xf3\x84)\x0f\x18\xe99\x8c\x93q\x82\x91\x1eR0\x03\xbd\x8d\xf4\x10\x87\x91\x9e\xaa\x18#=J\xb0\xc3\x0e\x91\x1e\xc3k4Fz\x06\xad\x81$\xad\xbc>\x950p\x88\xf4l+\xf8\x90C\xa4\xc7	\xcd\x88C\xa4\x87\x0c\x13\xe9)/\x18\xe9y\x1c;\x9e\x10\xe99\x14M 	\xe2\xe1\xf8BcI^\xf8\xe6\xa8\xc30 \xd2#\xcd\xe1c\x0b\x91\x9e\xcbi\x86\x17\"=$\x99HO9\xc2H\xcf\xe3\xd8\xe1\x85H\xcf\xa1h\x02\xc9\xf0\x0e'#\x06\x0b\x91\x1e\xa9\x9c\xac\x15\x18\xe9y\x8cfx!\xd2s9\xed\xd5[#=$\xe9HO\xf5\x06#=\x87bZFr\xb5\x9b#\x1d\x83\x87H\xaf\xf1\xa0\xa1$3;TMF\x16#\xbd\xb6\xf5\xf4\xba\xc5H\xcf\xa3\xd8A\xc2H\x0f9*\xd2S^0\xd2\xe3\x0c3\xaa$\xd9z\x0d\xc8\xdaA%9\xd6\x0b\\WLR\xab\xd7\x8a\xc9\x90b\xa4\xd7\xb4\x9c\x8f(Dz\x0e\xa3\x19P\x88\xf4\x90R\xe36\xcb i\xd3k|\xc5\x06\x94dK\xd7\x04]?I\x92^\xe0\x1aIr\xa3\xd7\x8a\xd9\x80B\xa4\xd7\xf4\x95\x0e(Fz\x0e\xa3\x19P\x88\xf4\x90b\"=\xe5\x06#=\x8fc\xafR\x88\xf4\x1c\x8a&\x90,\xe7\x18\xe9),In\xdeFz\x8a\x81\x91\x1ei\x0e\x1f\\\x88\xf4\\N3\xbc\x10\xe9!	B\xb0\x86\x83\x91\x9e\xc71\xc3K\xf2\x95c0\xd6.\x03$M9\x10t\xe5$;9VN\xae\\\x8c\xf4H\x0f\xe8\xf0b\xa4\xe7r\xecPa\xa4\xa7H\x18\xe9)7$\xf3x\x8d\xaf\xd8r@\x12\x8ek\x82\xae\x9f\xe4\x19/p\x8d$\xe9\xc5k\xc5dP1\xd2k\xfaJ\x87\x14#=\x87\xd1\x0c(Dz\xa95g\xc9\x90\x90n\xf8\xc5\xc7o\x92\xf5$	<&\xeb\x935\xc7<P\x00\xa8\xcf\xd1$r\xff\x866\x9554\xd9\xcf\x92>D\xec\xd6\xbc4vc\xbe7\xe6\xa6q\x0f\n\xb0l\xea\x7fT\xf6\xfa$\xc6v\\\xf7\xdb\x171\xa5\x04\x91S\x89\xdc!}\xd8\xad%oJN\x0c\x19_\x90?\x19\xdf\x8f\xc7nx\x89\x83R\xc3\xe7\xef\x87aW\x0d\xf2\xe2\xfc\xc3\x07\xc7\x15\x83\xe4\\Z\x1f\x93\xef\xdf\x1b`\xb5L\xc8\xa9\x15\x81\xd5Y\xefc\x8c\x19B\xa7\xb40\xa9y\xaf\xdd\xcbaJRS-\xd2\xbe\xf8\xb6\xff\xf1\xc7px\x7f\xfb\xd1`\xd1\x98\x9d\x87RA\x03\xaf\xeeS\xae\x9d~\xbb>u\xaf\xe5\xe1\xf5i\x94w\x87C\xcc\xd8\xf1\x86\x90oaw\xf8=\x1cNg\x8by\x1b\xd6\x97\xd3\xcb:\xbf\xb4\xe0\xf4\xbe9n\xcf]\x1f~\xa6\xfax\xcb\xbfWo\xbd\x9bST\x934\xc0/\xa2\x948\x97\x83@\xd3U\xd1\"\xf1\xda\x10d\xb9\x12\x04m&\xcb\\(B*\x97\x05'\x99\xabFH\xf5\x12\xe1,{		\x0d\xae$\xce\xab\x13*\x8c2\xf7\x88\xf7/.a\xe1e\xe4\x10\xdb+M\xb8x\xc19\xdc\xa6\x95py\x08\xe5\x1fw\x0dJ\xb3\xd8\xa5\xf8\x89\xaf\x9f\xbb eq\x97\x0b\x122\xb8\xb4\x17\xa4@\xa7\x0b\xb2A\xe2\x05)\xc8zA\xa6\x82O.H!\xd5\x0b\x92\x92\xcc\x05)$\xb8 )\xcb^\x90B\xc3\x0b\x92\xf2\xeaT\x0b\xa3^\x90\x80\xf7/Ha\xa9\x0b\x92\x13\xdb\x0bR\xb8\xea\x82\xe4\xdc\xa6\x95xAN\x94\x7f\xdc\x05)\xcd\xa2\x17\xe4\xbc\xaf\x9f\xbb \xc7a\xfd\xf2+\xa4\x90\xe9\xc6\xf8\xc4Y\xccB\x16q\xe1\xcb\xddc\xb73\xc8\x9cHFC\xefL}\x1f\xdb\xd7\xceb\xa0\xb6S\xd7w/\x92\xbb.\x8f\xd6\xfb)\xd6\x97\x0ci\x90\xa7\xe6\x93\xd2S[h\x0b\x94\x978\x83\xd4K4\xb4^\xa4T{I\x85\xb6@\x9e\x8b\x8b\xf7\xf7\x87\x9cJ\xf3\x9a\x1f\xd4\x92[\xf6u\x8a\xcdT\x16\x9f\x94\xb4\xb0\xf1 \xe9A'\xc4n\xfb\xfa\xda7uM\xa5\x88\x9b\x1eZ\xb3\xb8\xa9\x14q\xb1\xb5\xd4\xad\x947X^1\x98&\x86\xbc_\xe7\x9a\xfe\xdd\xf6\xf1\x85G\xea\x95;\xdb=A\xe0\x9b~\x92\xd0\x1d/\xa2._X\xa9$]\x82z\x99S\xc8\x94\x7f\xd5@k\x8aU\x80\x1e\x87N\xc1\x8eC\xc70!\xae\x08\x16\x18j\xfe]]c\xeb=\x17J\"\xe0\xa1[\xff\x1a>\x0e\xc3\xeb\xe9\x1a\xff?\x99\xa0\x14Q\xb9\xd7\x05\x86}\x1e\x87\xf7\xfd\xcbz\x84\xf7<Mc\xf7-MD)\xec\xfa~{<mO\xdf\xb0\x03\x98s\xf6#,\xae\xd3\xbb\x96\xf1\xd7\xf3GX\xe6R,\xbc\xcf\x85\xf0C\xfc#<Hi\x8d\x0d>\xc2c.\xd3\xb5\xaeJ1\x96>Ii}t\xfc#>\xbe-\x85\n\xba\xc8\xed\xaa?\xe6?\xe2\xc3\xcf\xf2rn(\\\xe6f\xd5\xc7\x83?\xe2C\xbc\x92A\x1b\n\x1f\xb2\x7fH\xeb\xf5\x11V\xa5[XA\\<\xa5\xbc\xfe\x98\xfc\x08\xc7s\x06\xe7\xc7w?\xc2\xe2\xaf\x7f\xcc\xde\x1e\xf3[\xacSi\x1e\xc5\xfb\xfb/\xf7\xf1\x7f\xc5\xb6\xac\xb6\xd5\xea\xcbj\xb5Z}E^n\xd0\xf2\xb1\x94\xde\xd7\xd2\xaf\xb5t\xf1\xd7?\xe6\xf1_V\xcf\xcbZ\xfaPK\xefk\xe9\xaa\x96>\xd4\xd2\xa7Z\xba\xf8\xeb\x1f\xcb\x04\xda\xf6=V\xdb\x93\xedWz\xa9\xb7\xf0\xca\xbb\xc2?\xf2{\xc2\x8b\xe17I\xff\xfc{\xb8\xbb\xfe\x1e\xd5\xab\xee\x9c7\x8f\xdf\xc3\xa2\x96-J\xe1\xb2\x02\x97\xa5\xf0\xbe\x16\xde\x97\xc2\x87Z\xf8P\n\x1fk\xe1c)\x8c\xd3Z\x8a\xd7\xef\xe3\xe1o\x7f\xfb\xbf\x03\x00PK\x07\x08r\x89\x1f\x89\xca\xc5\x00\x00!\x8f\x04\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00i\x02\xfdL\xbf\xea\xfa\xb8%\x01\x00\x00\xcf\x01\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x00\x00\x00\x00index.htmlUT\x05\x00\x01\x06\x08][PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe7\x00\xfdL\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x08\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81f\x01\x00\x00main.cssUT\x05\x00\x012\x05][PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00U\x86R]\x88\xac\xeb\xd7\xfe\x01\x00\x00w\x04\x00\x00\x07\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xa7\x01\x00\x00main.jsUT\x05\x00\x01\xe2\xf8\xd4jPK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xc6\"\xfeL1\xf1\x06u\xd8\x01\x00\x003\x05\x00\x00\x0b\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xe3\x03\x00\x00main.js.mapUT\x05\x00\x01t\x92^[PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xc6\"\xfeL\x1as\xd7)\x02\n\x00\x00y \x00\x00\x0c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xfd\x05\x00\x00tailwind.cssUT\x05\x00\x01u\x92^[PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xe2\x00\xfdLr\x89\x1f\x89\xca\xc5\x00\x00!\x8f\x04\x00\x10\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81B\x10\x00\x00tailwind.min.cssUT\x05\x00\x01(\x05][PK\x05\x06\x00\x00\x00\x00\x06\x00\x06\x00\x8a\x01\x00\x00S\xd6\x00\x00\x00\x00"
 	fs.Register(data)
 }
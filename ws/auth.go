@@ -0,0 +1,239 @@
+package ws
+
+import (
+	"bufio"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Time allowed for a client to send its auth message, when it didn't
+// present a token during the handshake.
+const authTimeout = 5 * time.Second
+
+// Role is what an authenticated client is allowed to do.
+type Role int
+
+const (
+	// RoleNone is an unauthenticated client.
+	RoleNone Role = iota
+	// RoleRead may receive streams and manage its own subscriptions.
+	RoleRead
+	// RoleControl may also change server and sensor state.
+	RoleControl
+)
+
+func (r Role) String() string {
+	switch r {
+	case RoleRead:
+		return "read"
+	case RoleControl:
+		return "control"
+	}
+
+	return "none"
+}
+
+// ParseRole returns the Role named by s: "read" or "control".
+func ParseRole(s string) (Role, error) {
+	switch s {
+	case "read":
+		return RoleRead, nil
+	case "control":
+		return RoleControl, nil
+	}
+
+	return RoleNone, fmt.Errorf("unknown role %q", s)
+}
+
+var (
+	errForbiddenOrigin = errors.New("ws: origin not allowed")
+	errBadToken        = errors.New("ws: invalid token")
+)
+
+// Auth decides who may connect. A nil *Auth lets anyone on the network in,
+// subject to gorilla's same-origin check, with RoleControl.
+type Auth struct {
+	// Origins allowed to open a connection, as scheme://host[:port].
+	// "*" allows any. When empty, only same-origin requests are allowed.
+	// Requests without an Origin header (non-browsers) are always allowed.
+	Origins []string
+
+	// Tokens maps each accepted token to its role. When empty, no token is
+	// required and every client gets RoleControl.
+	//
+	// A token is presented as "Authorization: Bearer <token>", as the
+	// "token" query param, or as the first message after connecting:
+	//
+	//	{"type": "auth", "token": "<token>"}
+	Tokens map[string]Role
+}
+
+// LoadTokens reads a token file: one "<token> <role>" pair per line,
+// blank lines and lines starting with # are ignored.
+func LoadTokens(path string) (map[string]Role, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	tokens := make(map[string]Role)
+	sc := bufio.NewScanner(f)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%s:%d: expected \"<token> <role>\"", path, n)
+		}
+		role, err := ParseRole(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", path, n, err)
+		}
+		tokens[fields[0]] = role
+	}
+
+	return tokens, sc.Err()
+}
+
+func (a *Auth) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+
+	if a == nil || len(a.Origins) == 0 {
+		return strings.EqualFold(u.Host, r.Host)
+	}
+
+	for _, o := range a.Origins {
+		if o == "*" || strings.EqualFold(o, origin) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// lookup returns the role of token, comparing in constant time.
+func (a *Auth) lookup(token string) (Role, bool) {
+	role, found := RoleNone, false
+	for t, r := range a.Tokens {
+		if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+			role, found = r, true
+		}
+	}
+
+	return role, found
+}
+
+// requestToken returns a token presented in the handshake, if any.
+func requestToken(r *http.Request) string {
+	if h := r.Header.Get("Authorization"); strings.HasPrefix(h, "Bearer ") {
+		return strings.TrimSpace(strings.TrimPrefix(h, "Bearer "))
+	}
+
+	return r.URL.Query().Get("token")
+}
+
+// authenticate checks a request before upgrade. It returns the role
+// granted, or RoleNone when the client may still authenticate with its
+// first message. A non-nil error has already been written as an HTTP
+// error response.
+func (a *Auth) authenticate(w http.ResponseWriter, r *http.Request) (Role, error) {
+	if !a.checkOrigin(r) {
+		http.Error(w, "Origin not allowed", http.StatusForbidden)
+		return RoleNone, errForbiddenOrigin
+	}
+
+	if a == nil || len(a.Tokens) == 0 {
+		return RoleControl, nil
+	}
+
+	token := requestToken(r)
+	if token == "" {
+		return RoleNone, nil
+	}
+
+	role, ok := a.lookup(token)
+	if !ok {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="xxws"`)
+		http.Error(w, "Invalid token", http.StatusUnauthorized)
+		return RoleNone, errBadToken
+	}
+
+	return role, nil
+}
+
+// authMessage is the first message of a client that authenticates
+// after connecting.
+type authMessage struct {
+	Type  string `json:"type"`
+	Token string `json:"token"`
+}
+
+// awaitAuth reads the first message on conn and checks its token. On
+// failure the connection is sent a policy violation close frame.
+func (a *Auth) awaitAuth(conn *websocket.Conn) (Role, error) {
+	conn.SetReadDeadline(time.Now().Add(authTimeout))
+	defer conn.SetReadDeadline(time.Time{})
+
+	var m authMessage
+	_, b, err := conn.ReadMessage()
+	if err == nil {
+		err = json.Unmarshal(b, &m)
+	}
+
+	role, ok := RoleNone, false
+	if err == nil && m.Type == "auth" {
+		role, ok = a.lookup(m.Token)
+	}
+	if !ok {
+		conn.WriteControl(
+			websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "authentication required"),
+			time.Now().Add(writeTimeout),
+		)
+		return RoleNone, errBadToken
+	}
+
+	return role, nil
+}
+
+// Require wraps h so only requests presenting a token with at least role
+// get through. With no tokens configured, every request does.
+func (a *Auth) Require(role Role, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if a != nil && len(a.Tokens) > 0 {
+			got, ok := a.lookup(requestToken(r))
+			if !ok {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="xxws"`)
+				http.Error(w, "Invalid token", http.StatusUnauthorized)
+				return
+			}
+			if got < role {
+				http.Error(w, "Insufficient role", http.StatusForbidden)
+				return
+			}
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,131 @@
+package ws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// BatchMode decides how messages queued for a client are put into frames.
+type BatchMode int
+
+const (
+	// BatchNone sends every message in its own frame. This is what a
+	// browser doing JSON.parse(evt.data) expects.
+	BatchNone BatchMode = iota
+	// BatchArray sends a frame holding an array of messages: a JSON array,
+	// or a MessagePack array for the msgpack format.
+	BatchArray
+	// BatchNDJSON sends a frame of newline delimited messages. It is
+	// selected by requesting the "xxws.ndjson" subprotocol.
+	BatchNDJSON
+)
+
+// ParseBatchMode returns the BatchMode named by s: "none" or "array".
+// NDJSON is only available through subprotocol negotiation.
+func ParseBatchMode(s string) (BatchMode, error) {
+	switch s {
+	case "", "none":
+		return BatchNone, nil
+	case "array":
+		return BatchArray, nil
+	}
+
+	return BatchNone, fmt.Errorf("unknown batch mode %q", s)
+}
+
+// awaitBatch holds off writing, for at most MaxLatency, until a full
+// batch is queued. Bursts then go out in fewer, larger frames. Without a
+// MaxBatch, a batch is full when the queue is, since waiting longer would
+// only drop messages.
+func (c *Client) awaitBatch() {
+	if c.batch == BatchNone || c.opts.MaxLatency <= 0 {
+		return
+	}
+
+	full := c.opts.MaxBatch
+	if full <= 0 || full > c.send.size {
+		full = c.send.size
+	}
+
+	timer := time.NewTimer(c.opts.MaxLatency)
+	defer timer.Stop()
+
+	// A closing queue won't grow; flush it straight away.
+	for c.send.len() < full && !c.send.isClosed() {
+		select {
+		case <-c.send.ready:
+		case <-timer.C:
+			return
+		}
+	}
+}
+
+// writeFrame sends m as one websocket message.
+func (c *Client) writeFrame(m frame) error {
+	if m.prepared == nil {
+		return c.conn.WriteMessage(c.codec.frameType, m.data)
+	}
+
+	return c.conn.WritePreparedMessage(m.prepared)
+}
+
+// write sends ms according to the client's batch mode.
+func (c *Client) write(ms []frame) error {
+	if c.batch == BatchNone {
+		for _, m := range ms {
+			// Reuse the frame the hub built once for everyone.
+			if err := c.writeFrame(m); err != nil {
+				log.Println(fmt.Sprintf("Error writing to connection: %v", err))
+				return err
+			}
+			c.addPayload(len(m.data))
+			c.addMessages(1)
+		}
+		return nil
+	}
+
+	if len(ms) == 1 && c.batch == BatchNDJSON {
+		c.addPayload(len(ms[0].data))
+		c.addMessages(1)
+		return c.writeFrame(ms[0])
+	}
+
+	wc, err := c.conn.NextWriter(c.codec.frameType)
+	if err != nil {
+		log.Println(fmt.Sprintf("Error acquiring connection writer: %v", err))
+		return err
+	}
+
+	var open, sep, end []byte
+	switch {
+	case c.batch == BatchNDJSON:
+		sep = lf
+	case c.codec.frameType == websocket.BinaryMessage:
+		// A MessagePack array header followed by the encoded items.
+		open = appendLen(nil, len(ms), 0x90, 0xdc, 0xdd)
+	default:
+		open, sep, end = []byte{'['}, []byte{','}, []byte{']'}
+	}
+
+	n := len(open) + len(end)
+	wc.Write(open)
+	for i, m := range ms {
+		if i > 0 {
+			wc.Write(sep)
+			n += len(sep)
+		}
+		if _, err := wc.Write(m.data); err != nil {
+			log.Println(fmt.Sprintf("Error writing to connection: %v", err))
+			break
+		}
+		n += len(m.data)
+	}
+	wc.Write(end)
+	c.addPayload(n)
+	c.addMessages(len(ms))
+
+	return wc.Close()
+}
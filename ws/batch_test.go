@@ -0,0 +1,41 @@
+package ws
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// With no MaxBatch, a batching client still waits MaxLatency for more
+// messages before writing.
+func TestBatchLatencyOnly(t *testing.T) {
+	o := DefaultOptions
+	o.Batch, o.MaxBatch, o.MaxLatency = BatchArray, 0, 200*time.Millisecond
+
+	h := NewHub()
+	go h.RunLoop()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ServeWSOptions(h, w, r, o)
+	}))
+	defer srv.Close()
+	defer h.Close()
+
+	conn := dial(t, "ws"+strings.TrimPrefix(srv.URL, "http"))
+	waitClients(t, h, 1)
+
+	for _, b := range []string{`1`, `2`, `3`} {
+		h.Broadcast([]byte(b))
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	var got []int
+	if err := json.Unmarshal([]byte(readString(t, conn)), &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 {
+		t.Errorf("got frame %v, want all 3 messages", got)
+	}
+}
@@ -0,0 +1,106 @@
+package ws
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+// The accel topic's share of a sample, the largest payload main.go sends.
+var benchSample = []byte(`{"acceleration":{"x":0.0123,"y":-0.9981,"z":0.0456,` +
+	`"rotation":[-1.2301,0.4517]},"linear":{"x":0.0021,"y":-0.0012,"z":0.0456}}`)
+
+// benchClients connects n clients to a hub serving with o, each reading
+// and discarding messages and reporting every one on got.
+func benchClients(b *testing.B, n int, o Options) (*Hub, <-chan struct{}) {
+	b.Helper()
+
+	// Keep connection logging out of the results.
+	log.SetOutput(io.Discard)
+	b.Cleanup(func() { log.SetOutput(os.Stderr) })
+
+	h := NewHub()
+	go h.RunLoop()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ServeWSOptions(h, w, r, o)
+	}))
+	b.Cleanup(func() {
+		h.Close()
+		srv.Close()
+	})
+
+	got := make(chan struct{}, n)
+	d := websocket.Dialer{EnableCompression: o.Compression}
+	url := "ws" + strings.TrimPrefix(srv.URL, "http")
+	for i := 0; i < n; i++ {
+		conn, _, err := d.Dial(url, nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Cleanup(func() { conn.Close() })
+		go func() {
+			for {
+				_, r, err := conn.NextReader()
+				if err != nil {
+					return
+				}
+				io.Copy(io.Discard, r)
+				got <- struct{}{}
+			}
+		}()
+	}
+	waitClients(b, h, n)
+
+	return h, got
+}
+
+// BenchmarkHubBroadcast measures publishing one sample with Hub.Send until
+// every client has read it, with frames prepared once and shared (as the
+// hub does) and written by each client separately.
+//
+// Compressed, preparing deflates the sample once instead of once per
+// client: on one core it is about 30% faster at 10 and 50 clients. Without
+// compression there is nothing to share but the frame header, while a
+// PreparedMessage costs about 11KB of allocations per sample, so it is
+// slower: twice the time for one client, shrinking to under 10% at 50.
+// The hub prepares anyway because it is a fixed cost of a few
+// microseconds per sample, and compressed fan out, where it pays, is where
+// the Pi runs out of CPU.
+func BenchmarkHubBroadcast(b *testing.B) {
+	for _, compress := range []bool{false, true} {
+		for _, n := range []int{1, 10, 50} {
+			for _, prepare := range []bool{true, false} {
+				name := fmt.Sprintf("compress=%t/clients=%d/prepared=%t", compress, n, prepare)
+				b.Run(name, func(b *testing.B) {
+					o := DefaultOptions
+					o.Compression, o.CompressionLevel = compress, 1
+					h, got := benchClients(b, n, o)
+
+					prepareFrames = prepare
+					defer func() { prepareFrames = true }()
+
+					b.ReportAllocs()
+					b.ResetTimer()
+					for i := 0; i < b.N; i++ {
+						h.Send(Message{
+							Seq:     uint64(i + 1),
+							Topics:  []string{"accel", "sensor:pi"},
+							Sampled: true,
+							Data:    benchSample,
+						})
+						for j := 0; j < n; j++ {
+							<-got
+						}
+					}
+				})
+			}
+		}
+	}
+}
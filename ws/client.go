@@ -1,9 +1,15 @@
 package ws
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -19,18 +25,27 @@ const (
 	// Frequency of outgoing pings. This must be less then pongTimeout.
 	pingInterval = (pongTimeout * 9) / 10
 
-	// Message size limit. We aren't trying to receive messages, send only.
-	incomingMsgLimit = 1
+	// The fastest a client may ask to be pinged.
+	minPingInterval = time.Second
+
+	// Weight of the newest sample in the RTT moving average is 1/rttSmoothing.
+	rttSmoothing = 8
+
+	// Message size limit. Clients send control messages and RPC requests,
+	// the largest being pipeline configs.
+	incomingMsgLimit = 1 << 16
 )
 
 var (
-	lf    = []byte{'\n'}
-	space = []byte{' '}
+	lf = []byte{'\n'}
 )
 
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
 	WriteBufferSize: 1024,
+	Subprotocols:    []string{SubprotocolJSON, SubprotocolNDJSON, SubprotocolMsgpack},
+	// Origins have already been checked by Auth before we upgrade.
+	CheckOrigin: func(*http.Request) bool { return true },
 }
 
 // Client manages a single ws connection.
@@ -38,46 +53,142 @@ type Client struct {
 	// Reference to the Hub.
 	hub *Hub
 
+	// Identity, for the registry; see ClientInfo.
+	id        uint64
+	userAgent string
+	connected time.Time
+
+	// Smoothed round trip time of pings, in nanoseconds. Accessed
+	// atomically.
+	rtt int64
+
 	// The tcp conn.
 	conn *websocket.Conn
 
-	// Buffered channel of outgoing messages.
-	send chan []byte
+	// Bounded queue of outgoing messages; see Policy.
+	send *queue
+
+	// Subscribed topics. Owned by Hub.RunLoop.
+	topics map[string]bool
+
+	// Output rate of sampled streams; nil means every sample.
+	// Owned by Hub.RunLoop.
+	rate *rateLimiter
+
+	// Wire format and batching negotiated at upgrade.
+	codec *codec
+	batch BatchMode
+
+	opts Options
+
+	// Payload and wire byte counts.
+	counters *counters
+
+	// What the client authenticated as.
+	role Role
+
+	// The session the client asked to resume, if any, and the one it
+	// got. session is owned by Hub.RunLoop.
+	resume  *resumeRequest
+	session *session
+
+	// How much history to replay when joining; negative means none and
+	// zero all of it. See Hub.replay.
+	joinHistory time.Duration
+
+	closeOnce sync.Once
+}
+
+// Dropped returns the number of messages discarded for this client
+// because it couldn't keep up.
+func (c *Client) Dropped() uint64 {
+	return c.send.dropped()
 }
 
+// close is called as each of the client's goroutines exits.
 func (c *Client) close() {
-	c.hub.unregister <- c
-	if err := c.conn.Close(); err != nil {
-		log.Println(
-			fmt.Sprintf("Error closing ws connection: %v", err),
-		)
-	}
+	c.hub.remove(c)
+	c.closeConn()
+	c.hub.wg.Done()
+}
+
+// closeConn closes the socket once, unblocking both goroutines.
+func (c *Client) closeConn() {
+	c.closeOnce.Do(func() {
+		if err := c.conn.Close(); err != nil {
+			log.Println(
+				fmt.Sprintf("Error closing ws connection: %v", err),
+			)
+		}
+	})
 }
 
 func (c *Client) handleIncoming() {
 	defer c.close()
 
 	c.conn.SetReadLimit(incomingMsgLimit)
-	c.conn.SetReadDeadline(time.Now().Add(pongTimeout))
-	c.conn.SetPongHandler(func(string) error {
-		c.conn.SetReadDeadline(time.Now().Add(pongTimeout))
+	c.conn.SetReadDeadline(time.Now().Add(c.pongWait()))
+	c.conn.SetPongHandler(func(payload string) error {
+		now := time.Now()
+		c.ponged(payload, now)
+		c.conn.SetReadDeadline(now.Add(c.pongWait()))
 		return nil
 	})
 
-	// We don't want to read any messages. In theory, gorilla/ws should just
-	// discard a message when a new one is received, so resources won't congest.
-	// We still need a blocking loop to keep our defer func from running.
+	// The only thing clients send us are control messages.
 	for {
-		_, _, err := c.conn.ReadMessage()
+		_, b, err := c.conn.ReadMessage()
 		if err != nil {
 			log.Println(fmt.Sprintf("Error receiving message (possible close): %v", err))
 			return
 		}
+
+		c.handleControl(b)
+	}
+}
+
+// push encodes m in the client's wire format and queues it.
+func (c *Client) push(m *message) {
+	f, err := m.encode(c.codec)
+	if err != nil {
+		log.Println(fmt.Sprintf("Error encoding message for %s: %v", c.codec.name, err))
+		return
+	}
+
+	c.send.push(f)
+}
+
+// reply is push for answers to the client's own requests, which the
+// backpressure policy must not drop in favour of newer data.
+func (c *Client) reply(m *message) {
+	f, err := m.encode(c.codec)
+	if err != nil {
+		log.Println(fmt.Sprintf("Error encoding message for %s: %v", c.codec.name, err))
+		return
+	}
+
+	c.send.pushReply(f)
+}
+
+// replySubscriptions tells the client its current topics. It must be
+// called from Hub.RunLoop, which owns the set.
+func (c *Client) replySubscriptions() {
+	topics := make([]string, 0, len(c.topics))
+	for t := range c.topics {
+		topics = append(topics, t)
+	}
+	sort.Strings(topics)
+
+	b, err := json.Marshal(subscriptionReply{Subscriptions: topics})
+	if err != nil {
+		log.Println(fmt.Sprintf("Error serializing subscriptions: %v", err))
+		return
 	}
+	c.reply(&message{data: b, kind: EnvelopeSubscriptions})
 }
 
 func (c *Client) handleOutgoing() {
-	ticker := time.NewTicker(pingInterval)
+	ticker := time.NewTicker(c.pingInterval())
 	defer func() {
 		ticker.Stop()
 		c.close()
@@ -85,60 +196,261 @@ func (c *Client) handleOutgoing() {
 
 	for {
 		select {
-		// watch ok for a channel close
-		case m, ok := <-c.send:
+		case <-c.send.ready:
+			c.awaitBatch()
+			ms, closed := c.send.drain(c.opts.MaxBatch)
 			c.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
-			if !ok {
-				// closed channel, so send a close message
-				c.conn.WriteMessage(websocket.CloseMessage, []byte{})
-				return
-			}
-
-			wc, err := c.conn.NextWriter(websocket.TextMessage)
-			if err != nil {
-				log.Println(fmt.Sprintf("Error acquiring connection writer: %v", err))
-			}
-
-			_, err = wc.Write(m)
-			if err != nil {
-				log.Println(fmt.Sprintf("Error writing to connection: %v", err))
-			}
-
-			// Dequeue send messages buffered on the channel
-			for n := len(c.send); n > 0; n-- {
-				wc.Write(lf)
-				wc.Write(<-c.send)
+			if len(ms) > 0 {
+				if err := c.write(ms); err != nil {
+					// trigger the close logic
+					return
+				}
 			}
 
-			if err := wc.Close(); err != nil {
-				// trigger the close logic
+			if closed && c.send.len() == 0 {
+				// closed and flushed, so send a close message
+				c.conn.WriteMessage(websocket.CloseMessage, c.send.closeMessage())
 				return
 			}
 
 		case <-ticker.C:
-			c.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
-			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+			now := time.Now()
+			c.conn.SetWriteDeadline(now.Add(writeTimeout))
+			if err := c.conn.WriteMessage(websocket.PingMessage, pingPayload(now)); err != nil {
 				return
 			}
 		}
 	}
 }
 
+// pingInterval returns how often the client is pinged.
+func (c *Client) pingInterval() time.Duration {
+	if c.opts.PingInterval <= 0 {
+		return pingInterval
+	}
+
+	return c.opts.PingInterval
+}
+
+// pongWait returns how long to wait for a pong before giving up on the
+// client: a ping interval plus the same slack the default interval gets.
+func (c *Client) pongWait() time.Duration {
+	return c.pingInterval() + pongTimeout - pingInterval
+}
+
+// parsePing reads a ping interval in seconds, which may be no longer
+// than the default.
+func parsePing(s string) (time.Duration, error) {
+	secs, err := strconv.ParseFloat(s, 64)
+	// Written so NaN fails too.
+	if err != nil || !(secs >= minPingInterval.Seconds() && secs <= pingInterval.Seconds()) {
+		return 0, fmt.Errorf("invalid ping %q: must be between %v and %v", s, minPingInterval, pingInterval)
+	}
+
+	return time.Duration(secs * float64(time.Second)), nil
+}
+
+// queryRate reads the "rate" and "rate_mode" query params.
+func queryRate(q url.Values) (*rateLimiter, error) {
+	s := q.Get("rate")
+	if s == "" {
+		return nil, nil
+	}
+
+	hz, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rate %q", s)
+	}
+	mode, err := ParseRateMode(q.Get("rate_mode"))
+	if err != nil {
+		return nil, err
+	}
+
+	return newRateLimiter(hz, mode), nil
+}
+
+// parseHistory reads a history window in seconds; empty or "all" means
+// everything remembered.
+func parseHistory(s string) (time.Duration, error) {
+	if s == "" || s == "all" {
+		return 0, nil
+	}
+
+	secs, err := strconv.ParseFloat(s, 64)
+	if err != nil || secs < 0 {
+		return 0, fmt.Errorf("invalid history %q", s)
+	}
+
+	return time.Duration(secs * float64(time.Second)), nil
+}
+
+// queryTopics reads the "topics" query param, defaulting to TopicAll.
+func queryTopics(q url.Values) map[string]bool {
+	names := parseTopics(q.Get("topics"))
+	if len(names) == 0 {
+		return map[string]bool{TopicAll: true}
+	}
+
+	topics := make(map[string]bool, len(names))
+	for _, t := range names {
+		topics[t] = true
+	}
+
+	return topics
+}
+
 // ServeWS upgrades a connection to ws and handles messaging with the hub.
 // If the connection cannot be upgraded, a non-nil error is returned.
 func ServeWS(h *Hub, w http.ResponseWriter, r *http.Request) error {
-	conn, err := upgrader.Upgrade(w, r, nil)
+	return ServeWSOptions(h, w, r, DefaultOptions)
+}
+
+// ServeWSOptions is ServeWS with explicit queueing options. The wire format
+// is negotiated through Sec-WebSocket-Protocol (see SubprotocolMsgpack and
+// SubprotocolNDJSON); otherwise "batch" picks o.Batch, e.g. /ws?batch=array. A client may
+// pick its own backpressure policy with the "policy" query param, its
+// initial topics with "topics" and its output rate in Hz with "rate" and
+// "rate_mode", e.g. /ws?policy=coalesce-to-latest&topics=accel,gyro&rate=10.
+// Without topics, a client is subscribed to everything. "envelope" wraps
+// every message with its type; see EnvelopeData. "history" replays the
+// last so many seconds (or all, if empty) before the live stream starts;
+// see historyReply. Enveloped clients are issued a session token and may
+// reconnect with "resume" and "last_seq" to be sent what they missed, in
+// place of any "history"; see sessionReply. "ping" pings the client every so many seconds (from 1 up
+// to the default) instead of o.PingInterval, to notice dead mobile links
+// sooner.
+func ServeWSOptions(h *Hub, w http.ResponseWriter, r *http.Request, o Options) error {
+	role, err := o.Auth.authenticate(w, r)
 	if err != nil {
 		return err
 	}
 
+	rate, err := queryRate(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return err
+	}
+	topics := queryTopics(r.URL.Query())
+
+	if name := r.URL.Query().Get("batch"); name != "" {
+		b, err := ParseBatchMode(name)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return err
+		}
+		o.Batch = b
+	}
+
+	joinHistory := time.Duration(-1)
+	if q, ok := r.URL.Query()["history"]; ok {
+		if joinHistory, err = parseHistory(q[0]); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return err
+		}
+	}
+
+	var resume *resumeRequest
+	if token := r.URL.Query().Get("resume"); token != "" {
+		seq, err := strconv.ParseUint(r.URL.Query().Get("last_seq"), 10, 64)
+		if err != nil {
+			http.Error(w, "resume needs a numeric last_seq", http.StatusBadRequest)
+			return err
+		}
+		resume = &resumeRequest{token: token, lastSeq: seq}
+	}
+
+	if q := r.URL.Query().Get("envelope"); q != "" {
+		e, err := strconv.ParseBool(q)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid envelope %q", q), http.StatusBadRequest)
+			return err
+		}
+		o.Envelope = e
+	}
+
+	if q := r.URL.Query().Get("ping"); q != "" {
+		if o.PingInterval, err = parsePing(q); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return err
+		}
+	}
+
+	if name := r.URL.Query().Get("policy"); name != "" {
+		p, err := ParsePolicy(name)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return err
+		}
+		o.Policy = p
+	}
+
+	// Count what goes out on the socket, to compare against payload bytes.
+	cnt := &counters{}
+	cw := &countingResponseWriter{ResponseWriter: w, client: cnt, hub: &h.counters}
+
+	u := upgrader
+	u.EnableCompression = o.Compression
+	if h.name != "" {
+		// Accept the subprotocol a client picked the room with.
+		u.Subprotocols = append(append([]string(nil), upgrader.Subprotocols...), SubprotocolRoomPrefix+h.name)
+	}
+	conn, err := u.Upgrade(cw, r, nil)
+	if err != nil {
+		return err
+	}
+
+	if o.Compression {
+		if err := conn.SetCompressionLevel(o.CompressionLevel); err != nil {
+			conn.Close()
+			return err
+		}
+	}
+
+	if role == RoleNone {
+		// No token in the handshake; it must be the first message.
+		if role, err = o.Auth.awaitAuth(conn); err != nil {
+			conn.Close()
+			return err
+		}
+	}
+
 	client := &Client{
-		hub:  h,
-		conn: conn,
-		send: make(chan []byte, 1<<4),
+		id:        atomic.AddUint64(&h.nextID, 1),
+		userAgent: r.UserAgent(),
+		connected: time.Now(),
+
+		hub:    h,
+		conn:   conn,
+		send:   newQueue(o.QueueSize, o.Policy, o.MaxDrops),
+		topics: topics,
+		rate:   rate,
+		codec:  codecFor(conn.Subprotocol(), o.Envelope),
+		batch:  o.Batch,
+		opts:   o,
+
+		counters: cnt,
+		role:     role,
+
+		resume:      resume,
+		joinHistory: joinHistory,
+	}
+	if conn.Subprotocol() == SubprotocolNDJSON {
+		client.batch = BatchNDJSON
 	}
 
-	h.register <- client
+	// Counted before the hand off, so a goroutine exiting early can never
+	// take the group below zero.
+	if !h.track(2) {
+		conn.WriteMessage(websocket.CloseMessage, closeMessage(websocket.CloseGoingAway, h.closeReason))
+		conn.Close()
+		return ErrHubClosed
+	}
+	if err := h.add(client); err != nil {
+		h.wg.Add(-2)
+		conn.WriteMessage(websocket.CloseMessage, closeMessage(websocket.CloseGoingAway, h.closeReason))
+		conn.Close()
+		return err
+	}
 
 	go client.handleIncoming()
 	go client.handleOutgoing()
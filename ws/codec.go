@@ -0,0 +1,116 @@
+package ws
+
+import (
+	"github.com/gorilla/websocket"
+)
+
+// Subprotocols a client may request via Sec-WebSocket-Protocol. Clients
+// that don't ask for one get JSON.
+const (
+	SubprotocolJSON    = "xxws.json"
+	SubprotocolNDJSON  = "xxws.ndjson"
+	SubprotocolMsgpack = "xxws.msgpack"
+)
+
+// codec is a wire format for outgoing messages.
+type codec struct {
+	name string
+
+	// websocket.TextMessage or websocket.BinaryMessage.
+	frameType int
+
+	// Encodes a decoded JSON document. Nil sends the published JSON as is.
+	encode func(v interface{}) ([]byte, error)
+
+	// Wrap every message in an envelope; see EnvelopeData.
+	envelope bool
+}
+
+var (
+	jsonCodec = &codec{
+		name:      SubprotocolJSON,
+		frameType: websocket.TextMessage,
+	}
+
+	msgpackCodec = &codec{
+		name:      SubprotocolMsgpack,
+		frameType: websocket.BinaryMessage,
+		encode:    EncodeMsgpack,
+	}
+
+	codecs = map[string]*codec{
+		SubprotocolJSON:    jsonCodec,
+		SubprotocolNDJSON:  jsonCodec,
+		SubprotocolMsgpack: msgpackCodec,
+	}
+
+	// The same formats with every message enveloped.
+	envelopeCodecs = map[*codec]*codec{
+		jsonCodec:    {name: jsonCodec.name, frameType: jsonCodec.frameType, envelope: true},
+		msgpackCodec: {name: msgpackCodec.name, frameType: msgpackCodec.frameType, encode: EncodeMsgpack, envelope: true},
+	}
+)
+
+// codecFor returns the codec for a negotiated subprotocol.
+func codecFor(subprotocol string, envelope bool) *codec {
+	c, ok := codecs[subprotocol]
+	if !ok {
+		c = jsonCodec
+	}
+	if envelope {
+		c = envelopeCodecs[c]
+	}
+
+	return c
+}
+
+// prepareFrames is turned off by BenchmarkHubBroadcast to measure what
+// sharing a PreparedMessage saves over writing each client's frame.
+var prepareFrames = true
+
+// encode returns m in the given wire format, encoding and framing it at
+// most once per codec no matter how many clients want it. Must be called
+// from RunLoop.
+func (m *message) encode(c *codec) (frame, error) {
+	if f, ok := m.encoded[c]; ok {
+		return f, nil
+	}
+
+	b := m.data
+	switch {
+	case c.encode != nil:
+		v, err := m.decode()
+		if err != nil {
+			return frame{}, err
+		}
+		if c.envelope {
+			v = m.envelopeDoc(v)
+		}
+		if b, err = c.encode(v); err != nil {
+			return frame{}, err
+		}
+	case c.envelope:
+		var err error
+		if b, err = m.envelopeJSON(); err != nil {
+			return frame{}, err
+		}
+	}
+
+	// PreparedMessage frames (and compresses) the payload once and lets
+	// every connection share the result.
+	f := frame{data: b}
+	if prepareFrames {
+		pm, err := websocket.NewPreparedMessage(c.frameType, b)
+		if err != nil {
+			return frame{}, err
+		}
+		f.prepared = pm
+	}
+
+	if m.encoded == nil {
+		m.encoded = make(map[*codec]frame)
+	}
+	m.encoded[c] = f
+
+	return f, nil
+}
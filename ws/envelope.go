@@ -0,0 +1,69 @@
+package ws
+
+import (
+	"encoding/json"
+)
+
+// Envelope types. Clients that connect with ?envelope=true get every
+// message wrapped so broadcasts and replies can share the connection:
+//
+//	{"type": "data", "seq": 1042, "topics": ["accel", "sensor:pi"], "data": {...}}
+//	{"type": "subscriptions", "data": {"subscriptions": ["accel"]}}
+//	{"type": "rpc", "data": {"jsonrpc": "2.0", "id": 1, "result": {...}}}
+//	{"type": "history", "data": {"history": {...}}}
+//	{"type": "session", "data": {"session": {...}}}
+//	{"type": "gap", "data": {"gap": {"from": 1043, "to": 1210}}}
+const (
+	EnvelopeData          = "data"
+	EnvelopeSubscriptions = "subscriptions"
+	EnvelopeRPC           = "rpc"
+	EnvelopeHistory       = "history"
+	EnvelopeSession       = "session"
+	EnvelopeGap           = "gap"
+)
+
+type envelope struct {
+	Type   string          `json:"type"`
+	Seq    uint64          `json:"seq,omitempty"`
+	Topics []string        `json:"topics,omitempty"`
+	Data   json.RawMessage `json:"data"`
+}
+
+// kindOrData returns the envelope type of m.
+func (m *message) kindOrData() string {
+	if m.kind == "" {
+		return EnvelopeData
+	}
+
+	return m.kind
+}
+
+// envelopeJSON wraps the published JSON without decoding it.
+func (m *message) envelopeJSON() ([]byte, error) {
+	return json.Marshal(envelope{
+		Type:   m.kindOrData(),
+		Seq:    m.seq,
+		Topics: m.topics,
+		Data:   m.data,
+	})
+}
+
+// envelopeDoc wraps a decoded document, for codecs that re-encode.
+func (m *message) envelopeDoc(v interface{}) map[string]interface{} {
+	doc := map[string]interface{}{
+		"type": m.kindOrData(),
+		"data": v,
+	}
+	if m.seq != 0 {
+		doc["seq"] = m.seq
+	}
+	if len(m.topics) > 0 {
+		topics := make([]interface{}, len(m.topics))
+		for i, t := range m.topics {
+			topics[i] = t
+		}
+		doc["topics"] = topics
+	}
+
+	return doc
+}
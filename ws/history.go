@@ -0,0 +1,173 @@
+package ws
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+)
+
+const (
+	// DefaultHistoryDepth is how much history a Hub keeps unless told
+	// otherwise with SetHistory.
+	DefaultHistoryDepth = 10 * time.Second
+
+	// Upper bound on remembered messages, whatever the depth.
+	maxHistory = 1 << 15
+)
+
+type historyEntry struct {
+	at time.Time
+	m  *message
+}
+
+// historyReply is a client's share of the history, sent as one message
+// before any live message published after it:
+//
+//	{"history": {"from": 1200, "to": 2399, "messages": [
+//	  {"seq": 1200, "topics": ["accel", "sensor:pi"], "data": {...}},
+//	  ...
+//	]}}
+//
+// Live messages continue after seq "to", which is the newest message the
+// hub had published whether or not this client wanted it.
+type historyReply struct {
+	History historyBatch `json:"history"`
+}
+
+type historyBatch struct {
+	From     uint64             `json:"from"`
+	To       uint64             `json:"to"`
+	Messages []historyEntryJSON `json:"messages"`
+}
+
+type historyEntryJSON struct {
+	Seq    uint64          `json:"seq"`
+	Topics []string        `json:"topics,omitempty"`
+	Data   json.RawMessage `json:"data"`
+}
+
+// SetHistory changes how far back the hub remembers sequenced messages,
+// for replay to new clients and for resuming streams. Zero disables the
+// history. RunLoop must be running.
+func (h *Hub) SetHistory(depth time.Duration) {
+	h.do(func() {
+		h.historyDepth = depth
+		h.trimHistory(time.Now())
+	})
+}
+
+// remember keeps m for later replay, if it is sequenced.
+func (h *Hub) remember(m *message, now time.Time) {
+	if m.seq == 0 || h.historyDepth <= 0 {
+		return
+	}
+
+	h.history = append(h.history, historyEntry{at: now, m: m})
+	h.trimHistory(now)
+}
+
+// trimHistory forgets messages older than the depth.
+func (h *Hub) trimHistory(now time.Time) {
+	cutoff := now.Add(-h.historyDepth)
+
+	i := 0
+	for i < len(h.history) && (len(h.history)-i > maxHistory || h.history[i].at.Before(cutoff)) {
+		// Drop the reference so the message can be collected.
+		h.history[i] = historyEntry{}
+		i++
+	}
+	h.history = h.history[i:]
+}
+
+// since returns the remembered messages after seq, oldest first.
+// Publishers may interleave slightly out of order, so this filters rather
+// than searching.
+func (h *Hub) since(seq uint64) []historyEntry {
+	var out []historyEntry
+	for _, e := range h.history {
+		if e.m.seq > seq {
+			out = append(out, e)
+		}
+	}
+
+	return out
+}
+
+// missed returns the remembered messages after seq, oldest first, and the
+// range of those after seq that have already been forgotten, if any.
+func (h *Hub) missed(seq uint64) ([]historyEntry, *gapRange) {
+	es := h.since(seq)
+
+	oldest := h.lastSeq + 1
+	for _, e := range es {
+		if e.m.seq < oldest {
+			oldest = e.m.seq
+		}
+	}
+	if seq+1 >= oldest {
+		return es, nil
+	}
+
+	return es, &gapRange{From: seq + 1, To: oldest - 1}
+}
+
+// replay queues the last window of history for c, or all of it if window
+// is zero, as one message. Decimating clients get it at their rate. It
+// must be called from RunLoop.
+func (h *Hub) replay(c *Client, window time.Duration) {
+	var (
+		ms   []*message
+		from time.Time
+		next time.Time
+	)
+	if window > 0 {
+		from = time.Now().Add(-window)
+	}
+	for _, e := range h.history {
+		if e.at.Before(from) || !wants(c.topics, e.m) {
+			continue
+		}
+		if c.rate != nil && e.m.sampled {
+			if e.at.Before(next) {
+				continue
+			}
+			next = e.at.Add(c.rate.interval)
+		}
+		ms = append(ms, e.m)
+	}
+
+	h.replyHistory(c, ms)
+}
+
+// replyHistory sends ms to c as one history reply, which the backpressure
+// policy never drops. It must be called from RunLoop.
+func (h *Hub) replyHistory(c *Client, ms []*message) {
+	batch := historyBatch{To: h.lastSeq, Messages: make([]historyEntryJSON, 0, len(ms))}
+	for _, m := range ms {
+		if batch.From == 0 || m.seq < batch.From {
+			batch.From = m.seq
+		}
+		batch.Messages = append(batch.Messages, historyEntryJSON{
+			Seq:    m.seq,
+			Topics: m.topics,
+			Data:   m.data,
+		})
+	}
+
+	b, err := json.Marshal(historyReply{History: batch})
+	if err != nil {
+		log.Println(fmt.Sprintf("Error serializing history: %v", err))
+		return
+	}
+	c.reply(&message{data: b, kind: EnvelopeHistory})
+}
+
+// requestHistory asks the run loop to replay history to c.
+func (h *Hub) requestHistory(c *Client, window time.Duration) {
+	h.do(func() {
+		if h.clients[c] {
+			h.replay(c, window)
+		}
+	})
+}
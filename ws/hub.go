@@ -1,15 +1,61 @@
 package ws
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
 )
 
+// ErrHubClosed is returned when registering with a Hub that has shut down.
+var ErrHubClosed = errors.New("ws: hub closed")
+
+// Message is a payload published to the Hub.
+type Message struct {
+	// Sequence number of the sample the message belongs to, used by
+	// clients to resume. Zero means the message isn't sequenced.
+	Seq uint64
+
+	// Topics the message belongs to; none means every client gets it.
+	Topics []string
+
+	// Part of a periodic stream that clients may thin out to a lower
+	// rate. One-off messages such as events must not set this.
+	Sampled bool
+
+	// A JSON document.
+	Data []byte
+}
+
 // Hub manages client registration and plumbing messages to/from clients.
+// The client set is owned by RunLoop; every other method talks to it over
+// channels, so there is no shared state to race on.
 type Hub struct {
-	// Registered clients
+	// The room the hub serves, if it belongs to Rooms.
+	name string
+
+	// Registered clients. Only touched from RunLoop.
 	clients map[*Client]bool
 
+	// Server-sent event streams. Only touched from RunLoop.
+	streams map[*sseClient]bool
+
+	// The most recent sequenced messages, oldest first, and how far back
+	// they go. Only touched from RunLoop.
+	history      []historyEntry
+	historyDepth time.Duration
+
+	// Newest sequence number published. Only touched from RunLoop.
+	lastSeq uint64
+
+	// Sessions clients may resume, by token. Only touched from RunLoop.
+	sessions map[string]*session
+
 	// An unbuffered channel of requests to register.
 	// No buffer ensures clients are registered before message handling starts.
 	register chan *Client
@@ -17,53 +63,272 @@ type Hub struct {
 	// Requests to unregister
 	unregister chan *Client
 
-	done chan struct{}
+	// Messages to fan out to interested clients.
+	broadcast chan message
+
+	// Work that must run on RunLoop because it touches client state.
+	ops chan func()
+
+	// Closed by Close to ask RunLoop to shut down, after closeReason is set.
+	closing     chan struct{}
+	closeOnce   sync.Once
+	closeReason string
+
+	// Closed by RunLoop once every client has been told to go away.
+	stopped chan struct{}
+
+	// The clients still connected at shutdown, set by RunLoop before
+	// stopped is closed.
+	remaining []*Client
+
+	// Tracks client goroutines so Close can wait for them to exit. Once
+	// wgClosed is set nothing more is added, so Wait can't race with Add.
+	wg       sync.WaitGroup
+	wgMu     sync.Mutex
+	wgClosed bool
+
+	// Byte totals across all clients.
+	counters counters
+
+	// Last client ID handed out. Accessed atomically.
+	nextID uint64
+
+	// JSON-RPC methods; see HandleRPC.
+	methodsMu sync.RWMutex
+	methods   map[string]rpcMethod
 }
 
 // NewHub returns a Hub.
 func NewHub() *Hub {
 	return &Hub{
 		clients:    make(map[*Client]bool),
+		streams:    make(map[*sseClient]bool),
+		sessions:   make(map[string]*session),
 		register:   make(chan *Client),
 		unregister: make(chan *Client),
-		done:       make(chan struct{}),
+		broadcast:  make(chan message, 1<<6),
+		ops:        make(chan func()),
+		closing:    make(chan struct{}),
+		stopped:    make(chan struct{}),
+
+		historyDepth: DefaultHistoryDepth,
 	}
 }
 
-// RunLoop registers/unregisters clients.
+// Name returns the name of the room the hub serves, or "" if it was
+// created on its own with NewHub.
+func (h *Hub) Name() string {
+	return h.name
+}
+
+// RunLoop registers/unregisters clients and fans out broadcasts.
+// It returns once Close has been called.
 func (h *Hub) RunLoop() {
+	defer close(h.stopped)
+
 	for {
 		select {
 		case client := <-h.register:
 			h.clients[client] = true
+			resumed := false
+			if client.codec.envelope {
+				// Only enveloped clients see sequence numbers, so only
+				// they can resume.
+				resumed = h.attach(client)
+			}
+			// A resumed client was just sent what it missed instead.
+			if client.joinHistory >= 0 && !resumed {
+				h.replay(client, client.joinHistory)
+			}
 		case client := <-h.unregister:
 			if _, ok := h.clients[client]; ok {
-				delete(h.clients, client)
-				close(client.send)
+				h.forget(client, websocket.CloseNormalClosure, "")
+			}
+		case m := <-h.broadcast:
+			now := time.Now()
+			if m.seq > h.lastSeq {
+				h.lastSeq = m.seq
+			}
+			for client := range h.clients {
+				if !wants(client.topics, &m) {
+					continue
+				}
+				if out, ok := client.rate.admit(&m, now); ok {
+					client.push(out)
+				}
+			}
+			for st := range h.streams {
+				if !wants(st.topics, &m) {
+					continue
+				}
+				if out, ok := st.rate.admit(&m, now); ok {
+					st.push(out)
+				}
+			}
+			h.remember(&m, now)
+		case op := <-h.ops:
+			op()
+		case <-h.closing:
+			log.Println(fmt.Sprintf("Closing %d connections...", len(h.clients)))
+			for client := range h.clients {
+				h.remaining = append(h.remaining, client)
+				h.forget(client, websocket.CloseGoingAway, h.closeReason)
+			}
+			for st := range h.streams {
+				delete(h.streams, st)
+				st.send.close(websocket.CloseGoingAway, h.closeReason)
 			}
-		case <-h.done:
 			return
 		}
 	}
 }
 
-// Broadcast emits the message on all registered clients.
+// forget drops c from the client set and closes its queue, keeping its
+// drop count in the hub's totals. It must be called from RunLoop.
+func (h *Hub) forget(c *Client, code int, reason string) {
+	delete(h.clients, c)
+	h.detach(c)
+	c.send.close(code, reason)
+	atomic.AddUint64(&h.counters.dropped, c.Dropped())
+}
+
+// Broadcast emits the message on all registered clients. It never waits on
+// a client: one that can't keep up has messages dropped according to its
+// Policy. Messages broadcast after Close are discarded.
 func (h *Hub) Broadcast(b []byte) {
-	for client := range h.clients {
-		client.send <- b
+	h.Publish(b)
+}
+
+// Publish emits the message on clients subscribed to any of the topics,
+// or to every client when no topics are given. Like Broadcast, it never
+// waits on a client.
+func (h *Hub) Publish(b []byte, topics ...string) {
+	h.Send(Message{Topics: topics, Data: b})
+}
+
+// PublishSample is Publish for periodic sample data. Clients that asked for
+// a lower rate get these decimated or averaged; one-off messages such as
+// events should use Publish so they are never thinned out.
+func (h *Hub) PublishSample(b []byte, topics ...string) {
+	h.Send(Message{Topics: topics, Sampled: true, Data: b})
+}
+
+// Send publishes m. Like Broadcast, it never waits on a client.
+func (h *Hub) Send(m Message) {
+	h.send(message{data: m.Data, topics: m.Topics, seq: m.Seq, sampled: m.Sampled})
+}
+
+func (h *Hub) send(m message) {
+	select {
+	case h.broadcast <- m:
+	case <-h.closing:
 	}
 }
 
-// Close unregisters all connected clients.
-func (h *Hub) Close() error {
-	log.Println(fmt.Sprintf("Closing %d connections...", len(h.clients)))
-	for client := range h.clients {
-		h.unregister <- client
+// do runs f on RunLoop, returning false if the hub is shutting down.
+func (h *Hub) do(f func()) bool {
+	select {
+	case h.ops <- f:
+		return true
+	case <-h.closing:
+		return false
+	}
+}
+
+// subscribe asks the run loop to add or remove topics for c.
+func (h *Hub) subscribe(c *Client, topics []string, add bool) {
+	h.do(func() {
+		if !h.clients[c] {
+			return
+		}
+		for _, t := range topics {
+			if add {
+				c.topics[t] = true
+			} else {
+				delete(c.topics, t)
+			}
+		}
+		c.replySubscriptions()
+	})
+}
+
+// setRate changes the output rate of c's sampled streams.
+func (h *Hub) setRate(c *Client, rate float64, mode RateMode) {
+	h.do(func() {
+		c.rate = newRateLimiter(rate, mode)
+	})
+}
+
+// add registers c with the run loop, failing if the hub is shutting down.
+func (h *Hub) add(c *Client) error {
+	select {
+	case h.register <- c:
+		return nil
+	case <-h.closing:
+		return ErrHubClosed
 	}
+}
 
-	close(h.done)
+// remove unregisters c. It is a no-op once the hub is shutting down,
+// since the run loop has already let go of every client.
+func (h *Hub) remove(c *Client) {
+	select {
+	case h.unregister <- c:
+	case <-h.closing:
+	}
+}
 
-	log.Println("Connections closed.")
+// track counts n client goroutines about to start, reporting false once
+// Shutdown has begun waiting for them.
+func (h *Hub) track(n int) bool {
+	h.wgMu.Lock()
+	defer h.wgMu.Unlock()
 
-	return nil
+	if h.wgClosed {
+		return false
+	}
+	h.wg.Add(n)
+	return true
+}
+
+// Close unregisters all connected clients and blocks until every client
+// goroutine has sent its close frame and exited. RunLoop must be running.
+func (h *Hub) Close() error {
+	return h.Shutdown(context.Background(), "")
+}
+
+// Shutdown sends every client a 1001 (going away) close frame with reason
+// once its queued messages are written, and waits for the clients to go.
+// New connections are refused. Clients still connected when ctx is done
+// are cut off and ctx's error is returned. RunLoop must be running.
+func (h *Hub) Shutdown(ctx context.Context, reason string) error {
+	h.closeOnce.Do(func() {
+		h.closeReason = reason
+		close(h.closing)
+	})
+
+	<-h.stopped
+
+	h.wgMu.Lock()
+	h.wgClosed = true
+	h.wgMu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		h.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		log.Println("Connections closed.")
+		return nil
+	case <-ctx.Done():
+		for _, c := range h.remaining {
+			c.closeConn()
+		}
+		<-done
+		log.Println("Connections closed (timed out).")
+		return ctx.Err()
+	}
 }
@@ -0,0 +1,205 @@
+package ws
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// startHub runs a hub behind a test server, returning its ws:// URL.
+func startHub(t *testing.T) (*Hub, string) {
+	t.Helper()
+
+	h := NewHub()
+	go h.RunLoop()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ServeWS(h, w, r)
+	}))
+	t.Cleanup(func() {
+		h.Close()
+		srv.Close()
+	})
+
+	return h, "ws" + strings.TrimPrefix(srv.URL, "http")
+}
+
+func dial(t *testing.T, url string) *websocket.Conn {
+	t.Helper()
+
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatalf("dial %s: %v", url, err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+// waitClients waits for the hub to have n clients registered.
+func waitClients(t testing.TB, h *Hub, n int) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(h.Clients()) != n {
+		if time.Now().After(deadline) {
+			t.Fatalf("got %d clients, want %d", len(h.Clients()), n)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func readString(t *testing.T, conn *websocket.Conn) string {
+	t.Helper()
+
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, b, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+
+	return string(b)
+}
+
+func TestHubBroadcast(t *testing.T) {
+	h, url := startHub(t)
+	all := dial(t, url)
+	accel := dial(t, url+"?topics=accel")
+	waitClients(t, h, 2)
+
+	h.Publish([]byte(`{"gyro":1}`), "gyro")
+	h.Publish([]byte(`{"accel":1}`), "accel")
+
+	if got := readString(t, all); got != `{"gyro":1}` {
+		t.Errorf("all got %s, want the gyro message first", got)
+	}
+	if got := readString(t, all); got != `{"accel":1}` {
+		t.Errorf("all got %s, want the accel message", got)
+	}
+	if got := readString(t, accel); got != `{"accel":1}` {
+		t.Errorf("accel got %s, want only the accel message", got)
+	}
+}
+
+func TestHubUnregister(t *testing.T) {
+	h, url := startHub(t)
+	conn := dial(t, url)
+	dial(t, url)
+	waitClients(t, h, 2)
+
+	conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	conn.Close()
+	waitClients(t, h, 1)
+
+	// The hub keeps serving whoever is left.
+	h.Broadcast([]byte(`{}`))
+	waitClients(t, h, 1)
+}
+
+func TestHubShutdown(t *testing.T) {
+	h, url := startHub(t)
+	conns := []*websocket.Conn{dial(t, url), dial(t, url)}
+	waitClients(t, h, 2)
+
+	h.Broadcast([]byte(`"last"`))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := h.Shutdown(ctx, "bye"); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+
+	for i, conn := range conns {
+		// Queued messages are flushed before the close frame.
+		if got := readString(t, conn); got != `"last"` {
+			t.Errorf("client %d got %s before closing, want \"last\"", i, got)
+		}
+		_, _, err := conn.ReadMessage()
+		if !websocket.IsCloseError(err, websocket.CloseGoingAway) {
+			t.Errorf("client %d got %v, want a going away close", i, err)
+		} else if ce := err.(*websocket.CloseError); ce.Text != "bye" {
+			t.Errorf("client %d got close reason %q, want \"bye\"", i, ce.Text)
+		}
+	}
+
+	// Latecomers are turned away.
+	conn := dial(t, url)
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if _, _, err := conn.ReadMessage(); !websocket.IsCloseError(err, websocket.CloseGoingAway) {
+		t.Errorf("client after shutdown got %v, want a going away close", err)
+	}
+	if h.Clients() != nil {
+		t.Error("Clients after shutdown is not nil")
+	}
+}
+
+// Clients connecting and leaving while the hub shuts down must neither
+// leak goroutines nor unbalance the wait group.
+func TestHubShutdownDuringRegister(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		h, url := startHub(t)
+
+		var wg sync.WaitGroup
+		for j := 0; j < 8; j++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+				if err != nil {
+					return
+				}
+				conn.Close()
+			}()
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := h.Shutdown(ctx, ""); err != nil {
+			t.Fatalf("Shutdown: %v", err)
+		}
+		cancel()
+		wg.Wait()
+	}
+}
+
+// Clients dialing without pause while the hub shuts down must never have
+// their goroutines counted after Shutdown starts waiting.
+func TestHubShutdownWhileDialing(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		h, url := startHub(t)
+
+		stop := make(chan struct{})
+		var wg sync.WaitGroup
+		for j := 0; j < 8; j++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					select {
+					case <-stop:
+						return
+					default:
+					}
+					conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+					if err == nil {
+						conn.Close()
+					}
+				}
+			}()
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		err := h.Shutdown(ctx, "")
+		cancel()
+		close(stop)
+		wg.Wait()
+		if err != nil {
+			t.Fatalf("Shutdown: %v", err)
+		}
+	}
+}
@@ -0,0 +1,338 @@
+package ws
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// MessagePack wire format, negotiated with the "xxws.msgpack" subprotocol.
+//
+// Each websocket binary frame carries one or more MessagePack objects back
+// to back. An object is the same document the JSON format would send, so
+// {"gyro":{"x":1.5,"y":0,"z":-2}} becomes a map of one key holding a map
+// of three keys. To keep frames small:
+//
+//   - whole numbers, such as sequence numbers and RPC ids, are encoded as
+//     integers in as few bytes as fit;
+//   - other numbers are encoded as float32 (0xca), since sensor values
+//     don't carry more precision than that;
+//   - map keys are written in sorted order.
+//
+// Spec: https://github.com/msgpack/msgpack/blob/master/spec.md
+
+// How deep arrays and maps may nest in a decoded object, so untrusted
+// input can't exhaust the stack.
+const maxMsgpackDepth = 1 << 6
+
+var (
+	// ErrMsgpackShort is returned when a buffer ends inside an object.
+	ErrMsgpackShort = errors.New("ws: msgpack: unexpected end of data")
+
+	// ErrMsgpackDepth is returned when arrays and maps nest deeper than
+	// DecodeMsgpack allows.
+	ErrMsgpackDepth = errors.New("ws: msgpack: nested too deep")
+)
+
+// EncodeMsgpack encodes v, which must be built from the types encoding/json
+// decodes into (nil, bool, float64, string, []interface{} and
+// map[string]interface{}). Go integer types are accepted too.
+func EncodeMsgpack(v interface{}) ([]byte, error) {
+	return appendMsgpack(nil, v)
+}
+
+func appendMsgpack(b []byte, v interface{}) ([]byte, error) {
+	switch t := v.(type) {
+	case nil:
+		return append(b, 0xc0), nil
+	case bool:
+		if t {
+			return append(b, 0xc3), nil
+		}
+		return append(b, 0xc2), nil
+	case float64:
+		// float32 would round sequence numbers past 2^24.
+		if t == math.Trunc(t) && t >= math.MinInt64 && t < math.MaxInt64 {
+			return appendInt(b, int64(t)), nil
+		}
+		return appendFloat32(b, float32(t)), nil
+	case float32:
+		return appendFloat32(b, t), nil
+	case int:
+		return appendInt(b, int64(t)), nil
+	case int64:
+		return appendInt(b, t), nil
+	case uint64:
+		if t > math.MaxInt64 {
+			b = append(b, 0xcf)
+			return binary.BigEndian.AppendUint64(b, t), nil
+		}
+		return appendInt(b, int64(t)), nil
+	case string:
+		return appendString(b, t), nil
+	case []interface{}:
+		b = appendLen(b, len(t), 0x90, 0xdc, 0xdd)
+		for _, x := range t {
+			var err error
+			if b, err = appendMsgpack(b, x); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	case map[string]interface{}:
+		keys := make([]string, 0, len(t))
+		for k := range t {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		b = appendLen(b, len(t), 0x80, 0xde, 0xdf)
+		for _, k := range keys {
+			b = appendString(b, k)
+			var err error
+			if b, err = appendMsgpack(b, t[k]); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	}
+
+	return nil, fmt.Errorf("ws: msgpack: unsupported type %T", v)
+}
+
+func appendFloat32(b []byte, f float32) []byte {
+	b = append(b, 0xca)
+	return binary.BigEndian.AppendUint32(b, math.Float32bits(f))
+}
+
+func appendInt(b []byte, i int64) []byte {
+	switch {
+	case i >= 0 && i < 128:
+		return append(b, byte(i))
+	case i < 0 && i >= -32:
+		return append(b, byte(i))
+	case i >= math.MinInt16 && i <= math.MaxInt16:
+		b = append(b, 0xd1)
+		return binary.BigEndian.AppendUint16(b, uint16(i))
+	case i >= math.MinInt32 && i <= math.MaxInt32:
+		b = append(b, 0xd2)
+		return binary.BigEndian.AppendUint32(b, uint32(i))
+	}
+
+	b = append(b, 0xd3)
+	return binary.BigEndian.AppendUint64(b, uint64(i))
+}
+
+func appendString(b []byte, s string) []byte {
+	switch n := len(s); {
+	case n < 32:
+		b = append(b, 0xa0|byte(n))
+	case n <= math.MaxUint8:
+		b = append(b, 0xd9, byte(n))
+	case n <= math.MaxUint16:
+		b = append(b, 0xda)
+		b = binary.BigEndian.AppendUint16(b, uint16(n))
+	default:
+		b = append(b, 0xdb)
+		b = binary.BigEndian.AppendUint32(b, uint32(n))
+	}
+
+	return append(b, s...)
+}
+
+// appendLen writes an array or map header: fix, 16 or 32 bit.
+func appendLen(b []byte, n int, fix, b16, b32 byte) []byte {
+	switch {
+	case n < 16:
+		return append(b, fix|byte(n))
+	case n <= math.MaxUint16:
+		b = append(b, b16)
+		return binary.BigEndian.AppendUint16(b, uint16(n))
+	}
+
+	b = append(b, b32)
+	return binary.BigEndian.AppendUint32(b, uint32(n))
+}
+
+// DecodeMsgpack decodes the first object in b and returns it along with
+// the remaining bytes, so a multi-object frame can be read in a loop.
+// Numbers decode as float64, maps as map[string]interface{}. Arrays and
+// maps may nest 64 deep.
+func DecodeMsgpack(b []byte) (interface{}, []byte, error) {
+	d := msgpackDecoder{b: b}
+	v, err := d.value()
+	if err != nil {
+		return nil, b, err
+	}
+
+	return v, d.b, nil
+}
+
+type msgpackDecoder struct {
+	b []byte
+
+	// Arrays and maps currently open.
+	depth int
+}
+
+func (d *msgpackDecoder) take(n int) ([]byte, error) {
+	if len(d.b) < n {
+		return nil, ErrMsgpackShort
+	}
+	p := d.b[:n]
+	d.b = d.b[n:]
+
+	return p, nil
+}
+
+func (d *msgpackDecoder) uint(n int) (uint64, error) {
+	p, err := d.take(n)
+	if err != nil {
+		return 0, err
+	}
+
+	var u uint64
+	for _, c := range p {
+		u = u<<8 | uint64(c)
+	}
+
+	return u, nil
+}
+
+func (d *msgpackDecoder) value() (interface{}, error) {
+	p, err := d.take(1)
+	if err != nil {
+		return nil, err
+	}
+
+	switch c := p[0]; {
+	case c <= 0x7f:
+		return float64(c), nil
+	case c >= 0xe0:
+		return float64(int8(c)), nil
+	case c&0xe0 == 0xa0:
+		return d.str(int(c & 0x1f))
+	case c&0xf0 == 0x90:
+		return d.array(int(c & 0x0f))
+	case c&0xf0 == 0x80:
+		return d.object(int(c & 0x0f))
+	}
+
+	switch c := p[0]; c {
+	case 0xc0:
+		return nil, nil
+	case 0xc2:
+		return false, nil
+	case 0xc3:
+		return true, nil
+	case 0xca:
+		u, err := d.uint(4)
+		return float64(math.Float32frombits(uint32(u))), err
+	case 0xcb:
+		u, err := d.uint(8)
+		return math.Float64frombits(u), err
+	case 0xcc, 0xcd, 0xce, 0xcf:
+		u, err := d.uint(1 << (c - 0xcc))
+		return float64(u), err
+	case 0xd0, 0xd1, 0xd2, 0xd3:
+		n := 1 << (c - 0xd0)
+		u, err := d.uint(n)
+		// Sign extend from n bytes.
+		shift := uint(64 - 8*n)
+		return float64(int64(u<<shift) >> shift), err
+	case 0xd9, 0xda, 0xdb:
+		n, err := d.uint(1 << (c - 0xd9))
+		if err != nil {
+			return nil, err
+		}
+		return d.str(int(n))
+	case 0xdc, 0xdd:
+		n, err := d.uint(2 << (c - 0xdc))
+		if err != nil {
+			return nil, err
+		}
+		return d.array(int(n))
+	case 0xde, 0xdf:
+		n, err := d.uint(2 << (c - 0xde))
+		if err != nil {
+			return nil, err
+		}
+		return d.object(int(n))
+	}
+
+	return nil, fmt.Errorf("ws: msgpack: unsupported type byte 0x%02x", p[0])
+}
+
+func (d *msgpackDecoder) str(n int) (interface{}, error) {
+	p, err := d.take(n)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(p), nil
+}
+
+// open rejects a header claiming more items than there are bytes left,
+// before anything is allocated for them, and one nested too deep. The
+// caller must call close when done with the items.
+func (d *msgpackDecoder) open(n int) error {
+	if n < 0 || n > len(d.b) {
+		return ErrMsgpackShort
+	}
+	if d.depth == maxMsgpackDepth {
+		return ErrMsgpackDepth
+	}
+	d.depth++
+
+	return nil
+}
+
+func (d *msgpackDecoder) close() {
+	d.depth--
+}
+
+func (d *msgpackDecoder) array(n int) (interface{}, error) {
+	if err := d.open(n); err != nil {
+		return nil, err
+	}
+	defer d.close()
+
+	out := make([]interface{}, n)
+	for i := range out {
+		v, err := d.value()
+		if err != nil {
+			return nil, err
+		}
+		out[i] = v
+	}
+
+	return out, nil
+}
+
+func (d *msgpackDecoder) object(n int) (interface{}, error) {
+	if err := d.open(n); err != nil {
+		return nil, err
+	}
+	defer d.close()
+
+	out := make(map[string]interface{}, n)
+	for i := 0; i < n; i++ {
+		k, err := d.value()
+		if err != nil {
+			return nil, err
+		}
+		key, ok := k.(string)
+		if !ok {
+			return nil, fmt.Errorf("ws: msgpack: non-string map key %v", k)
+		}
+		v, err := d.value()
+		if err != nil {
+			return nil, err
+		}
+		out[key] = v
+	}
+
+	return out, nil
+}
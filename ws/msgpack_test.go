@@ -0,0 +1,104 @@
+package ws
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// Documents as the server sends them. Fractions are exact in float32.
+var msgpackDocs = []string{
+	`{"acceleration":{"x":0.5,"y":-1.25,"z":9.75,"rotation":[12.5,-3]}}`,
+	`{"gyro":{"x":1.5,"y":0,"z":-2}}`,
+	`{"temperature":31.5}`,
+	`{"events":[{"type":"tap","axis":"z","magnitude":2.5}]}`,
+	`{"type":"data","seq":4294967296,"topics":["accel","sensor:pi"],"data":{"n":-40000}}`,
+	`{"jsonrpc":"2.0","id":7,"result":null,"ok":true}`,
+	`{"long":"` + string(bytes.Repeat([]byte("x"), 300)) + `","empty":{},"list":[]}`,
+}
+
+func TestMsgpackRoundTrip(t *testing.T) {
+	for _, doc := range msgpackDocs {
+		var want interface{}
+		if err := json.Unmarshal([]byte(doc), &want); err != nil {
+			t.Fatal(err)
+		}
+
+		b, err := EncodeMsgpack(want)
+		if err != nil {
+			t.Errorf("encoding %s: %v", doc, err)
+			continue
+		}
+		got, rest, err := DecodeMsgpack(b)
+		if err != nil || len(rest) != 0 {
+			t.Errorf("decoding %s: %v with %d bytes left", doc, err, len(rest))
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %v, want %s", got, doc)
+		}
+
+		// Every proper prefix ends inside the object.
+		for i := 0; i < len(b); i++ {
+			if _, _, err := DecodeMsgpack(b[:i]); err != ErrMsgpackShort {
+				t.Errorf("decoding %d of %d bytes of %s: got %v, want ErrMsgpackShort", i, len(b), doc, err)
+				break
+			}
+		}
+	}
+}
+
+func TestMsgpackBadHeaders(t *testing.T) {
+	deep := append(bytes.Repeat([]byte{0x91}, maxMsgpackDepth+1), 0xc0)
+
+	tests := []struct {
+		name string
+		b    []byte
+		err  error
+	}{
+		{"array32", []byte{0xdd, 0xff, 0xff, 0xff, 0xff, 0xc0}, ErrMsgpackShort},
+		{"map32", []byte{0xdf, 0x7f, 0xff, 0xff, 0xff}, ErrMsgpackShort},
+		{"array16", []byte{0xdc, 0x00, 0x03, 0xc0, 0xc0}, ErrMsgpackShort},
+		{"str32", []byte{0xdb, 0xff, 0xff, 0xff, 0xff, 'a'}, ErrMsgpackShort},
+		{"short header", []byte{0xde, 0x00}, ErrMsgpackShort},
+		{"too deep", deep, ErrMsgpackDepth},
+		{"deepest", deep[1:], nil},
+	}
+	for _, tt := range tests {
+		if _, _, err := DecodeMsgpack(tt.b); err != tt.err {
+			t.Errorf("%s: got %v, want %v", tt.name, err, tt.err)
+		}
+	}
+}
+
+// A batch is an array header followed by already encoded items.
+func TestMsgpackBatchHeader(t *testing.T) {
+	tests := []struct {
+		n    int
+		want []byte
+	}{
+		{0, []byte{0x90}},
+		{15, []byte{0x9f}},
+		{16, []byte{0xdc, 0x00, 0x10}},
+		{1<<16 - 1, []byte{0xdc, 0xff, 0xff}},
+		{1 << 16, []byte{0xdd, 0x00, 0x01, 0x00, 0x00}},
+	}
+	for _, tt := range tests {
+		b := appendLen(nil, tt.n, 0x90, 0xdc, 0xdd)
+		if !bytes.Equal(b, tt.want) {
+			t.Errorf("header for %d: got % x, want % x", tt.n, b, tt.want)
+			continue
+		}
+
+		for i := 0; i < tt.n; i++ {
+			b = append(b, byte(i%128))
+		}
+		v, rest, err := DecodeMsgpack(b)
+		if err != nil || len(rest) != 0 {
+			t.Errorf("batch of %d: %v with %d bytes left", tt.n, err, len(rest))
+		} else if got := len(v.([]interface{})); got != tt.n {
+			t.Errorf("batch of %d decoded %d items", tt.n, got)
+		}
+	}
+}
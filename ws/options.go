@@ -0,0 +1,63 @@
+package ws
+
+import (
+	"compress/flate"
+	"time"
+)
+
+// Options configure how a client is admitted and how its messages are
+// queued and framed.
+type Options struct {
+	// Who may connect; nil allows everyone.
+	Auth *Auth
+
+	// Capacity of the outgoing queue.
+	QueueSize int
+
+	// What to do with a message when the queue is full.
+	Policy Policy
+
+	// With the Disconnect policy, how many dropped messages are tolerated
+	// before the client is closed.
+	MaxDrops int
+
+	// How queued messages are grouped into frames.
+	Batch BatchMode
+
+	// The most messages written per frame (or per wakeup with BatchNone).
+	// Zero means everything queued.
+	MaxBatch int
+
+	// How long a batching writer waits for a full batch before sending
+	// what it has; with no MaxBatch, for a full queue. Zero sends
+	// immediately.
+	MaxLatency time.Duration
+
+	// Offer permessage-deflate to clients that ask for it, at the given
+	// flate level (1 is fastest, 9 smallest). Compressed frames are cached
+	// per level on each prepared broadcast, so they are still built once.
+	Compression      bool
+	CompressionLevel int
+
+	// Wrap every message in an envelope naming its type, so RPC replies
+	// can be told apart from broadcasts. See EnvelopeData.
+	Envelope bool
+
+	// How often clients are pinged to keep the connection alive and
+	// measure its round trip time; see ClientInfo.RTT. Zero means the
+	// default.
+	PingInterval time.Duration
+}
+
+// DefaultOptions are used by ServeWS.
+var DefaultOptions = Options{
+	QueueSize: 1 << 4,
+	Policy:    DropOldest,
+	MaxDrops:  1 << 7,
+	Batch:     BatchNone,
+	MaxBatch:  1 << 5,
+
+	PingInterval: pingInterval,
+
+	CompressionLevel: flate.BestSpeed,
+}
@@ -0,0 +1,241 @@
+package ws
+
+import (
+	"fmt"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/gorilla/websocket"
+)
+
+// Policy decides what happens to a message for a client whose outgoing
+// queue is full. Whatever the policy, the broadcaster never blocks.
+type Policy int
+
+const (
+	// DropNewest discards the incoming message and keeps what is queued.
+	DropNewest Policy = iota
+	// DropOldest discards the oldest queued message to make room.
+	DropOldest
+	// CoalesceLatest replaces everything queued with the incoming message,
+	// so a slow client always gets the freshest state.
+	CoalesceLatest
+	// Disconnect drops like DropNewest, then closes the client once
+	// Options.MaxDrops messages have been dropped.
+	Disconnect
+)
+
+var policyNames = map[string]Policy{
+	"drop-newest":        DropNewest,
+	"drop-oldest":        DropOldest,
+	"coalesce-to-latest": CoalesceLatest,
+	"disconnect":         Disconnect,
+}
+
+// ParsePolicy returns the Policy named by s, e.g. "drop-oldest".
+func ParsePolicy(s string) (Policy, error) {
+	p, ok := policyNames[s]
+	if !ok {
+		return DropNewest, fmt.Errorf("unknown backpressure policy %q", s)
+	}
+
+	return p, nil
+}
+
+// The most replies a client may leave unread before it is disconnected.
+const maxReplies = 1 << 8
+
+// frame is an encoded message waiting to be written. prepared holds the
+// same bytes framed once and shared by every client receiving them.
+type frame struct {
+	data     []byte
+	prepared *websocket.PreparedMessage
+}
+
+// queue is a bounded, non-blocking message queue feeding one client.
+type queue struct {
+	mu    sync.Mutex
+	items []frame
+
+	// Replies to the client's own requests. They go out ahead of items
+	// and the policy never drops them.
+	replies []frame
+
+	size     int
+	policy   Policy
+	maxDrops uint64
+	drops    uint64
+	closed   bool
+
+	// Close frame sent once the queue is drained after closing.
+	closeCode   int
+	closeReason string
+
+	// Signalled (without blocking) whenever items arrive or the queue closes.
+	ready chan struct{}
+}
+
+func newQueue(size int, policy Policy, maxDrops int) *queue {
+	if size < 1 {
+		size = 1
+	}
+
+	return &queue{
+		items:    make([]frame, 0, size),
+		size:     size,
+		policy:   policy,
+		maxDrops: uint64(maxDrops),
+		ready:    make(chan struct{}, 1),
+	}
+}
+
+// push enqueues m according to the policy. It never blocks.
+func (q *queue) push(m frame) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+
+	switch {
+	case q.policy == CoalesceLatest:
+		q.drops += uint64(len(q.items))
+		q.items = append(q.items[:0], m)
+	case len(q.items) < q.size:
+		q.items = append(q.items, m)
+	case q.policy == DropOldest:
+		q.drops++
+		copy(q.items, q.items[1:])
+		q.items[len(q.items)-1] = m
+	default:
+		q.drops++
+		if q.policy == Disconnect && q.drops >= q.maxDrops {
+			q.closeLocked(websocket.ClosePolicyViolation, "too many dropped messages")
+		}
+	}
+
+	q.signal()
+}
+
+// pushReply enqueues a reply, which is never dropped. A client leaving
+// more than maxReplies unread is closed instead.
+func (q *queue) pushReply(m frame) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+
+	if len(q.replies) >= maxReplies {
+		q.closeLocked(websocket.ClosePolicyViolation, "too many unread replies")
+		return
+	}
+	q.replies = append(q.replies, m)
+
+	q.signal()
+}
+
+// drain removes and returns up to max queued messages (all of them if max
+// is not positive), replies first, and whether the queue has been closed.
+// If messages are left behind, ready is signalled again.
+func (q *queue) drain(max int) ([]frame, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if max <= 0 || max >= len(q.replies)+len(q.items) {
+		items := append(q.replies, q.items...)
+		q.replies = nil
+		q.items = make([]frame, 0, q.size)
+		return items, q.closed
+	}
+
+	items := make([]frame, 0, max)
+	n := len(q.replies)
+	if n > max {
+		n = max
+	}
+	items = append(items, q.replies[:n]...)
+	q.replies = append(q.replies[:0], q.replies[n:]...)
+	n = max - len(items)
+	items = append(items, q.items[:n]...)
+	q.items = append(q.items[:0], q.items[n:]...)
+	q.signal()
+
+	return items, q.closed
+}
+
+// len returns the number of queued messages.
+func (q *queue) len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.replies) + len(q.items)
+}
+
+// close stops accepting messages and wakes the reader. The first close
+// decides the close frame the client is sent.
+func (q *queue) close(code int, reason string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.closeLocked(code, reason)
+}
+
+func (q *queue) closeLocked(code int, reason string) {
+	if !q.closed {
+		q.closed = true
+		q.closeCode, q.closeReason = code, reason
+	}
+	q.signal()
+}
+
+// isClosed reports whether close has been called.
+func (q *queue) isClosed() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.closed
+}
+
+// closeMessage returns the payload of the close frame for a closed queue.
+func (q *queue) closeMessage() []byte {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return closeMessage(q.closeCode, q.closeReason)
+}
+
+// The most bytes of reason a close frame can carry.
+const maxCloseReason = 123
+
+// closeMessage formats a close frame, cutting reason short at a UTF-8
+// boundary if it is too long to fit: otherwise the frame fails to send.
+func closeMessage(code int, reason string) []byte {
+	if len(reason) > maxCloseReason {
+		n := maxCloseReason
+		for n > 0 && !utf8.RuneStart(reason[n]) {
+			n--
+		}
+		reason = reason[:n]
+	}
+
+	return websocket.FormatCloseMessage(code, reason)
+}
+
+// dropped returns how many messages the policy has discarded.
+func (q *queue) dropped() uint64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.drops
+}
+
+// signal must be called with mu held.
+func (q *queue) signal() {
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,58 @@
+package ws
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestQueueRepliesSurvivePolicy(t *testing.T) {
+	for name, policy := range policyNames {
+		q := newQueue(2, policy, 1)
+		q.pushReply(frame{data: []byte("reply")})
+		for i := 0; i < 10; i++ {
+			q.push(frame{data: []byte("data")})
+		}
+
+		items, _ := q.drain(0)
+		if len(items) == 0 || string(items[0].data) != "reply" {
+			t.Errorf("%s: reply was dropped or sent after data", name)
+		}
+	}
+}
+
+func TestQueueDrainRepliesFirst(t *testing.T) {
+	q := newQueue(4, DropOldest, 0)
+	q.push(frame{data: []byte("d1")})
+	q.push(frame{data: []byte("d2")})
+	q.pushReply(frame{data: []byte("r1")})
+
+	var got []string
+	for q.len() > 0 {
+		items, _ := q.drain(2)
+		for _, f := range items {
+			got = append(got, string(f.data))
+		}
+	}
+
+	want := []string{"r1", "d1", "d2"}
+	if len(got) != len(want) {
+		t.Fatalf("drained %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("drained %v, want %v", got, want)
+		}
+	}
+}
+
+func TestCloseMessageReason(t *testing.T) {
+	long := strings.Repeat("é", 100)
+	b := closeMessage(websocket.ClosePolicyViolation, long)
+	reason := b[2:]
+	if len(reason) > maxCloseReason || !utf8.Valid(reason) || !strings.HasPrefix(long, string(reason)) {
+		t.Errorf("got %d byte reason %q, want a valid prefix of at most %d", len(reason), reason, maxCloseReason)
+	}
+}
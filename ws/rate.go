@@ -0,0 +1,260 @@
+package ws
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+)
+
+// RateMode decides how a client's lower output rate is reached.
+type RateMode int
+
+const (
+	// Decimate forwards the first sample of each interval and drops the rest.
+	Decimate RateMode = iota
+	// Average forwards the mean of every sample in the interval. Sensor
+	// readings are averaged field by field; everything else comes from the
+	// latest sample, including fields named as identifiers (see
+	// identifierFields) and numbers that were whole in every sample, such as
+	// counters.
+	Average
+)
+
+// ParseRateMode returns the RateMode named by s: "decimate" or "average".
+func ParseRateMode(s string) (RateMode, error) {
+	switch s {
+	case "", "decimate":
+		return Decimate, nil
+	case "average":
+		return Average, nil
+	}
+
+	return Decimate, fmt.Errorf("unknown rate mode %q", s)
+}
+
+// identifierFields are numbers Average never averages, whatever they hold.
+var identifierFields = map[string]bool{
+	"id":        true,
+	"seq":       true,
+	"time":      true,
+	"timestamp": true,
+	"ts":        true,
+}
+
+// rateMessage asks for a different output rate, in Hz; 0 means every sample.
+//
+//	{"type": "rate", "rate": 10, "mode": "average"}
+type rateMessage struct {
+	Rate float64 `json:"rate"`
+	Mode string  `json:"mode"`
+}
+
+// rateLimiter thins a client's sampled streams down to its requested rate.
+// Each distinct topic list is an independent stream. Owned by Hub.RunLoop.
+type rateLimiter struct {
+	interval time.Duration
+	mode     RateMode
+	streams  map[string]*stream
+}
+
+type stream struct {
+	next time.Time
+
+	// Running field-wise sum of decoded samples, for Average, with each
+	// number as a numSum.
+	sum interface{}
+	n   int
+}
+
+// numSum accumulates one numeric field for Average.
+type numSum struct {
+	sum, last float64
+
+	// Whether every sample so far was a whole number.
+	whole bool
+}
+
+func newNumSum(x float64) numSum {
+	return numSum{sum: x, last: x, whole: x == math.Trunc(x)}
+}
+
+func newRateLimiter(rate float64, mode RateMode) *rateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+
+	return &rateLimiter{
+		interval: time.Duration(float64(time.Second) / rate),
+		mode:     mode,
+		streams:  make(map[string]*stream),
+	}
+}
+
+// fresh returns a limiter with the same settings and no state.
+func (r *rateLimiter) fresh() *rateLimiter {
+	if r == nil {
+		return nil
+	}
+
+	return &rateLimiter{
+		interval: r.interval,
+		mode:     r.mode,
+		streams:  make(map[string]*stream),
+	}
+}
+
+// admit returns the message to send in place of m at now, if any. A nil
+// limiter passes everything through.
+func (r *rateLimiter) admit(m *message, now time.Time) (*message, bool) {
+	if r == nil || !m.sampled {
+		return m, true
+	}
+
+	key := strings.Join(m.topics, "\x00")
+	s, ok := r.streams[key]
+	if !ok {
+		s = &stream{}
+		r.streams[key] = s
+	}
+
+	if r.mode == Average {
+		if v, err := m.decode(); err == nil {
+			if sum, ok := addJSON(s.sum, v); ok && s.n > 0 {
+				s.sum = sum
+				s.n++
+			} else {
+				// First sample, or the shape changed; start over.
+				s.sum, s.n = startJSON(v), 1
+			}
+		}
+	}
+
+	if now.Before(s.next) {
+		return nil, false
+	}
+
+	s.next = s.next.Add(r.interval)
+	if s.next.Before(now) {
+		// We fell behind (or just started); don't burst to catch up.
+		s.next = now.Add(r.interval)
+	}
+
+	if r.mode == Decimate || s.n <= 1 {
+		s.sum, s.n = nil, 0
+		return m, true
+	}
+
+	avg := meanJSON(s.sum, float64(s.n), "")
+	s.sum, s.n = nil, 0
+	b, err := json.Marshal(avg)
+	if err != nil {
+		log.Println(fmt.Sprintf("Error serializing averaged message: %v", err))
+		return m, true
+	}
+
+	return &message{
+		data:      b,
+		topics:    m.topics,
+		seq:       m.seq,
+		kind:      m.kind,
+		sampled:   true,
+		decoded:   avg,
+		isDecoded: true,
+	}, true
+}
+
+// startJSON turns the numbers in v into sums of one sample.
+func startJSON(v interface{}) interface{} {
+	switch t := v.(type) {
+	case float64:
+		return newNumSum(t)
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for key, x := range t {
+			out[key] = startJSON(x)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i, x := range t {
+			out[i] = startJSON(x)
+		}
+		return out
+	}
+
+	return v
+}
+
+// addJSON adds the numbers in b to the sums in a, which must share b's
+// shape. Non-numeric leaves are taken from b.
+func addJSON(a, b interface{}) (interface{}, bool) {
+	switch bv := b.(type) {
+	case float64:
+		av, ok := a.(numSum)
+		if !ok {
+			return nil, false
+		}
+		n := newNumSum(bv)
+		return numSum{sum: av.sum + bv, last: bv, whole: av.whole && n.whole}, true
+	case map[string]interface{}:
+		am, ok := a.(map[string]interface{})
+		if !ok || len(am) != len(bv) {
+			return nil, false
+		}
+		out := make(map[string]interface{}, len(bv))
+		for k, v := range bv {
+			s, ok := addJSON(am[k], v)
+			if !ok {
+				return nil, false
+			}
+			out[k] = s
+		}
+		return out, true
+	case []interface{}:
+		as, ok := a.([]interface{})
+		if !ok || len(as) != len(bv) {
+			return nil, false
+		}
+		out := make([]interface{}, len(bv))
+		for i, v := range bv {
+			s, ok := addJSON(as[i], v)
+			if !ok {
+				return nil, false
+			}
+			out[i] = s
+		}
+		return out, true
+	}
+
+	return b, true
+}
+
+// meanJSON divides the sums in v by the n samples they add up, keeping
+// the latest value of identifiers and whole numbers. key is the name of
+// the field v is in.
+func meanJSON(v interface{}, n float64, key string) interface{} {
+	switch t := v.(type) {
+	case numSum:
+		if t.whole || identifierFields[key] {
+			return t.last
+		}
+		return t.sum / n
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for k, x := range t {
+			out[k] = meanJSON(x, n, k)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i, x := range t {
+			out[i] = meanJSON(x, n, key)
+		}
+		return out
+	}
+
+	return v
+}
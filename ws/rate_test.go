@@ -0,0 +1,31 @@
+package ws
+
+import (
+	"testing"
+	"time"
+)
+
+// Averaging touches sensor readings only: identifiers and whole-number
+// counters come from the latest sample.
+func TestRateAverage(t *testing.T) {
+	r := newRateLimiter(1, Average)
+	start := time.Now()
+
+	samples := []string{
+		`{"seq":6,"count":6,"accel":{"x":0.5,"y":0,"rotation":[1,2.5]}}`,
+		`{"seq":7,"count":7,"accel":{"x":1.5,"y":1,"rotation":[1,3.5]}}`,
+	}
+	if _, ok := r.admit(&message{data: []byte(samples[0]), sampled: true}, start); !ok {
+		t.Fatal("first sample not admitted")
+	}
+	r.admit(&message{data: []byte(samples[0]), sampled: true}, start.Add(100*time.Millisecond))
+	m, ok := r.admit(&message{data: []byte(samples[1]), sampled: true}, start.Add(time.Second))
+	if !ok {
+		t.Fatal("sample after the interval not admitted")
+	}
+
+	want := `{"accel":{"rotation":[1,3],"x":1,"y":1},"count":7,"seq":7}`
+	if got := string(m.data); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
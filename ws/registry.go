@@ -0,0 +1,193 @@
+package ws
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// ErrNoClient is returned for a client ID that isn't connected.
+var ErrNoClient = errors.New("ws: no such client")
+
+// ClientInfo is a snapshot of a connected client.
+type ClientInfo struct {
+	// Unique for the life of the Hub.
+	ID        uint64    `json:"id"`
+	Remote    string    `json:"remote"`
+	UserAgent string    `json:"userAgent"`
+	Connected time.Time `json:"connected"`
+	Role      string    `json:"role"`
+	Format    string    `json:"format"`
+
+	// Subscribed topics, sorted.
+	Topics []string `json:"topics"`
+	// Messages waiting in the outgoing queue.
+	Queued int `json:"queued"`
+	// Smoothed round trip time of pings; zero until one is answered.
+	RTT time.Duration `json:"rtt"`
+	// How often the client is pinged.
+	PingInterval time.Duration `json:"pingInterval"`
+
+	Stats
+}
+
+// ID returns the client's ID, which is unique for the life of its Hub.
+func (c *Client) ID() uint64 {
+	return c.id
+}
+
+// RTT returns the client's smoothed ping round trip time, or zero if no
+// ping has been answered yet.
+func (c *Client) RTT() time.Duration {
+	return time.Duration(atomic.LoadInt64(&c.rtt))
+}
+
+// pingPayload stamps a ping with the time it was sent, so the pong that
+// echoes it can be timed without tracking which ping is outstanding.
+func pingPayload(t time.Time) []byte {
+	return strconv.AppendInt(nil, t.UnixNano(), 10)
+}
+
+// ponged folds the round trip of the ping echoed in payload into the
+// moving average. Pongs the client sent unprompted carry no timestamp of
+// ours and are ignored. It is only called from the reading goroutine.
+func (c *Client) ponged(payload string, t time.Time) {
+	sent, err := strconv.ParseInt(payload, 10, 64)
+	if err != nil {
+		return
+	}
+	sample := t.UnixNano() - sent
+	if sample < 0 {
+		return
+	}
+
+	rtt := atomic.LoadInt64(&c.rtt)
+	if rtt == 0 {
+		rtt = sample
+	} else {
+		rtt += (sample - rtt) / rttSmoothing
+	}
+	atomic.StoreInt64(&c.rtt, rtt)
+}
+
+// info must be called from Hub.RunLoop.
+func (c *Client) info() ClientInfo {
+	topics := make([]string, 0, len(c.topics))
+	for t := range c.topics {
+		topics = append(topics, t)
+	}
+	sort.Strings(topics)
+
+	return ClientInfo{
+		ID:        c.id,
+		Remote:    c.conn.RemoteAddr().String(),
+		UserAgent: c.userAgent,
+		Connected: c.connected,
+		Role:      c.role.String(),
+		Format:    c.codec.name,
+		Topics:    topics,
+		Queued:    c.send.len(),
+		Stats:     c.Stats(),
+
+		RTT:          c.RTT(),
+		PingInterval: c.pingInterval(),
+	}
+}
+
+// Clients returns a snapshot of the connected clients ordered by ID, or
+// nil once the hub is shutting down.
+func (h *Hub) Clients() []ClientInfo {
+	done := make(chan []ClientInfo, 1)
+	ok := h.do(func() {
+		infos := make([]ClientInfo, 0, len(h.clients))
+		for c := range h.clients {
+			infos = append(infos, c.info())
+		}
+		done <- infos
+	})
+	if !ok {
+		return nil
+	}
+
+	infos := <-done
+	sort.Slice(infos, func(i, j int) bool { return infos[i].ID < infos[j].ID })
+
+	return infos
+}
+
+// Client returns a snapshot of the client with the given ID.
+func (h *Hub) Client(id uint64) (ClientInfo, error) {
+	type result struct {
+		info ClientInfo
+		ok   bool
+	}
+
+	done := make(chan result, 1)
+	ok := h.do(func() {
+		if c := h.lookup(id); c != nil {
+			done <- result{c.info(), true}
+			return
+		}
+		done <- result{}
+	})
+	if !ok {
+		return ClientInfo{}, ErrHubClosed
+	}
+
+	r := <-done
+	if !r.ok {
+		return ClientInfo{}, ErrNoClient
+	}
+
+	return r.info, nil
+}
+
+// Disconnect closes the client with the given ID straight away, sending a
+// policy violation close frame with reason. Anything still queued for it
+// is discarded, and its session can't be resumed.
+func (h *Hub) Disconnect(id uint64, reason string) error {
+	done := make(chan *Client, 1)
+	ok := h.do(func() {
+		c := h.lookup(id)
+		if c != nil {
+			h.forget(c, websocket.ClosePolicyViolation, reason)
+			if s := c.session; s != nil && s.client == c {
+				delete(h.sessions, s.token)
+			}
+		}
+		done <- c
+	})
+	if !ok {
+		return ErrHubClosed
+	}
+
+	c := <-done
+	if c == nil {
+		return ErrNoClient
+	}
+
+	// Don't wait for the writer, which may be stuck on a slow link.
+	c.conn.WriteControl(
+		websocket.CloseMessage,
+		closeMessage(websocket.ClosePolicyViolation, reason),
+		time.Now().Add(time.Second),
+	)
+	c.closeConn()
+
+	return nil
+}
+
+// lookup must be called from RunLoop.
+func (h *Hub) lookup(id uint64) *Client {
+	for c := range h.clients {
+		if c.id == id {
+			return c
+		}
+	}
+
+	return nil
+}
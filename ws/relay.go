@@ -0,0 +1,290 @@
+package ws
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// Default bounds on the wait between reconnection attempts.
+	defaultMinBackoff = 500 * time.Millisecond
+	defaultMaxBackoff = 30 * time.Second
+
+	// How long a connection must last, if nothing arrives on it, before
+	// the backoff starts over.
+	relayStable = 10 * time.Second
+)
+
+// SourceTopic is the topic relayed messages from source are published on,
+// e.g. "source:pi-2".
+func SourceTopic(source string) string {
+	return "source:" + source
+}
+
+// Relay copies the stream of an upstream gophx-xxws server into a local
+// Hub, so one server can fan in many devices:
+//
+//	r := &ws.Relay{URL: "ws://pi-2.local:3000/ws", Source: "pi-2"}
+//	go r.Run(ctx, hub)
+//
+// Each relayed message gets a "source" field naming where it came from,
+// and is published on its upstream topics plus SourceTopic(Source):
+//
+//	{"source": "pi-2", "accel": {...}}
+//
+// Relayed messages are not sequenced locally, since every upstream counts
+// on its own; the local history and session resume don't cover them. The
+// relay itself resumes its upstream session on reconnect, so a short
+// outage loses nothing the upstream still remembers.
+type Relay struct {
+	// The upstream /ws endpoint. Query params such as topics, rate or
+	// token are passed along; envelope is always turned on.
+	URL string
+
+	// Identifies the upstream in relayed messages.
+	Source string
+
+	// Extra handshake headers, e.g. Authorization.
+	Header http.Header
+
+	// Dials the upstream; nil means websocket.DefaultDialer.
+	Dialer *websocket.Dialer
+
+	// Bounds on the wait between reconnection attempts, which doubles
+	// after every failure. It starts over once a connection delivers a
+	// message or lasts 10s. Zero means 500ms and 30s.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// Topics of one-off messages, such as events, that local clients must
+	// never have thinned out. Everything else is relayed as sampled data.
+	Events []string
+
+	// The upstream session to resume and the last sequence number seen.
+	// Only touched by Run.
+	session string
+	lastSeq uint64
+}
+
+// Run relays the upstream into h until ctx is done or h shuts down,
+// reconnecting with backoff whenever the connection fails. It returns
+// ctx's error or ErrHubClosed.
+func (r *Relay) Run(ctx context.Context, h *Hub) error {
+	minBackoff, maxBackoff := r.MinBackoff, r.MaxBackoff
+	if minBackoff <= 0 {
+		minBackoff = defaultMinBackoff
+	}
+	if maxBackoff < minBackoff {
+		maxBackoff = defaultMaxBackoff
+	}
+
+	backoff := minBackoff
+	for {
+		conn, err := r.dial()
+		if err == nil {
+			log.Println(fmt.Sprintf("Relaying %s from %s", r.Source, r.URL))
+			start := time.Now()
+			var received bool
+			received, err = r.pump(ctx, h, conn)
+			if received || time.Since(start) >= relayStable {
+				// It worked for a while; this is a new failure.
+				backoff = minBackoff
+			}
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		select {
+		case <-h.closing:
+			return ErrHubClosed
+		default:
+		}
+		log.Println(fmt.Sprintf("Error relaying %s (retrying in %v): %v", r.Source, backoff, err))
+
+		// Jitter keeps a fleet of relays from reconnecting in lockstep.
+		wait := backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-h.closing:
+			return ErrHubClosed
+		}
+
+		if backoff *= 2; backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+// dial connects to the upstream, asking to resume the previous session.
+func (r *Relay) dial() (*websocket.Conn, error) {
+	u, err := url.Parse(r.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	q.Set("envelope", "true")
+	q.Del("resume")
+	q.Del("last_seq")
+	if r.session != "" {
+		q.Set("resume", r.session)
+		q.Set("last_seq", strconv.FormatUint(r.lastSeq, 10))
+	}
+	u.RawQuery = q.Encode()
+
+	d := r.Dialer
+	if d == nil {
+		d = websocket.DefaultDialer
+	}
+
+	conn, _, err := d.Dial(u.String(), r.Header)
+	return conn, err
+}
+
+// pump publishes what arrives on conn to h until the connection fails,
+// ctx is done or h shuts down. It reports whether any message arrived.
+func (r *Relay) pump(ctx context.Context, h *Hub, conn *websocket.Conn) (bool, error) {
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-h.closing:
+		case <-stop:
+			conn.Close()
+			return
+		}
+		conn.WriteControl(
+			websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseGoingAway, ""),
+			time.Now().Add(time.Second),
+		)
+		conn.Close()
+	}()
+
+	// The upstream pings well within pongTimeout; silence means it's gone.
+	conn.SetReadDeadline(time.Now().Add(pongTimeout))
+	conn.SetPingHandler(func(payload string) error {
+		conn.SetReadDeadline(time.Now().Add(pongTimeout))
+		return conn.WriteControl(websocket.PongMessage, []byte(payload), time.Now().Add(writeTimeout))
+	})
+
+	received := false
+	for {
+		_, b, err := conn.ReadMessage()
+		if err != nil {
+			return received, err
+		}
+		received = true
+		conn.SetReadDeadline(time.Now().Add(pongTimeout))
+
+		var e envelope
+		if err := json.Unmarshal(b, &e); err != nil {
+			log.Println(fmt.Sprintf("Error parsing message from %s: %v", r.Source, err))
+			continue
+		}
+		r.handle(h, &e)
+	}
+}
+
+// handle acts on one enveloped message from the upstream.
+func (r *Relay) handle(h *Hub, e *envelope) {
+	switch e.Type {
+	case EnvelopeData:
+		r.relay(h, e.Seq, e.Topics, e.Data)
+	case EnvelopeHistory:
+		// What a resumed session missed, or history asked for in URL.
+		var hr historyReply
+		if err := json.Unmarshal(e.Data, &hr); err != nil {
+			log.Println(fmt.Sprintf("Error parsing history from %s: %v", r.Source, err))
+			return
+		}
+		for _, m := range hr.History.Messages {
+			r.relay(h, m.Seq, m.Topics, m.Data)
+		}
+	case EnvelopeSession:
+		var s sessionReply
+		if err := json.Unmarshal(e.Data, &s); err != nil {
+			log.Println(fmt.Sprintf("Error parsing session from %s: %v", r.Source, err))
+			return
+		}
+		if !s.Session.Resumed {
+			// Nothing carries over from an earlier session.
+			switch {
+			case r.session == "":
+			case s.Session.Seq > r.lastSeq:
+				log.Println(fmt.Sprintf("Relay %s couldn't resume and lost messages %d-%d", r.Source, r.lastSeq+1, s.Session.Seq))
+			default:
+				log.Println(fmt.Sprintf("Relay %s couldn't resume; the upstream restarted at %d", r.Source, s.Session.Seq))
+			}
+			r.lastSeq = s.Session.Seq
+		}
+		r.session = s.Session.Token
+	case EnvelopeGap:
+		var g gapReply
+		if err := json.Unmarshal(e.Data, &g); err == nil {
+			log.Println(fmt.Sprintf("Relay %s lost messages %d-%d", r.Source, g.Gap.From, g.Gap.To))
+		}
+	}
+}
+
+// relay publishes one upstream message to h.
+func (r *Relay) relay(h *Hub, seq uint64, topics []string, data json.RawMessage) {
+	if seq > r.lastSeq {
+		r.lastSeq = seq
+	}
+	h.Send(Message{
+		Topics:  append(topics, SourceTopic(r.Source)),
+		Sampled: !r.isEvent(topics),
+		Data:    r.tag(data),
+	})
+}
+
+func (r *Relay) isEvent(topics []string) bool {
+	for _, t := range topics {
+		for _, ev := range r.Events {
+			if t == ev {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// tag adds the source to a JSON object, or wraps anything else:
+//
+//	{"source": "pi-2", "data": [...]}
+func (r *Relay) tag(data json.RawMessage) []byte {
+	src, _ := json.Marshal(r.Source)
+
+	data = bytes.TrimSpace(data)
+	if len(data) < 2 || data[0] != '{' {
+		b, _ := json.Marshal(struct {
+			Source string          `json:"source"`
+			Data   json.RawMessage `json:"data"`
+		}{r.Source, data})
+		return b
+	}
+
+	b := make([]byte, 0, len(data)+len(src)+12)
+	b = append(b, `{"source":`...)
+	b = append(b, src...)
+	if rest := bytes.TrimSpace(data[1:]); rest[0] != '}' {
+		b = append(b, ',')
+	}
+
+	return append(b, data[1:]...)
+}
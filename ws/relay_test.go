@@ -0,0 +1,163 @@
+package ws
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// What a resumed upstream session missed arrives as one history batch,
+// which is relayed message by message.
+func TestRelayHistory(t *testing.T) {
+	h, url := startHub(t)
+	conn := dial(t, url)
+	waitClients(t, h, 1)
+
+	b, _ := json.Marshal(historyReply{History: historyBatch{From: 6, To: 7, Messages: []historyEntryJSON{
+		{Seq: 6, Topics: []string{"accel"}, Data: json.RawMessage(`{"n":6}`)},
+		{Seq: 7, Topics: []string{"accel"}, Data: json.RawMessage(`{"n":7}`)},
+	}}})
+	r := &Relay{Source: "pi", lastSeq: 5}
+	r.handle(h, &envelope{Type: EnvelopeHistory, Data: b})
+
+	for _, want := range []string{`{"source":"pi","n":6}`, `{"source":"pi","n":7}`} {
+		if got := readString(t, conn); got != want {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	}
+	if r.lastSeq != 7 {
+		t.Errorf("got last seq %d, want 7", r.lastSeq)
+	}
+}
+
+// A relay dropped by its upstream resumes the session and relays what it
+// missed in the meantime.
+func TestRelayReconnect(t *testing.T) {
+	up, upURL := startHub(t)
+	up.SetHistory(time.Hour)
+	h, url := startHub(t)
+	conn := dial(t, url)
+	waitClients(t, h, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r := &Relay{URL: upURL, Source: "pi", MinBackoff: 10 * time.Millisecond}
+	go r.Run(ctx, h)
+	waitClients(t, up, 1)
+
+	send := func(seq uint64) {
+		up.Send(Message{Seq: seq, Topics: []string{"accel"}, Data: []byte(fmt.Sprintf(`{"n":%d}`, seq))})
+	}
+	send(1)
+	if got := readString(t, conn); got != `{"source":"pi","n":1}` {
+		t.Fatalf("got %s, want n 1", got)
+	}
+
+	// Cut the connection without ending the session.
+	up.do(func() {
+		for c := range up.clients {
+			c.closeConn()
+		}
+	})
+	waitClients(t, up, 0)
+	send(2)
+	send(3)
+
+	for _, want := range []string{`{"source":"pi","n":2}`, `{"source":"pi","n":3}`} {
+		if got := readString(t, conn); got != want {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	}
+}
+
+// An upstream that accepts connections and drops them straight away is
+// redialled with growing waits, not every MinBackoff.
+func TestRelayBackoff(t *testing.T) {
+	var dials int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&dials, 1)
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, ""))
+		conn.Close()
+	}))
+	defer srv.Close()
+
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	h := NewHub()
+	go h.RunLoop()
+	defer h.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	r := &Relay{URL: "ws" + strings.TrimPrefix(srv.URL, "http"), Source: "pi", MinBackoff: 20 * time.Millisecond, MaxBackoff: time.Second}
+	if err := r.Run(ctx, h); err != context.DeadlineExceeded {
+		t.Fatalf("Run returned %v, want the context's error", err)
+	}
+
+	// Waits of at least 10, 20, 40, 80 and 160ms fit in 500ms; a
+	// backoff stuck at 20ms would allow around 30.
+	if n := atomic.LoadInt32(&dials); n > 7 {
+		t.Errorf("got %d dials in 500ms, want at most 7", n)
+	}
+}
+
+// An upstream session that can't be resumed is reported with the range of
+// messages lost.
+func TestRelayNotResumed(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	b, _ := json.Marshal(sessionReply{Session: sessionInfo{Token: "new", Seq: 9}})
+	r := &Relay{Source: "pi", session: "old", lastSeq: 5}
+	r.handle(nil, &envelope{Type: EnvelopeSession, Data: b})
+
+	if r.session != "new" || r.lastSeq != 9 {
+		t.Errorf("got session %s at %d, want new at 9", r.session, r.lastSeq)
+	}
+	if !strings.Contains(buf.String(), "lost messages 6-9") {
+		t.Errorf("got log %q, want messages 6-9 reported lost", buf.String())
+	}
+}
+
+func TestRelayTag(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{`{"n":1}`, `{"source":"pi","n":1}`},
+		{` {"n":1} `, `{"source":"pi","n":1}`},
+		{`{}`, `{"source":"pi"}`},
+		{`{ }`, `{"source":"pi" }`},
+		{`[1,2]`, `{"source":"pi","data":[1,2]}`},
+		{`3.5`, `{"source":"pi","data":3.5}`},
+		{`"up"`, `{"source":"pi","data":"up"}`},
+		{`null`, `{"source":"pi","data":null}`},
+	}
+	r := &Relay{Source: "pi"}
+	for _, tt := range tests {
+		got := r.tag(json.RawMessage(tt.in))
+		if string(got) != tt.want {
+			t.Errorf("tag(%s): got %s, want %s", tt.in, got, tt.want)
+		}
+		if !json.Valid(got) {
+			t.Errorf("tag(%s): %s is not valid JSON", tt.in, got)
+		}
+	}
+}
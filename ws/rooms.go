@@ -0,0 +1,157 @@
+package ws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+// SubprotocolRoomPrefix, followed by a room name, selects the room through
+// Sec-WebSocket-Protocol instead of the path, e.g. "xxws.room.alerts".
+// Clients that join a room this way get the default JSON format.
+const SubprotocolRoomPrefix = "xxws.room."
+
+var (
+	// ErrNoRoom is returned for a room that doesn't exist.
+	ErrNoRoom = errors.New("ws: no such room")
+
+	// ErrRoomExists is returned when creating a room twice.
+	ErrRoomExists = errors.New("ws: room exists")
+)
+
+// Rooms is a set of named hubs served from one endpoint, so independent
+// streams (one per sensor, alerts, logs) can share a server. Each room is
+// an ordinary Hub: publish to it, and shut it down, on its own.
+type Rooms struct {
+	// Options for clients of every room.
+	opts Options
+
+	mu   sync.RWMutex
+	hubs map[string]*Hub
+}
+
+// NewRooms returns an empty set of rooms whose clients are admitted and
+// queued according to o.
+func NewRooms(o Options) *Rooms {
+	return &Rooms{
+		opts: o,
+		hubs: make(map[string]*Hub),
+	}
+}
+
+// Create starts a hub for the named room and returns it.
+func (rs *Rooms) Create(name string) (*Hub, error) {
+	if name == "" || strings.ContainsAny(name, "/, ") {
+		return nil, fmt.Errorf("ws: invalid room name %q", name)
+	}
+
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	if _, ok := rs.hubs[name]; ok {
+		return nil, ErrRoomExists
+	}
+
+	h := NewHub()
+	h.name = name
+	go h.RunLoop()
+	rs.hubs[name] = h
+
+	return h, nil
+}
+
+// Hub returns the named room, or nil if there is none.
+func (rs *Rooms) Hub(name string) *Hub {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+
+	return rs.hubs[name]
+}
+
+// Names returns the names of the open rooms, sorted.
+func (rs *Rooms) Names() []string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+
+	names := make([]string, 0, len(rs.hubs))
+	for name := range rs.hubs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Close removes the named room and shuts its hub down; see Hub.Shutdown.
+func (rs *Rooms) Close(ctx context.Context, name, reason string) error {
+	rs.mu.Lock()
+	h, ok := rs.hubs[name]
+	delete(rs.hubs, name)
+	rs.mu.Unlock()
+
+	if !ok {
+		return ErrNoRoom
+	}
+
+	return h.Shutdown(ctx, reason)
+}
+
+// Shutdown closes every room at once, returning the first error.
+func (rs *Rooms) Shutdown(ctx context.Context, reason string) error {
+	rs.mu.Lock()
+	hubs := rs.hubs
+	rs.hubs = make(map[string]*Hub)
+	rs.mu.Unlock()
+
+	errs := make(chan error, len(hubs))
+	for _, h := range hubs {
+		go func(h *Hub) {
+			errs <- h.Shutdown(ctx, reason)
+		}(h)
+	}
+
+	var first error
+	for range hubs {
+		if err := <-errs; err != nil && first == nil {
+			first = err
+		}
+	}
+
+	return first
+}
+
+// ServeWS upgrades r for the room named by a SubprotocolRoomPrefix
+// subprotocol or, failing that, by the path below prefix, e.g.
+// /ws/rooms/alerts with a prefix of "/ws/rooms/". Query params are as for
+// ServeWSOptions. Unknown rooms get a 404.
+func (rs *Rooms) ServeWS(w http.ResponseWriter, r *http.Request, prefix string) error {
+	name := roomFromSubprotocol(r)
+	if name == "" {
+		name = strings.Trim(strings.TrimPrefix(r.URL.Path, prefix), "/")
+	}
+
+	h := rs.Hub(name)
+	if h == nil {
+		http.Error(w, ErrNoRoom.Error(), http.StatusNotFound)
+		return ErrNoRoom
+	}
+
+	return ServeWSOptions(h, w, r, rs.opts)
+}
+
+// roomFromSubprotocol returns the room a client asked for by subprotocol.
+func roomFromSubprotocol(r *http.Request) string {
+	for _, p := range websocket.Subprotocols(r) {
+		if strings.HasPrefix(p, SubprotocolRoomPrefix) {
+			return strings.TrimPrefix(p, SubprotocolRoomPrefix)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,197 @@
+package ws
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+)
+
+// JSON-RPC 2.0 error codes. Codes from -32000 to -32099 are ours.
+const (
+	RPCParseError     = -32700
+	RPCInvalidRequest = -32600
+	RPCMethodNotFound = -32601
+	RPCInvalidParams  = -32602
+	RPCInternalError  = -32603
+
+	// RPCServerError is returned for handler errors that aren't an *RPCError.
+	RPCServerError = -32000
+	// RPCUnauthorized is returned when the client's role is too low.
+	RPCUnauthorized = -32001
+)
+
+// RPCHandler runs a method with its raw params, which may be empty.
+// Returning an *RPCError picks the error code; any other error is
+// reported as RPCServerError.
+type RPCHandler func(params json.RawMessage) (interface{}, error)
+
+// RPCError is a JSON-RPC error object.
+type RPCError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("rpc error %d: %s", e.Code, e.Message)
+}
+
+// InvalidParams wraps err as an RPCInvalidParams error.
+func InvalidParams(err error) *RPCError {
+	return &RPCError{Code: RPCInvalidParams, Message: err.Error()}
+}
+
+type rpcMethod struct {
+	role    Role
+	handler RPCHandler
+}
+
+// rpcRequest is a JSON-RPC 2.0 request from a client:
+//
+//	{"jsonrpc": "2.0", "id": 1, "method": "sensor.tare"}
+//
+// Requests without an id are notifications and get no response. Batches
+// are not supported.
+type rpcRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+type rpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *RPCError       `json:"error,omitempty"`
+}
+
+// HandleRPC registers handler as method, callable by clients with at least
+// role. Handlers run on the calling client's reader goroutine, so one
+// client's requests are answered in order.
+func (h *Hub) HandleRPC(method string, role Role, handler RPCHandler) {
+	h.methodsMu.Lock()
+	defer h.methodsMu.Unlock()
+
+	if h.methods == nil {
+		h.methods = make(map[string]rpcMethod)
+	}
+	h.methods[method] = rpcMethod{role: role, handler: handler}
+}
+
+func (h *Hub) method(name string) (rpcMethod, bool) {
+	h.methodsMu.RLock()
+	defer h.methodsMu.RUnlock()
+
+	m, ok := h.methods[name]
+	return m, ok
+}
+
+// isRPC reports whether b, which failed to parse, was probably meant as
+// a JSON-RPC request rather than a control message.
+func isRPC(b []byte) bool {
+	return bytes.Contains(b, []byte(`"jsonrpc"`))
+}
+
+func (c *Client) handleRPC(b []byte) {
+	var req rpcRequest
+	if err := json.Unmarshal(b, &req); err != nil {
+		c.replyRPC(rpcResponse{Error: &RPCError{Code: RPCInvalidRequest, Message: err.Error()}})
+		return
+	}
+
+	notify := len(req.ID) == 0
+	res := rpcResponse{ID: req.ID}
+	if !validID(req.ID) {
+		res.ID = nil
+	}
+	res.Result, res.Error = c.callRPC(req)
+	if notify {
+		return
+	}
+	if res.Error == nil && res.Result == nil {
+		// A result member is required on success.
+		res.Result = struct{}{}
+	}
+
+	c.replyRPC(res)
+}
+
+func (c *Client) callRPC(req rpcRequest) (interface{}, *RPCError) {
+	if req.JSONRPC != "2.0" || req.Method == "" || !validID(req.ID) {
+		return nil, &RPCError{Code: RPCInvalidRequest, Message: "invalid request"}
+	}
+
+	m, ok := c.hub.method(req.Method)
+	if !ok {
+		return nil, &RPCError{Code: RPCMethodNotFound, Message: fmt.Sprintf("method %q not found", req.Method)}
+	}
+	if c.role < m.role {
+		return nil, &RPCError{Code: RPCUnauthorized, Message: fmt.Sprintf("method %q requires role %s", req.Method, m.role)}
+	}
+
+	result, err := m.handler(req.Params)
+	if err != nil {
+		if e, ok := err.(*RPCError); ok {
+			return nil, e
+		}
+		return nil, &RPCError{Code: RPCServerError, Message: err.Error()}
+	}
+
+	return result, nil
+}
+
+// validID accepts the id forms JSON-RPC allows: absent, null, string or number.
+func validID(id json.RawMessage) bool {
+	if len(id) == 0 {
+		return true
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(id, &v); err != nil {
+		return false
+	}
+	switch v.(type) {
+	case nil, string, float64:
+		return true
+	}
+
+	return false
+}
+
+// replyRPC queues res for the client.
+func (c *Client) replyRPC(res rpcResponse) {
+	res.JSONRPC = "2.0"
+	if len(res.ID) == 0 {
+		res.ID = json.RawMessage("null")
+	}
+
+	b, err := json.Marshal(res)
+	if err != nil {
+		log.Println(fmt.Sprintf("Error serializing rpc response: %v", err))
+		return
+	}
+
+	c.hub.do(func() {
+		if c.hub.clients[c] {
+			c.reply(&message{data: b, kind: EnvelopeRPC})
+		}
+	})
+}
+
+// DecodeParams unmarshals params into v, reporting failures as
+// RPCInvalidParams. Empty params leave v untouched.
+func DecodeParams(params json.RawMessage, v interface{}) error {
+	if len(params) == 0 {
+		return nil
+	}
+
+	d := json.NewDecoder(bytes.NewReader(params))
+	d.DisallowUnknownFields()
+	if err := d.Decode(v); err != nil {
+		return InvalidParams(err)
+	}
+
+	return nil
+}
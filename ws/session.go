@@ -0,0 +1,208 @@
+package ws
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+)
+
+const (
+	// How long a disconnected session can be resumed.
+	sessionTTL = 2 * time.Minute
+
+	// Upper bound on remembered sessions; the longest detached go first.
+	maxSessions = 1 << 10
+
+	// The most missed messages sent to a resuming client; older ones are
+	// reported as a gap.
+	maxFill = 1 << 13
+)
+
+// session lets a client that reconnects pick up where it left off. Only
+// touched from Hub.RunLoop.
+type session struct {
+	token string
+
+	// The client last attached to the session.
+	client *Client
+
+	// When client went away; zero while it is connected.
+	detached time.Time
+}
+
+// resumeRequest is what a reconnecting client presents:
+//
+//	/ws?envelope=true&resume=<token>&last_seq=1042
+type resumeRequest struct {
+	token   string
+	lastSeq uint64
+}
+
+// sessionReply is the first message an enveloped client gets:
+//
+//	{"session": {"token": "9f86d0...", "resumed": true, "seq": 1042}}
+//
+// seq is the newest sequence number published so far. A resumed client
+// then gets a gapReply if some of what it missed is gone, and the rest as
+// one historyReply. None of these are ever dropped by its Policy.
+type sessionReply struct {
+	Session sessionInfo `json:"session"`
+}
+
+type sessionInfo struct {
+	Token   string `json:"token"`
+	Resumed bool   `json:"resumed"`
+	Seq     uint64 `json:"seq"`
+}
+
+// gapReply tells a resuming client that messages with sequence numbers
+// from..to (inclusive) are gone from the history and won't be sent:
+//
+//	{"gap": {"from": 1043, "to": 1210}}
+type gapReply struct {
+	Gap gapRange `json:"gap"`
+}
+
+type gapRange struct {
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+}
+
+func newSessionToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// attach gives c a session, resuming the one it asked for when possible,
+// and queues the session reply followed by whatever c missed. It reports
+// whether the session was resumed, and must be called from RunLoop, as c
+// is registered.
+func (h *Hub) attach(c *Client) bool {
+	h.expireSessions(time.Now())
+
+	s, resumed := h.resumable(c.resume)
+	if resumed {
+		old := s.client
+		c.topics = make(map[string]bool, len(old.topics))
+		for t := range old.topics {
+			c.topics[t] = true
+		}
+		c.rate = old.rate.fresh()
+	} else {
+		token, err := newSessionToken()
+		if err != nil {
+			log.Println(fmt.Sprintf("Error creating session: %v", err))
+			return false
+		}
+		s = &session{token: token}
+		h.sessions[token] = s
+	}
+	s.client, s.detached = c, time.Time{}
+	c.session = s
+
+	h.pushJSON(c, EnvelopeSession, sessionReply{Session: sessionInfo{
+		Token:   s.token,
+		Resumed: resumed,
+		Seq:     h.lastSeq,
+	}})
+
+	if resumed {
+		h.fill(c, c.resume.lastSeq)
+	}
+
+	return resumed
+}
+
+// resumable returns the session r names, if it exists and isn't in use.
+func (h *Hub) resumable(r *resumeRequest) (*session, bool) {
+	if r == nil {
+		return nil, false
+	}
+
+	s, ok := h.sessions[r.token]
+	if !ok || s.detached.IsZero() {
+		return nil, false
+	}
+
+	return s, true
+}
+
+// fill sends c every remembered message after seq that it wants, as one
+// history reply, preceded by a gap notice for those it can't have: the
+// ones already evicted and, past maxFill, the oldest of the rest.
+func (h *Hub) fill(c *Client, seq uint64) {
+	es, gap := h.missed(seq)
+
+	var wanted []*message
+	for _, e := range es {
+		if wants(c.topics, e.m) {
+			wanted = append(wanted, e.m)
+		}
+	}
+	if len(wanted) > maxFill {
+		// Cut between sequence numbers, so the gap is exact.
+		cutoff := wanted[len(wanted)-maxFill].seq
+		kept := wanted[:0]
+		for _, m := range wanted {
+			if m.seq >= cutoff {
+				kept = append(kept, m)
+			}
+		}
+		wanted = kept
+		gap = &gapRange{From: seq + 1, To: cutoff - 1}
+	}
+
+	if gap != nil {
+		h.pushJSON(c, EnvelopeGap, gapReply{Gap: *gap})
+	}
+	h.replyHistory(c, wanted)
+}
+
+// detach marks c's session as resumable. It must be called from RunLoop.
+func (h *Hub) detach(c *Client) {
+	if s := c.session; s != nil && s.client == c {
+		s.detached = time.Now()
+	}
+}
+
+// expireSessions forgets sessions detached for longer than sessionTTL,
+// then the longest detached ones while there are too many.
+func (h *Hub) expireSessions(now time.Time) {
+	for token, s := range h.sessions {
+		if !s.detached.IsZero() && now.Sub(s.detached) > sessionTTL {
+			delete(h.sessions, token)
+		}
+	}
+
+	for len(h.sessions) >= maxSessions {
+		var oldest *session
+		for _, s := range h.sessions {
+			if !s.detached.IsZero() && (oldest == nil || s.detached.Before(oldest.detached)) {
+				oldest = s
+			}
+		}
+		if oldest == nil {
+			// Every session is attached.
+			return
+		}
+		delete(h.sessions, oldest.token)
+	}
+}
+
+// pushJSON sends v to c as a reply of the given envelope type.
+func (h *Hub) pushJSON(c *Client, kind string, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		log.Println(fmt.Sprintf("Error serializing %s message: %v", kind, err))
+		return
+	}
+
+	c.reply(&message{data: b, kind: kind})
+}
@@ -0,0 +1,132 @@
+package ws
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// readEnvelope reads the next enveloped message, failing unless it is of
+// the given type, and decodes its data into v.
+func readEnvelope(t *testing.T, conn *websocket.Conn, kind string, v interface{}) {
+	t.Helper()
+
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	var e envelope
+	if err := conn.ReadJSON(&e); err != nil {
+		t.Fatalf("reading %s: %v", kind, err)
+	}
+	if e.Type != kind {
+		t.Fatalf("got a %s message, want %s", e.Type, kind)
+	}
+	if err := json.Unmarshal(e.Data, v); err != nil {
+		t.Fatalf("decoding %s: %v", kind, err)
+	}
+}
+
+// A client resuming after missing more than its queue holds gets its
+// session, then everything it missed in one batch, whatever its policy.
+func TestSessionResume(t *testing.T) {
+	h, url := startHub(t)
+	h.SetHistory(time.Hour)
+	publishSeq(h, 1, 5)
+
+	conn := dial(t, url+"?envelope=true")
+	var first sessionReply
+	readEnvelope(t, conn, EnvelopeSession, &first)
+	conn.Close()
+	waitClients(t, h, 0)
+
+	publishSeq(h, 6, 245)
+	h.do(func() {
+		// Forget 1..10.
+		h.history = h.history[10:]
+	})
+
+	conn = dial(t, url+"?envelope=true&policy=coalesce-to-latest&resume="+first.Session.Token+"&last_seq=5")
+
+	var s sessionReply
+	readEnvelope(t, conn, EnvelopeSession, &s)
+	if !s.Session.Resumed || s.Session.Token != first.Session.Token || s.Session.Seq != 245 {
+		t.Errorf("got session %+v, want %s resumed at 245", s.Session, first.Session.Token)
+	}
+
+	var g gapReply
+	readEnvelope(t, conn, EnvelopeGap, &g)
+	if g.Gap != (gapRange{From: 6, To: 10}) {
+		t.Errorf("got gap %+v, want 6..10", g.Gap)
+	}
+
+	var missed historyReply
+	readEnvelope(t, conn, EnvelopeHistory, &missed)
+	b := missed.History
+	if b.From != 11 || b.To != 245 || len(b.Messages) != 235 {
+		t.Errorf("got %d messages %d..%d, want 235 from 11..245", len(b.Messages), b.From, b.To)
+	}
+}
+
+// A client the admin kicked must not come back through its session.
+func TestSessionDisconnected(t *testing.T) {
+	h, url := startHub(t)
+
+	conn := dial(t, url+"?envelope=true&topics=accel")
+	var first sessionReply
+	readEnvelope(t, conn, EnvelopeSession, &first)
+	waitClients(t, h, 1)
+	if err := h.Disconnect(h.Clients()[0].ID, strings.Repeat("kicked ", 30)); err != nil {
+		t.Fatal(err)
+	}
+	waitClients(t, h, 0)
+
+	// A reason too long for a close frame is cut short, not lost.
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, _, err := conn.ReadMessage()
+	if ce, ok := err.(*websocket.CloseError); !ok || !strings.HasPrefix(ce.Text, "kicked kicked") {
+		t.Errorf("got %v, want a close with the reason cut short", err)
+	}
+
+	conn = dial(t, url+"?envelope=true&resume="+first.Session.Token+"&last_seq=0")
+	var s sessionReply
+	readEnvelope(t, conn, EnvelopeSession, &s)
+	if s.Session.Resumed || s.Session.Token == first.Session.Token {
+		t.Errorf("kicked client resumed session %s", s.Session.Token)
+	}
+}
+
+// A resuming client that also asks for history gets only what it missed,
+// not a second, overlapping batch.
+func TestSessionResumeWithHistory(t *testing.T) {
+	h, url := startHub(t)
+	h.SetHistory(time.Hour)
+	publishSeq(h, 1, 5)
+
+	conn := dial(t, url+"?envelope=true")
+	var first sessionReply
+	readEnvelope(t, conn, EnvelopeSession, &first)
+	conn.Close()
+	waitClients(t, h, 0)
+
+	publishSeq(h, 6, 8)
+	conn = dial(t, url+"?envelope=true&history=&resume="+first.Session.Token+"&last_seq=5")
+
+	var s sessionReply
+	readEnvelope(t, conn, EnvelopeSession, &s)
+	var missed historyReply
+	readEnvelope(t, conn, EnvelopeHistory, &missed)
+	if b := missed.History; b.From != 6 || b.To != 8 || len(b.Messages) != 3 {
+		t.Errorf("got %d messages %d..%d, want 3 from 6..8", len(b.Messages), b.From, b.To)
+	}
+
+	h.Send(Message{Seq: 9, Topics: []string{"accel"}, Sampled: true, Data: []byte(`{"n":1}`)})
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	var e envelope
+	if err := conn.ReadJSON(&e); err != nil {
+		t.Fatal(err)
+	}
+	if e.Type != EnvelopeData || e.Seq != 9 {
+		t.Errorf("got a %s message with seq %d after the missed batch, want data 9", e.Type, e.Seq)
+	}
+}
@@ -0,0 +1,309 @@
+package ws
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// How often an idle event stream is sent a comment, so proxies don't
+// time it out.
+const sseHeartbeat = 15 * time.Second
+
+// sseClient is a server-sent event stream fed by the Hub.
+type sseClient struct {
+	// Subscribed topics and output rate. Owned by Hub.RunLoop.
+	topics map[string]bool
+	rate   *rateLimiter
+
+	// Dotted paths of the fields to send, e.g. acceleration.x; nil
+	// sends whole messages.
+	fields [][]string
+
+	send *queue
+}
+
+// push formats m as an event and queues it. It must be called from
+// Hub.RunLoop.
+func (c *sseClient) push(m *message) {
+	if b, ok := c.event(m); ok {
+		c.send.push(frame{data: b})
+	}
+}
+
+// event formats m for the stream, reporting false if the stream doesn't
+// want any of it. It must be called from Hub.RunLoop.
+func (c *sseClient) event(m *message) ([]byte, bool) {
+	data := m.data
+	if c.fields != nil {
+		v, err := m.decode()
+		if err != nil {
+			log.Println(fmt.Sprintf("Error decoding message for event stream: %v", err))
+			return nil, false
+		}
+		p, ok := project(v, c.fields)
+		if !ok {
+			// Nothing this stream asked for.
+			return nil, false
+		}
+		if data, err = json.Marshal(p); err != nil {
+			log.Println(fmt.Sprintf("Error serializing event: %v", err))
+			return nil, false
+		}
+	}
+
+	return formatEvent(m, data), true
+}
+
+// formatEvent renders one event:
+//
+//	id: 1042
+//	event: accel
+//	data: {"acceleration": {...}}
+//
+// The id is the sample sequence number and the event name the message's
+// first topic.
+func formatEvent(m *message, data []byte) []byte {
+	var b bytes.Buffer
+	if m.seq != 0 {
+		fmt.Fprintf(&b, "id: %d\n", m.seq)
+	}
+	if len(m.topics) > 0 {
+		fmt.Fprintf(&b, "event: %s\n", m.topics[0])
+	}
+	for _, line := range bytes.Split(data, lf) {
+		b.WriteString("data: ")
+		b.Write(line)
+		b.WriteByte('\n')
+	}
+	b.WriteByte('\n')
+
+	return b.Bytes()
+}
+
+// project keeps only the given paths of a decoded JSON document. It
+// reports false when none of them are present.
+func project(v interface{}, paths [][]string) (interface{}, bool) {
+	out := make(map[string]interface{})
+	for _, path := range paths {
+		cur, ok := v, true
+		for _, key := range path {
+			obj, isObj := cur.(map[string]interface{})
+			if !isObj {
+				ok = false
+				break
+			}
+			if cur, ok = obj[key]; !ok {
+				break
+			}
+		}
+		if !ok {
+			continue
+		}
+
+		dst := out
+		for _, key := range path[:len(path)-1] {
+			next, isObj := dst[key].(map[string]interface{})
+			if !isObj {
+				next = make(map[string]interface{})
+				dst[key] = next
+			}
+			dst = next
+		}
+		dst[path[len(path)-1]] = cur
+	}
+
+	return out, len(out) > 0
+}
+
+// parseFields splits a comma separated list of dotted paths.
+func parseFields(s string) [][]string {
+	var fields [][]string
+	for _, f := range parseTopics(s) {
+		fields = append(fields, strings.Split(f, "."))
+	}
+
+	return fields
+}
+
+// addSSE registers c with the run loop. If it is resuming, it returns the
+// events c missed after seq, to be written before anything queued: the
+// backlog can be far longer than the queue. Sampled messages are thinned
+// to c's rate by when they were published. Messages that are no longer
+// remembered are reported with a gap event first:
+//
+//	event: gap
+//	data: {"gap": {"from": 1043, "to": 1210}}
+func (h *Hub) addSSE(c *sseClient, seq uint64) ([][]byte, error) {
+	done := make(chan [][]byte, 1)
+	ok := h.do(func() {
+		var backlog [][]byte
+		if seq != 0 {
+			es, gap := h.missed(seq)
+			if gap != nil {
+				b, err := json.Marshal(gapReply{Gap: *gap})
+				if err != nil {
+					log.Println(fmt.Sprintf("Error serializing gap: %v", err))
+				} else {
+					backlog = append(backlog, formatEvent(&message{topics: []string{EnvelopeGap}}, b))
+				}
+			}
+			for _, e := range es {
+				if !wants(c.topics, e.m) {
+					continue
+				}
+				m, ok := c.rate.admit(e.m, e.at)
+				if !ok {
+					continue
+				}
+				if b, ok := c.event(m); ok {
+					backlog = append(backlog, b)
+				}
+			}
+		}
+		h.streams[c] = true
+		done <- backlog
+	})
+	if !ok {
+		return nil, ErrHubClosed
+	}
+
+	return <-done, nil
+}
+
+func (h *Hub) removeSSE(c *sseClient) {
+	h.do(func() {
+		delete(h.streams, c)
+	})
+}
+
+// ServeSSE streams the Hub's messages as server-sent events, for clients
+// that can't use websockets:
+//
+//	curl -N 'http://pi:3000/events?topics=accel&fields=acceleration.x&rate=10'
+//
+// It takes the same "topics", "rate" and "rate_mode" query params as
+// ServeWSOptions, plus "fields", a list of dotted paths to keep from each
+// message. Each event's id is its sample sequence number; a client that
+// reconnects with Last-Event-ID (or the "last_event_id" query param) is
+// first sent what it missed at its rate, as far back as the Hub remembers,
+// after a gap event for anything older (see Hub.addSSE). ServeSSE blocks until the
+// client goes away or the Hub shuts down.
+func ServeSSE(h *Hub, w http.ResponseWriter, r *http.Request, o Options) error {
+	role, err := o.Auth.authenticate(w, r)
+	if err != nil {
+		return err
+	}
+	if role == RoleNone {
+		// There is no first message to authenticate with.
+		w.Header().Set("WWW-Authenticate", `Bearer realm="xxws"`)
+		http.Error(w, "Token required", http.StatusUnauthorized)
+		return errBadToken
+	}
+
+	q := r.URL.Query()
+	rate, err := queryRate(q)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return err
+	}
+
+	var seq uint64
+	last := r.Header.Get("Last-Event-ID")
+	if last == "" {
+		last = q.Get("last_event_id")
+	}
+	if last != "" {
+		if seq, err = strconv.ParseUint(last, 10, 64); err != nil {
+			http.Error(w, fmt.Sprintf("invalid Last-Event-ID %q", last), http.StatusBadRequest)
+			return err
+		}
+	}
+
+	c := &sseClient{
+		topics: queryTopics(q),
+		rate:   rate,
+		fields: parseFields(q.Get("fields")),
+		send:   newQueue(o.QueueSize, o.Policy, o.MaxDrops),
+	}
+	backlog, err := h.addSSE(c, seq)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+		return err
+	}
+	defer h.removeSSE(c)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	// Stop nginx and friends from buffering the stream.
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(w)
+	if err := rc.Flush(); err != nil {
+		return err
+	}
+
+	write := func(out [][]byte, events int) error {
+		rc.SetWriteDeadline(time.Now().Add(writeTimeout))
+		n := 0
+		for _, b := range out {
+			if _, err := w.Write(b); err != nil {
+				return err
+			}
+			n += len(b)
+		}
+		if err := rc.Flush(); err != nil {
+			return err
+		}
+		atomic.AddUint64(&h.counters.payload, uint64(n))
+		atomic.AddUint64(&h.counters.wire, uint64(n))
+		atomic.AddUint64(&h.counters.messages, uint64(events))
+		return nil
+	}
+
+	if len(backlog) > 0 {
+		if err := write(backlog, len(backlog)); err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(sseHeartbeat)
+	defer ticker.Stop()
+
+	for {
+		var (
+			out    [][]byte
+			events int
+			closed bool
+		)
+		select {
+		case <-c.send.ready:
+			var fs []frame
+			fs, closed = c.send.drain(0)
+			for _, f := range fs {
+				out = append(out, f.data)
+			}
+			events = len(fs)
+		case <-ticker.C:
+			out = [][]byte{[]byte(": ping\n\n")}
+		case <-r.Context().Done():
+			return nil
+		}
+
+		if err := write(out, events); err != nil {
+			return err
+		}
+
+		if closed {
+			// The hub is shutting down; an EventSource will reconnect.
+			return nil
+		}
+	}
+}
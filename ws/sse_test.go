@@ -0,0 +1,113 @@
+package ws
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// publishSeq publishes sequenced samples first..last and waits for the
+// hub to have seen them all.
+func publishSeq(h *Hub, first, last uint64) {
+	for seq := first; seq <= last; seq++ {
+		h.Send(Message{Seq: seq, Topics: []string{"accel"}, Sampled: true, Data: []byte(`{"n":1}`)})
+	}
+
+	for {
+		done := make(chan uint64, 1)
+		h.do(func() { done <- h.lastSeq })
+		if <-done >= last {
+			return
+		}
+	}
+}
+
+// startSSE runs a hub's event stream behind a test server, returning its
+// URL.
+func startSSE(t *testing.T) (*Hub, string) {
+	t.Helper()
+
+	h := NewHub()
+	go h.RunLoop()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ServeSSE(h, w, r, DefaultOptions)
+	}))
+	t.Cleanup(func() {
+		h.Close()
+		srv.Close()
+	})
+
+	return h, srv.URL
+}
+
+// A stream resuming from further back than its queue holds gets every
+// remembered event, after a gap event for those already forgotten.
+func TestSSEResume(t *testing.T) {
+	h, url := startSSE(t)
+	h.SetHistory(time.Hour)
+	publishSeq(h, 1, 240)
+	h.do(func() {
+		// Forget 1..100.
+		h.history = h.history[100:]
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	req.Header.Set("Last-Event-ID", "5")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var lines []string
+	sc := bufio.NewScanner(resp.Body)
+	for sc.Scan() && sc.Text() != "id: 240" {
+		lines = append(lines, sc.Text())
+	}
+
+	if len(lines) < 2 || lines[0] != "event: gap" || lines[1] != `data: {"gap":{"from":6,"to":100}}` {
+		t.Fatalf("stream starts %q, want a gap event for 6..100", lines)
+	}
+	ids := 1
+	for _, l := range lines {
+		if strings.HasPrefix(l, "id: ") {
+			ids++
+		}
+	}
+	if ids != 140 {
+		t.Errorf("got %d events, want 140 (101..240)", ids)
+	}
+}
+
+// A rate limited stream gets its backlog at its rate too.
+func TestSSEResumeRate(t *testing.T) {
+	h, url := startSSE(t)
+	h.SetHistory(time.Hour)
+	publishSeq(h, 1, 50)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, url+"?rate=1&last_event_id=5", nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var ids []string
+	sc := bufio.NewScanner(resp.Body)
+	for sc.Scan() {
+		if strings.HasPrefix(sc.Text(), "id: ") {
+			ids = append(ids, sc.Text())
+		}
+	}
+	if len(ids) != 1 || ids[0] != "id: 6" {
+		t.Errorf("got events %q, want only id 6 at 1Hz", ids)
+	}
+}
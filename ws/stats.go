@@ -0,0 +1,106 @@
+package ws
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"net/http"
+	"sync/atomic"
+)
+
+// Stats are byte counters for a client or, summed, for a Hub.
+type Stats struct {
+	// Bytes of message payload handed to the connection.
+	PayloadBytes uint64 `json:"payloadBytes"`
+	// Bytes that actually went out on the socket, after framing and
+	// permessage-deflate compression.
+	WireBytes uint64 `json:"wireBytes"`
+	// Messages written to the connection.
+	Messages uint64 `json:"messages"`
+	// Messages discarded by the backpressure Policy.
+	Dropped uint64 `json:"dropped"`
+}
+
+// counters is updated atomically from the client's writer goroutine.
+type counters struct {
+	payload  uint64
+	wire     uint64
+	messages uint64
+	// Only used by the hub, for clients that have gone away.
+	dropped uint64
+}
+
+func (c *counters) stats() Stats {
+	return Stats{
+		PayloadBytes: atomic.LoadUint64(&c.payload),
+		WireBytes:    atomic.LoadUint64(&c.wire),
+		Messages:     atomic.LoadUint64(&c.messages),
+		Dropped:      atomic.LoadUint64(&c.dropped),
+	}
+}
+
+// countingConn counts bytes written to the socket into the client's and
+// the hub's counters.
+type countingConn struct {
+	net.Conn
+	client, hub *counters
+}
+
+func (c *countingConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	atomic.AddUint64(&c.client.wire, uint64(n))
+	atomic.AddUint64(&c.hub.wire, uint64(n))
+
+	return n, err
+}
+
+// countingResponseWriter hands the upgrader a countingConn on Hijack, so
+// wire bytes can be measured below the websocket framing.
+type countingResponseWriter struct {
+	http.ResponseWriter
+	client, hub *counters
+}
+
+func (w *countingResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("ws: response does not implement http.Hijacker")
+	}
+
+	conn, brw, err := h.Hijack()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &countingConn{Conn: conn, client: w.client, hub: w.hub}, brw, nil
+}
+
+// addPayload records n bytes of payload written for c.
+func (c *Client) addPayload(n int) {
+	atomic.AddUint64(&c.counters.payload, uint64(n))
+	atomic.AddUint64(&c.hub.counters.payload, uint64(n))
+}
+
+// addMessages records n messages written for c.
+func (c *Client) addMessages(n int) {
+	atomic.AddUint64(&c.counters.messages, uint64(n))
+	atomic.AddUint64(&c.hub.counters.messages, uint64(n))
+}
+
+// Stats returns the client's counters.
+func (c *Client) Stats() Stats {
+	s := c.counters.stats()
+	s.Dropped = c.Dropped()
+
+	return s
+}
+
+// Stats returns counters summed over every client the hub has served.
+func (h *Hub) Stats() Stats {
+	s := h.counters.stats()
+	for _, c := range h.Clients() {
+		s.Dropped += c.Dropped
+	}
+
+	return s
+}
@@ -0,0 +1,140 @@
+package ws
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// TopicAll subscribes a client to every topic. New clients start with it.
+const TopicAll = "*"
+
+// controlMessage is sent by clients to change what they receive:
+//
+//	{"type": "subscribe", "topics": ["accel", "sensor:pi-2"]}
+//	{"type": "unsubscribe", "topics": ["*"]}
+//	{"type": "rate", "rate": 10, "mode": "average"}
+//	{"type": "history", "seconds": 5}
+//
+// JSON-RPC requests share the connection; see HandleRPC.
+//
+// The server answers subscription changes with the resulting set:
+//
+//	{"subscriptions": ["accel", "sensor:pi-2"]}
+type controlMessage struct {
+	Type   string   `json:"type"`
+	Topics []string `json:"topics"`
+
+	// Set on JSON-RPC requests, which are handled separately.
+	JSONRPC string `json:"jsonrpc"`
+
+	// How far back a history request goes; zero is everything.
+	Seconds float64 `json:"seconds"`
+
+	rateMessage
+}
+
+type subscriptionReply struct {
+	Subscriptions []string `json:"subscriptions"`
+}
+
+// message is a published payload and the topics it belongs to.
+// No topics means every client receives it.
+type message struct {
+	data   []byte
+	topics []string
+
+	// Sequence number of the sample the message came from; 0 if none.
+	seq uint64
+
+	// Envelope type for clients that asked for one; empty means data.
+	kind string
+
+	// Part of a periodic stream a client may thin out; see rateLimiter.
+	sampled bool
+
+	// data decoded as JSON, and re-encoded per codec, shared by every
+	// client that needs it.
+	decoded    interface{}
+	decodedErr error
+	isDecoded  bool
+	encoded    map[*codec]frame
+}
+
+// decode parses data once per message, however many clients need it.
+func (m *message) decode() (interface{}, error) {
+	if !m.isDecoded {
+		m.decodedErr = json.Unmarshal(m.data, &m.decoded)
+		m.isDecoded = true
+	}
+
+	return m.decoded, m.decodedErr
+}
+
+// parseTopics splits a comma separated list, dropping blanks.
+func parseTopics(s string) []string {
+	var topics []string
+	for _, t := range strings.Split(s, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			topics = append(topics, t)
+		}
+	}
+
+	return topics
+}
+
+// wants reports whether a client subscribed to set should get m.
+func wants(set map[string]bool, m *message) bool {
+	if len(m.topics) == 0 || set[TopicAll] {
+		return true
+	}
+
+	for _, t := range m.topics {
+		if set[t] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *Client) handleControl(b []byte) {
+	var m controlMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		if isRPC(b) {
+			c.replyRPC(rpcResponse{Error: &RPCError{Code: RPCParseError, Message: err.Error()}})
+			return
+		}
+		log.Println(fmt.Sprintf("Error parsing control message: %v", err))
+		return
+	}
+
+	if m.JSONRPC != "" {
+		c.handleRPC(b)
+		return
+	}
+
+	switch m.Type {
+	case "subscribe":
+		c.hub.subscribe(c, m.Topics, true)
+	case "unsubscribe":
+		c.hub.subscribe(c, m.Topics, false)
+	case "rate":
+		mode, err := ParseRateMode(m.Mode)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+		c.hub.setRate(c, m.Rate, mode)
+	case "history":
+		if m.Seconds < 0 {
+			log.Println(fmt.Sprintf("Invalid history window %v", m.Seconds))
+			return
+		}
+		c.hub.requestHistory(c, time.Duration(m.Seconds*float64(time.Second)))
+	default:
+		log.Println(fmt.Sprintf("Unknown control message type %q", m.Type))
+	}
+}
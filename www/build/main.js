@@ -1,5 +1,5 @@
 "use strict";
-let ws = new WebSocket(`ws://${location.host}/ws`);
+let ws = new WebSocket(`${location.protocol === "https:" ? "wss" : "ws"}://${location.host}/ws`);
 let sensor = Sensor();
 let dataNode = getRenderTarget("data-target");
 let wsHandler = {
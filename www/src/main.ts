@@ -1,4 +1,6 @@
-let ws = new WebSocket(`ws://${location.host}/ws`);
+let ws = new WebSocket(
+  `${location.protocol === "https:" ? "wss" : "ws"}://${location.host}/ws`
+);
 let sensor = Sensor();
 let dataNode = getRenderTarget("data-target");
 